@@ -0,0 +1,177 @@
+package depiq
+
+import (
+	"context"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/orn-id/depiq/internal/errors"
+)
+
+type (
+	// Options of SelectDataset#InBatchesWithOptions
+	BatchOptions struct {
+		// If set every batch is processed in a transaction of DB started with Database#BeginTx using the context of
+		// InBatches, the batch dataset executes its queries in the transaction which is committed if the batch
+		// function returns nil and rolled back otherwise. The keys of a batch are selected before its transaction is
+		// started
+		DB *Database
+		// Called after every batch has been processed (and committed)
+		Progress func(p BatchProgress)
+	}
+
+	// The progress of SelectDataset#InBatches reported after every batch
+	BatchProgress struct {
+		// The number of the batch starting at 1
+		Batch int
+		// The number of rows in the batch
+		Rows int
+		// The number of rows in all batches processed so far
+		TotalRows int
+		// The keys of the first and last row of the batch
+		FirstKey interface{}
+		LastKey  interface{}
+	}
+)
+
+// Walks the rows of the dataset in batches of size rows ordered by the ascending key column (e.g. the primary key) and
+// calls fn with a dataset for every batch, see InBatchesWithOptions to use a transaction for every batch or report the
+// progress
+//
+//	err := db.From("user").Where(C("email_verified").IsNull()).
+//		InBatches(ctx, "id", 1000, func(batch *depiq.SelectDataset) error {
+//			_, err := batch.Update().Set(Record{"email_verified": false}).Executor().ExecContext(ctx)
+//			return err
+//		})
+//
+// The keys of a batch are selected using the WHERE of the dataset and a keyset predicate on the key of the last row of
+// the previous batch instead of an OFFSET
+//
+//	SELECT "id" FROM "user" WHERE (("email_verified" IS NULL) AND ("id" > 1000)) ORDER BY "id" ASC LIMIT 1000
+//
+// The batch dataset is the dataset with its ORDER and LIMIT removed and the keys restricted to the keys of the batch
+// (e.g. "id" BETWEEN 1001 AND 2000) so it can be fetched, updated or deleted. The key column must be unique and can be
+// a string (e.g. "id" or "user.id") or an IdentifierExpression. The first error returned by fn stops the batches and
+// is returned.
+//
+// The LIMIT of the dataset caps the total number of rows processed in the order of the key, a dataset with an OFFSET
+// is rejected. The keys of a batch are selected before fn is called, rows that are changed in between (e.g. by another
+// transaction) are not locked: a row inserted in the key range of the batch is part of the batch dataset and a row
+// that is deleted or no longer matches the WHERE is not.
+func (sd *SelectDataset) InBatches(
+	ctx context.Context,
+	keyCol interface{},
+	size uint,
+	fn func(batch *SelectDataset) error,
+) error {
+	return sd.InBatchesWithOptions(ctx, keyCol, size, BatchOptions{}, fn)
+}
+
+// Same as InBatches with options to process every batch in a transaction and report the progress
+//
+//	err := db.From("user").InBatchesWithOptions(ctx, "id", 1000, depiq.BatchOptions{
+//		DB: db,
+//		Progress: func(p depiq.BatchProgress) {
+//			log.Printf("batch %d: %d rows (%d total)", p.Batch, p.Rows, p.TotalRows)
+//		},
+//	}, func(batch *depiq.SelectDataset) error {
+//		// the queries of the batch are executed in the transaction of the batch
+//		_, err := batch.Delete().Executor().ExecContext(ctx)
+//		return err
+//	})
+func (sd *SelectDataset) InBatchesWithOptions(
+	ctx context.Context,
+	keyCol interface{},
+	size uint,
+	opts BatchOptions,
+	fn func(batch *SelectDataset) error,
+) error {
+	if sd.queryFactory == nil {
+		return ErrQueryFactoryNotFoundError
+	}
+	if sd.err != nil {
+		return sd.err
+	}
+	key, err := batchKey(keyCol)
+	if err != nil {
+		return err
+	}
+	if size == 0 {
+		return errors.New("batch size must be greater than 0")
+	}
+	if sd.clauses.Offset() > 0 {
+		return errors.New("a dataset with an OFFSET can not be processed in batches, use a WHERE on the batch key")
+	}
+	// the limit of the dataset caps the total rows, 0 for no cap
+	var maxRows uint
+	if l, ok := sd.clauses.Limit().(uint); ok {
+		maxRows = l
+	}
+	keys := sd.Select(key).Order(key.Asc())
+	batches := sd.ClearOrder().ClearLimit()
+	progress := BatchProgress{}
+	for {
+		limit := size
+		if maxRows > 0 {
+			limit = minUint(size, maxRows-uint(progress.TotalRows))
+		}
+		ds := keys.Limit(limit)
+		if progress.Batch > 0 {
+			ds = ds.Where(key.Gt(progress.LastKey))
+		}
+		var batchKeys []interface{}
+		if err := ds.ScanValsContext(ctx, &batchKeys); err != nil {
+			return err
+		}
+		if len(batchKeys) == 0 {
+			return nil
+		}
+		progress.Batch++
+		progress.Rows = len(batchKeys)
+		progress.TotalRows += len(batchKeys)
+		progress.FirstKey, progress.LastKey = batchKeys[0], batchKeys[len(batchKeys)-1]
+
+		batch := batches.Where(key.Between(exp.NewRangeVal(progress.FirstKey, progress.LastKey)))
+		if err := runBatch(ctx, batch, opts.DB, fn); err != nil {
+			return err
+		}
+		if opts.Progress != nil {
+			opts.Progress(progress)
+		}
+		if uint(len(batchKeys)) < limit || (maxRows > 0 && uint(progress.TotalRows) >= maxRows) {
+			return nil
+		}
+	}
+}
+
+// calls fn with the batch, in a transaction of db started with ctx if db is not nil
+func runBatch(ctx context.Context, batch *SelectDataset, db *Database, fn func(batch *SelectDataset) error) error {
+	if db == nil {
+		return fn(batch)
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return tx.Wrap(func() error {
+		txBatch := batch.copy(batch.clauses)
+		txBatch.queryFactory = tx.queryFactory()
+		return fn(txBatch)
+	})
+}
+
+func minUint(a, b uint) uint {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func batchKey(keyCol interface{}) (exp.IdentifierExpression, error) {
+	switch t := keyCol.(type) {
+	case string:
+		return I(t), nil
+	case exp.IdentifierExpression:
+		return t, nil
+	}
+	return nil, errors.New("unsupported batch key column type %T, use a string or an IdentifierExpression", keyCol)
+}
@@ -0,0 +1,179 @@
+package depiq_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/orn-id/depiq"
+	"github.com/orn-id/depiq/internal/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+type batchSuite struct {
+	suite.Suite
+}
+
+func (bs *batchSuite) mockDB() (*depiq.Database, sqlmock.Sqlmock) {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	bs.Require().NoError(err)
+	return depiq.New("mock", mDB), sqlMock
+}
+
+func (bs *batchSuite) keyRows(keys ...int64) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id"})
+	for _, key := range keys {
+		rows.AddRow(key)
+	}
+	return rows
+}
+
+func (bs *batchSuite) TestInBatches() {
+	db, sqlMock := bs.mockDB()
+	sqlMock.ExpectQuery(`SELECT "id" FROM "items" WHERE ("name" = 'a') ORDER BY "id" ASC LIMIT 2`).
+		WillReturnRows(bs.keyRows(1, 2))
+	sqlMock.ExpectQuery(`SELECT "id" FROM "items" WHERE (("name" = 'a') AND ("id" > 2)) ORDER BY "id" ASC LIMIT 2`).
+		WillReturnRows(bs.keyRows(3, 4))
+	sqlMock.ExpectQuery(`SELECT "id" FROM "items" WHERE (("name" = 'a') AND ("id" > 4)) ORDER BY "id" ASC LIMIT 2`).
+		WillReturnRows(bs.keyRows(5))
+
+	var batches []string
+	ds := db.From("items").Where(depiq.C("name").Eq("a")).Order(depiq.C("name").Desc()).Limit(10)
+	err := ds.InBatches(context.Background(), "id", 2, func(batch *depiq.SelectDataset) error {
+		sql, _, err := batch.ToSQL()
+		batches = append(batches, sql)
+		return err
+	})
+	bs.NoError(err)
+	bs.Equal([]string{
+		`SELECT * FROM "items" WHERE (("name" = 'a') AND ("id" BETWEEN 1 AND 2))`,
+		`SELECT * FROM "items" WHERE (("name" = 'a') AND ("id" BETWEEN 3 AND 4))`,
+		`SELECT * FROM "items" WHERE (("name" = 'a') AND ("id" BETWEEN 5 AND 5))`,
+	}, batches)
+	bs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (bs *batchSuite) TestInBatches_lastBatchIsFull() {
+	db, sqlMock := bs.mockDB()
+	sqlMock.ExpectQuery(`SELECT "items"."id" FROM "items" ORDER BY "items"."id" ASC LIMIT 2`).
+		WillReturnRows(bs.keyRows(1, 2))
+	sqlMock.ExpectQuery(`SELECT "items"."id" FROM "items" WHERE ("items"."id" > 2) ORDER BY "items"."id" ASC LIMIT 2`).
+		WillReturnRows(bs.keyRows())
+
+	count := 0
+	err := db.From("items").InBatches(context.Background(), depiq.T("items").Col("id"), 2,
+		func(batch *depiq.SelectDataset) error {
+			count++
+			return nil
+		})
+	bs.NoError(err)
+	bs.Equal(1, count)
+	bs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (bs *batchSuite) TestInBatches_limitCapsTotalRows() {
+	db, sqlMock := bs.mockDB()
+	sqlMock.ExpectQuery(`SELECT "id" FROM "items" ORDER BY "id" ASC LIMIT 2`).
+		WillReturnRows(bs.keyRows(1, 2))
+	sqlMock.ExpectQuery(`SELECT "id" FROM "items" WHERE ("id" > 2) ORDER BY "id" ASC LIMIT 1`).
+		WillReturnRows(bs.keyRows(3))
+
+	var batches []string
+	err := db.From("items").Limit(3).InBatches(context.Background(), "id", 2, func(batch *depiq.SelectDataset) error {
+		sql, _, err := batch.ToSQL()
+		batches = append(batches, sql)
+		return err
+	})
+	bs.NoError(err)
+	bs.Equal([]string{
+		`SELECT * FROM "items" WHERE ("id" BETWEEN 1 AND 2)`,
+		`SELECT * FROM "items" WHERE ("id" BETWEEN 3 AND 3)`,
+	}, batches)
+	bs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (bs *batchSuite) TestInBatches_stopsOnError() {
+	db, sqlMock := bs.mockDB()
+	sqlMock.ExpectQuery(`SELECT "id" FROM "items" ORDER BY "id" ASC LIMIT 2`).
+		WillReturnRows(bs.keyRows(1, 2))
+
+	expectedErr := errors.New("test error")
+	err := db.From("items").InBatches(context.Background(), "id", 2, func(batch *depiq.SelectDataset) error {
+		return expectedErr
+	})
+	bs.Equal(expectedErr, err)
+	bs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (bs *batchSuite) TestInBatchesWithOptions() {
+	db, sqlMock := bs.mockDB()
+	sqlMock.ExpectQuery(`SELECT "id" FROM "items" ORDER BY "id" ASC LIMIT 2`).
+		WillReturnRows(bs.keyRows(1, 2))
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`DELETE FROM "items" WHERE ("id" BETWEEN 1 AND 2)`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectCommit()
+	sqlMock.ExpectQuery(`SELECT "id" FROM "items" WHERE ("id" > 2) ORDER BY "id" ASC LIMIT 2`).
+		WillReturnRows(bs.keyRows(3))
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`DELETE FROM "items" WHERE ("id" BETWEEN 3 AND 3)`).
+		WillReturnError(errors.New("delete error"))
+	sqlMock.ExpectRollback()
+
+	var progress []depiq.BatchProgress
+	opts := depiq.BatchOptions{
+		DB: db,
+		Progress: func(p depiq.BatchProgress) {
+			progress = append(progress, p)
+		},
+	}
+	err := db.From("items").InBatchesWithOptions(context.Background(), "id", 2, opts,
+		func(batch *depiq.SelectDataset) error {
+			_, err := batch.Delete().Executor().Exec()
+			return err
+		})
+	bs.EqualError(err, "depiq: delete error")
+	bs.Equal([]depiq.BatchProgress{
+		{Batch: 1, Rows: 2, TotalRows: 2, FirstKey: int64(1), LastKey: int64(2)},
+	}, progress)
+	bs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (bs *batchSuite) TestInBatchesWithOptions_transactionUsesContext() {
+	db, sqlMock := bs.mockDB()
+	sqlMock.ExpectQuery(`SELECT "id" FROM "items" ORDER BY "id" ASC LIMIT 2`).
+		WillReturnRows(bs.keyRows(1, 2))
+	sqlMock.ExpectBegin().WillDelayFor(time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	called := false
+	err := db.From("items").InBatchesWithOptions(ctx, "id", 2, depiq.BatchOptions{DB: db},
+		func(batch *depiq.SelectDataset) error {
+			called = true
+			return nil
+		})
+	bs.Equal(sqlmock.ErrCancelled, err)
+	bs.False(called)
+}
+
+func (bs *batchSuite) TestInBatches_errors() {
+	fn := func(batch *depiq.SelectDataset) error { return nil }
+	db, _ := bs.mockDB()
+
+	bs.EqualError(db.From("items").InBatches(context.Background(), 1, 2, fn),
+		"depiq: unsupported batch key column type int, use a string or an IdentifierExpression")
+	bs.EqualError(db.From("items").InBatches(context.Background(), "id", 0, fn),
+		"depiq: batch size must be greater than 0")
+	bs.EqualError(db.From("items").Offset(10).InBatches(context.Background(), "id", 2, fn),
+		"depiq: a dataset with an OFFSET can not be processed in batches, use a WHERE on the batch key")
+	bs.Equal(depiq.ErrQueryFactoryNotFoundError, depiq.From("items").InBatches(context.Background(), "id", 2, fn))
+
+	expectedErr := errors.New("test error")
+	bs.Equal(expectedErr, db.From("items").SetError(expectedErr).InBatches(context.Background(), "id", 2, fn))
+}
+
+func TestBatchSuite(t *testing.T) {
+	suite.Run(t, new(batchSuite))
+}
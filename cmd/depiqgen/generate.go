@@ -0,0 +1,408 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/orn-id/depiq"
+)
+
+type (
+	options struct {
+		// ignore fields without a db tag (see depiq.SetIgnoreUntaggedFields)
+		ignoreUntagged bool
+		// the arguments written to the header of the generated file
+		args []string
+	}
+
+	// the structs and imports of a package
+	pkg struct {
+		fset    *token.FileSet
+		name    string
+		structs map[string]*typeDecl
+		// the methods declared for each type name
+		methods map[string]map[string]bool
+	}
+
+	typeDecl struct {
+		spec *ast.TypeSpec
+		file *ast.File
+	}
+
+	// a column of a struct
+	column struct {
+		// the name of the Column field in the generated struct
+		Name string
+		// the selector of the struct field, e.g. Address.Street
+		Path string
+		// the type of the struct field
+		Type string
+	}
+
+	table struct {
+		Type    string
+		Columns []column
+	}
+
+	generator struct {
+		pkg     *pkg
+		opts    options
+		imports map[string]string
+		err     error
+	}
+)
+
+var tableTemplate = template.Must(template.New("table").Parse(`// Code generated by "depiqgen {{ .Args }}"; DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+{{- range .StdImports }}
+	{{ . }}
+{{- end }}
+{{ if .StdImports }}
+{{ end -}}
+{{- range .Imports }}
+	{{ . }}
+{{- end }}
+)
+{{ range .Tables }}
+// The typed columns of {{ .Type }}, see depiq.TableOf
+type {{ .Type }}Table struct {
+	*depiq.TypedTable[{{ .Type }}]
+{{- range .Columns }}
+	{{ .Name }} depiq.Column[{{ .Type }}]
+{{- end }}
+}
+
+// Returns the typed columns of {{ .Type }}
+func New{{ .Type }}Table() {{ .Type }}Table {
+	t := depiq.TableOf[{{ .Type }}]()
+	return {{ .Type }}Table{
+		TypedTable: t,
+{{- $type := .Type }}
+{{- range .Columns }}
+		{{ .Name }}: depiq.ColumnOf(t, func(m *{{ $type }}) *{{ .Type }} { return &m.{{ .Path }} }),
+{{- end }}
+	}
+}
+{{ end -}}
+`))
+
+// Generates the source of the typed columns of the structs in the package in dir
+func generate(dir string, typeNames []string, opts options) ([]byte, error) {
+	p, err := parsePackage(dir, typeNames)
+	if err != nil {
+		return nil, err
+	}
+	g := &generator{pkg: p, opts: opts, imports: map[string]string{}}
+	tables := make([]table, 0, len(typeNames))
+	for _, name := range typeNames {
+		td, ok := p.structs[name]
+		if !ok {
+			return nil, fmt.Errorf("struct type %s not found in package %s", name, p.name)
+		}
+		if td.spec.TypeParams != nil {
+			return nil, fmt.Errorf("generic type %s is not supported", name)
+		}
+		cols := g.columns(td.spec.Type.(*ast.StructType), td.file, "", "", map[string]bool{name: true})
+		if g.err != nil {
+			return nil, fmt.Errorf("%s: %w", name, g.err)
+		}
+		renameReservedColumns(cols)
+		tables = append(tables, table{Type: name, Columns: cols})
+	}
+	var stdImports []string
+	imports := []string{strconv.Quote("github.com/orn-id/depiq")}
+	for importPath, name := range g.imports {
+		spec := strconv.Quote(importPath)
+		if name != path.Base(importPath) {
+			spec = name + " " + spec
+		}
+		// standard library packages do not have a domain
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			imports = append(imports, spec)
+		} else {
+			stdImports = append(stdImports, spec)
+		}
+	}
+	sort.Strings(stdImports)
+	sort.Strings(imports)
+	var buf bytes.Buffer
+	err = tableTemplate.Execute(&buf, map[string]interface{}{
+		"Args":       strings.Join(opts.args, " "),
+		"Package":    p.name,
+		"StdImports": stdImports,
+		"Imports":    imports,
+		"Tables":     tables,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// parses the package in dir that declares the types
+func parsePackage(dir string, typeNames []string) (*pkg, error) {
+	fset := token.NewFileSet()
+	notTest := func(fi os.FileInfo) bool { return !strings.HasSuffix(fi.Name(), "_test.go") }
+	pkgs, err := parser.ParseDir(fset, dir, notTest, 0)
+	if err != nil {
+		return nil, err
+	}
+	for name, astPkg := range pkgs {
+		p := &pkg{fset: fset, name: name, structs: map[string]*typeDecl{}, methods: map[string]map[string]bool{}}
+		for _, f := range astPkg.Files {
+			p.addDecls(f)
+		}
+		if _, ok := p.structs[typeNames[0]]; ok {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("struct type %s not found in %s", typeNames[0], dir)
+}
+
+func (p *pkg) addDecls(f *ast.File) {
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					if _, isStruct := ts.Type.(*ast.StructType); isStruct {
+						p.structs[ts.Name.Name] = &typeDecl{spec: ts, file: f}
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil || len(d.Recv.List) == 0 {
+				continue
+			}
+			recv := typeName(d.Recv.List[0].Type)
+			if p.methods[recv] == nil {
+				p.methods[recv] = map[string]bool{}
+			}
+			p.methods[recv][d.Name.Name] = true
+		}
+	}
+}
+
+// returns the columns of a struct, the fields of embedded structs are added after the fields of the struct so a field
+// of the struct shadows a field of an embedded struct with the same name
+func (g *generator) columns(
+	st *ast.StructType,
+	file *ast.File,
+	namePrefix, pathPrefix string,
+	seen map[string]bool,
+) []column {
+	var cols, embeddedCols []column
+	for _, f := range st.Fields.List {
+		dbTag := g.dbTag(f)
+		if dbTag == "-" {
+			continue
+		}
+		if len(f.Names) == 0 {
+			embeddedCols = append(embeddedCols, g.embeddedColumns(f, dbTag, file, namePrefix, pathPrefix, seen)...)
+			continue
+		}
+		if dbTag == "" && g.opts.ignoreUntagged {
+			continue
+		}
+		for _, n := range f.Names {
+			if !n.IsExported() {
+				continue
+			}
+			if nested, ok := f.Type.(*ast.StructType); ok {
+				cols = append(cols, g.columns(nested, file, namePrefix+n.Name, pathPrefix+n.Name+".", seen)...)
+				continue
+			}
+			if nested, ok := g.nestedStruct(f.Type, seen); ok {
+				cols = append(cols, g.nestedColumns(nested, namePrefix+n.Name, pathPrefix+n.Name+".", seen)...)
+				continue
+			}
+			cols = append(cols, column{
+				Name: namePrefix + n.Name,
+				Path: pathPrefix + n.Name,
+				Type: g.typeString(f.Type, file),
+			})
+		}
+	}
+	for _, c := range embeddedCols {
+		if !containsColumn(cols, c.Name) {
+			cols = append(cols, c)
+		}
+	}
+	return cols
+}
+
+// returns the columns of an embedded field, a db tag on an embedded struct is used as the prefix of its columns
+func (g *generator) embeddedColumns(
+	f *ast.Field,
+	dbTag string,
+	file *ast.File,
+	namePrefix, pathPrefix string,
+	seen map[string]bool,
+) []column {
+	name := typeName(f.Type)
+	if isExternal(f.Type) {
+		g.fail("embedded type %s from another package is not supported", g.typeString(f.Type, file))
+		return nil
+	}
+	nested, ok := g.structDecl(f.Type, seen)
+	if !ok {
+		// an embedded type that is not a struct is a column if it is exported
+		if !ast.IsExported(name) || (dbTag == "" && g.opts.ignoreUntagged) {
+			return nil
+		}
+		return []column{{Name: namePrefix + name, Path: pathPrefix + name, Type: g.typeString(f.Type, file)}}
+	}
+	if dbTag != "" {
+		namePrefix += name
+	}
+	return g.nestedColumns(nested, namePrefix, pathPrefix+name+".", seen)
+}
+
+func (g *generator) nestedColumns(nested *typeDecl, namePrefix, pathPrefix string, seen map[string]bool) []column {
+	name := nested.spec.Name.Name
+	seen[name] = true
+	defer delete(seen, name)
+	return g.columns(nested.spec.Type.(*ast.StructType), nested.file, namePrefix, pathPrefix, seen)
+}
+
+// returns the struct declared in the package if the type is a struct (or a pointer to a struct) that does not implement
+// sql.Scanner, the columns of the struct are columns of the struct it is used in
+func (g *generator) nestedStruct(expr ast.Expr, seen map[string]bool) (*typeDecl, bool) {
+	if g.pkg.methods[typeName(expr)]["Scan"] {
+		return nil, false
+	}
+	return g.structDecl(expr, seen)
+}
+
+// returns the struct declared in the package if the type is a struct or a pointer to a struct
+func (g *generator) structDecl(expr ast.Expr, seen map[string]bool) (*typeDecl, bool) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	td, ok := g.pkg.structs[ident.Name]
+	if !ok {
+		return nil, false
+	}
+	if seen[ident.Name] {
+		g.fail("recursive struct %s is not supported", ident.Name)
+		return nil, false
+	}
+	return td, true
+}
+
+// reports whether the type is declared in another package, these types can not be inspected
+func isExternal(expr ast.Expr) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	_, ok := expr.(*ast.SelectorExpr)
+	return ok
+}
+
+func (g *generator) dbTag(f *ast.Field) string {
+	if f.Tag == nil {
+		return ""
+	}
+	tag, err := strconv.Unquote(f.Tag.Value)
+	if err != nil {
+		return ""
+	}
+	return strings.Split(reflect.StructTag(tag).Get("db"), ",")[0]
+}
+
+// returns the source of a type and adds the packages it uses to the imports of the generated file
+func (g *generator) typeString(expr ast.Expr, file *ast.File) string {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if x, ok := sel.X.(*ast.Ident); ok {
+			g.addImport(x.Name, file)
+		}
+		return false
+	})
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, g.pkg.fset, expr); err != nil {
+		g.fail("%s", err)
+	}
+	return buf.String()
+}
+
+func (g *generator) addImport(name string, file *ast.File) {
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		if (spec.Name != nil && spec.Name.Name == name) || (spec.Name == nil && path.Base(importPath) == name) {
+			g.imports[importPath] = name
+			return
+		}
+	}
+	g.fail("unable to find the import of package %s", name)
+}
+
+func (g *generator) fail(format string, args ...interface{}) {
+	if g.err == nil {
+		g.err = fmt.Errorf(format, args...)
+	}
+}
+
+// returns the name of a type without a pointer and package, e.g. *sql.NullString -> NullString
+func typeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return typeName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.Ident:
+		return t.Name
+	case *ast.IndexExpr:
+		return typeName(t.X)
+	}
+	return ""
+}
+
+// renames the columns that would hide a method of depiq.TypedTable (e.g. a Table or Schema field), the suffix Col is
+// added to the name
+func renameReservedColumns(cols []column) {
+	reserved := map[string]bool{"TypedTable": true}
+	t := reflect.TypeOf(&depiq.TypedTable[struct{}]{})
+	for i := 0; i < t.NumMethod(); i++ {
+		reserved[t.Method(i).Name] = true
+	}
+	for i := range cols {
+		for reserved[cols[i].Name] || containsColumn(cols[:i], cols[i].Name) {
+			cols[i].Name += "Col"
+		}
+	}
+}
+
+func containsColumn(cols []column, name string) bool {
+	for _, c := range cols {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
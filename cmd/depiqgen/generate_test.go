@@ -0,0 +1,43 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type generateSuite struct {
+	suite.Suite
+}
+
+func TestGenerateSuite(t *testing.T) {
+	suite.Run(t, new(generateSuite))
+}
+
+func (gs *generateSuite) TestGenerate() {
+	opts := options{args: []string{"-type", "User,Order"}}
+	src, err := generate(filepath.Join("testdata", "models"), []string{"User", "Order"}, opts)
+	gs.Require().NoError(err)
+	expected, err := os.ReadFile(filepath.Join("testdata", "user_table.go.golden"))
+	gs.Require().NoError(err)
+	gs.Equal(string(expected), string(src))
+}
+
+func (gs *generateSuite) TestGenerate_errors() {
+	dir := filepath.Join("testdata", "models")
+	_, err := generate(dir, []string{"Missing"}, options{})
+	gs.EqualError(err, "struct type Missing not found in testdata/models")
+	_, err = generate(dir, []string{"User", "Missing"}, options{})
+	gs.EqualError(err, "struct type Missing not found in package models")
+	_, err = generate(dir, []string{"Embedded"}, options{})
+	gs.EqualError(err, "Embedded: embedded type sql.NullString from another package is not supported")
+}
+
+func (gs *generateSuite) TestGenerate_ignoreUntagged() {
+	src, err := generate(filepath.Join("testdata", "models"), []string{"Address"}, options{ignoreUntagged: true})
+	gs.Require().NoError(err)
+	gs.Contains(string(src), "City depiq.Column[string]")
+	gs.NotContains(string(src), "Street")
+}
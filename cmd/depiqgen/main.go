@@ -0,0 +1,57 @@
+// Command depiqgen generates typed columns for structs so queries break the build instead of failing at runtime when a
+// struct changes, see depiq.TableOf and depiq.ColumnOf.
+//
+//	//go:generate go run github.com/orn-id/depiq/cmd/depiqgen -type User,Order
+//
+// For every type a <Type>Table struct with a depiq.Column for every column of the type and a New<Type>Table function
+// are written to <type>_table.go in the directory of the package
+//
+//	users := NewUserTable()
+//	depiq.From(users).Where(users.Name.Eq("Bob"), users.Age.Gt(18))
+//
+// The columns are found with the same rules used to scan rows into the struct (see the db and depiq struct tags),
+// structs embedded from other packages are not supported.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeNames := flag.String("type", "", "comma separated list of struct type names; required")
+	output := flag.String("output", "", "output file name; default <dir>/<type>_table.go")
+	ignoreUntagged := flag.Bool("ignore-untagged", false,
+		"ignore fields without a db tag, use it if depiq.SetIgnoreUntaggedFields(true) is used")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: depiqgen -type T [flags] [directory]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	types := strings.Split(*typeNames, ",")
+	src, err := generate(dir, types, options{ignoreUntagged: *ignoreUntagged, args: os.Args[1:]})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "depiqgen: %s\n", err)
+		os.Exit(1)
+	}
+	name := *output
+	if name == "" {
+		name = filepath.Join(dir, strings.ToLower(types[0])+"_table.go")
+	}
+	// nolint:gosec // generated source is readable like the rest of the tree
+	if err := os.WriteFile(name, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "depiqgen: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	pgarray "github.com/lib/pq"
+)
+
+type (
+	Base struct {
+		ID        int64     `db:"id"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+
+	Address struct {
+		Street string
+		City   string `db:"city"`
+	}
+
+	// implements sql.Scanner so it is a single column
+	Location struct {
+		Lat, Lng float64
+	}
+
+	User struct {
+		*Base
+		Name     string              `db:"name"`
+		Email    sql.NullString      `db:"email"`
+		Age      *int                `db:"age"`
+		Address  Address             `db:"address"`
+		Location Location            `db:"location"`
+		Tags     pgarray.StringArray `db:"tags"`
+		Password string              `db:"-"`
+		internal string
+	}
+
+	Order struct {
+		ID     int64 `db:"id" depiq:"skipinsert"`
+		UserID int64 `db:"user_id"`
+		// hides the Schema method of the table so the column is named SchemaCol
+		Schema string `db:"schema"`
+		Meta   struct {
+			Source string `db:"source"`
+		} `db:"meta"`
+	}
+)
+
+func (l *Location) Scan(src interface{}) error {
+	return nil
+}
+
+// embeds a struct from another package which can not be inspected
+type Embedded struct {
+	sql.NullString
+}
@@ -124,6 +124,20 @@ func (d *Database) Select(cols ...interface{}) *SelectDataset {
 	return newDataset(d.dialect, d.queryFactory()).Select(cols...)
 }
 
+// Creates a dataset from JSON created by SelectDataset#MarshalJSON that uses the adapter of the Database and supports
+// queries, see SelectFromJSON
+//          ds, err := db.SelectFromJSON(savedQuery)
+//          if err != nil {
+//              panic(err.Error())
+//          }
+//          var users []User
+//          if err := ds.Fetch(&users); err != nil {
+//              panic(err.Error())
+//          }
+func (d *Database) SelectFromJSON(data []byte) (*SelectDataset, error) {
+	return selectFromJSON(d.dialect, d.queryFactory(), data)
+}
+
 func (d *Database) Update(table interface{}) *UpdateDataset {
 	return newUpdateDataset(d.dialect, d.queryFactory()).Table(table)
 }
@@ -475,6 +489,12 @@ func (td *TxDatabase) Select(cols ...interface{}) *SelectDataset {
 	return newDataset(td.dialect, td.queryFactory()).Select(cols...)
 }
 
+// Creates a dataset from JSON created by SelectDataset#MarshalJSON that executes its queries in the transaction, see
+// Database#SelectFromJSON
+func (td *TxDatabase) SelectFromJSON(data []byte) (*SelectDataset, error) {
+	return selectFromJSON(td.dialect, td.queryFactory(), data)
+}
+
 func (td *TxDatabase) Update(table interface{}) *UpdateDataset {
 	return newUpdateDataset(td.dialect, td.queryFactory()).Table(table)
 }
@@ -149,6 +149,31 @@ func (ds *databaseSuite) TestScanVals() {
 		"depiq: type must be a pointer to a slice when scanning into vals")
 }
 
+func (ds *databaseSuite) TestSelectFromJSON() {
+	mDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	ds.NoError(err)
+	mock.ExpectQuery(`SELECT "address", "name" FROM "items" WHERE ("name" IN ((SELECT "name" FROM "names"))) LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).AddRow("111 Test Addr", "Test1"))
+
+	data, err := depiq.From("items").
+		Where(depiq.C("name").In(depiq.From("names").Select("name"))).
+		Limit(1).
+		MarshalJSON()
+	ds.NoError(err)
+
+	db := depiq.New("mock", mDB)
+	decoded, err := db.SelectFromJSON(data)
+	ds.NoError(err)
+	var items []testActionItem
+	ds.NoError(decoded.Fetch(&items))
+	ds.Equal([]testActionItem{{Address: "111 Test Addr", Name: "Test1"}}, items)
+	ds.NoError(mock.ExpectationsWereMet())
+
+	_, err = db.SelectFromJSON([]byte(`{"version":2,"select":{}}`))
+	ds.Error(err)
+}
+
 func (ds *databaseSuite) TestScanVal() {
 	mDB, mock, err := sqlmock.New()
 	ds.NoError(err)
@@ -497,6 +522,30 @@ func (tds *txdatabaseSuite) TestFrom() {
 	tds.NoError(tx.Commit())
 }
 
+func (tds *txdatabaseSuite) TestSelectFromJSON() {
+	mDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	tds.NoError(err)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("Test1"))
+	mock.ExpectCommit()
+
+	data, err := depiq.From("items").Select("name").MarshalJSON()
+	tds.NoError(err)
+
+	db := depiq.New("mock", mDB)
+	tx, err := db.Begin()
+	tds.NoError(err)
+	decoded, err := tx.SelectFromJSON(data)
+	tds.NoError(err)
+	var names []string
+	tds.NoError(decoded.ScanVals(&names))
+	tds.Equal([]string{"Test1"}, names)
+	tds.NoError(tx.Commit())
+	tds.NoError(mock.ExpectationsWereMet())
+}
+
 func (tds *txdatabaseSuite) TestScanStructs() {
 	mDB, mock, err := sqlmock.New()
 	tds.NoError(err)
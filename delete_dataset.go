@@ -13,6 +13,7 @@ type DeleteDataset struct {
 	dialect      SQLDialect
 	clauses      exp.DeleteClauses
 	isPrepared   prepared
+	simplify     bool
 	queryFactory exec.QueryFactory
 	err          error
 }
@@ -55,6 +56,18 @@ func (dd *DeleteDataset) IsPrepared() bool {
 	return dd.isPrepared.Bool()
 }
 
+// Set to true to simplify the boolean expressions of the dataset before the SQL is generated, e.g. empty expression
+// lists are removed and x IN () is folded into FALSE. See sqlgen.Simplifier
+func (dd *DeleteDataset) Simplify(simplify bool) *DeleteDataset {
+	ret := dd.copy(dd.clauses)
+	ret.simplify = simplify
+	return ret
+}
+
+func (dd *DeleteDataset) IsSimplified() bool {
+	return dd.simplify
+}
+
 // Sets the adapter used to serialize values and create the SQL statement
 func (dd *DeleteDataset) WithDialect(dl string) *DeleteDataset {
 	ds := dd.copy(dd.GetClauses())
@@ -79,12 +92,18 @@ func (dd *DeleteDataset) GetClauses() exp.DeleteClauses {
 	return dd.clauses
 }
 
+// Returns a copy of the dataset using the clauses. Used by exp.Rewrite to rebuild a rewritten dataset.
+func (dd *DeleteDataset) WithClauses(clauses exp.DeleteClauses) exp.Expression {
+	return dd.copy(clauses)
+}
+
 // used interally to copy the dataset
 func (dd *DeleteDataset) copy(clauses exp.DeleteClauses) *DeleteDataset {
 	return &DeleteDataset{
 		dialect:      dd.dialect,
 		clauses:      clauses,
 		isPrepared:   dd.isPrepared,
+		simplify:     dd.simplify,
 		queryFactory: dd.queryFactory,
 		err:          dd.err,
 	}
@@ -239,6 +258,14 @@ func (dd *DeleteDataset) deleteSQLBuilder() sb.SQLBuilder {
 	if dd.err != nil {
 		return buf.SetError(dd.err)
 	}
-	dd.dialect.ToDeleteSQL(buf, dd.clauses)
+	dd.dialect.ToDeleteSQL(buf, dd.sqlClauses())
 	return buf
 }
+
+// returns the clauses used to generate the SQL, simplified if Simplify(true) was used
+func (dd *DeleteDataset) sqlClauses() exp.DeleteClauses {
+	if fn := datasetSimplifier(dd.dialect, dd.simplify); fn != nil {
+		return exp.RewriteDeleteClauses(dd.clauses, fn)
+	}
+	return dd.clauses
+}
@@ -98,12 +98,38 @@ func (dds *deleteDatasetSuite) TestPrepared() {
 	dds.True(ds.IsPrepared())
 }
 
+func (dds *deleteDatasetSuite) TestSimplify() {
+	ds := depiq.Delete("test").Where(depiq.Or(depiq.C("a").In([]int{}), depiq.C("a").In([]string{})))
+	simplifiedDs := ds.Simplify(true)
+	dds.True(simplifiedDs.IsSimplified())
+	dds.False(ds.IsSimplified())
+	dds.True(simplifiedDs.Where(depiq.Ex{"b": 1}).IsSimplified())
+
+	sql, _, err := ds.ToSQL()
+	dds.NoError(err)
+	dds.Equal(`DELETE FROM "test" WHERE (("a" IN ()) OR ("a" IN ()))`, sql)
+
+	sql, _, err = simplifiedDs.ToSQL()
+	dds.NoError(err)
+	dds.Equal(`DELETE FROM "test" WHERE FALSE`, sql)
+}
+
 func (dds *deleteDatasetSuite) TestGetClauses() {
 	ds := depiq.Delete("test")
 	ce := exp.NewDeleteClauses().SetFrom(depiq.I("test"))
 	dds.Equal(ce, ds.GetClauses())
 }
 
+func (dds *deleteDatasetSuite) TestWithClauses() {
+	ds := depiq.Delete("test").WithDialect("mock")
+	ce := exp.NewDeleteClauses().SetFrom(depiq.I("other"))
+	wds, ok := ds.WithClauses(ce).(*depiq.DeleteDataset)
+	dds.True(ok)
+	dds.Equal(ce, wds.GetClauses())
+	dds.Equal(ds.Dialect(), wds.Dialect())
+	dds.NotEqual(ce, ds.GetClauses())
+}
+
 func (dds *deleteDatasetSuite) TestWith() {
 	from := depiq.From("cte")
 	bd := depiq.Delete("items")
@@ -72,3 +72,9 @@ func SetColumnRenameFunction(renameFunc func(string) string) {
 func SetTimeLocation(loc *time.Location) {
 	sqlgen.SetTimeLocation(loc)
 }
+
+// Set the secret used to sign the cursors of SelectDataset#Paginate. There is no default secret, the same secret must
+// be set by all instances of a program so cursors stay valid across restarts and instances (e.g. load from config).
+func SetPaginationSecret(secret []byte) {
+	paginationSecret = append([]byte(nil), secret...)
+}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"github.com/orn-id/depiq"
 	"github.com/orn-id/depiq/exp"
+	"github.com/orn-id/depiq/sqlgen"
 )
 
 func DialectOptions() *depiq.SQLDialectOptions {
@@ -20,6 +21,8 @@ func DialectOptions() *depiq.SQLDialectOptions {
 	opts.SupportsWithCTERecursive = false
 	opts.SupportsDistinctOn = false
 	opts.SupportsWindowFunction = false
+	opts.SupportsAggregateFilter = false
+	opts.SupportsWithinGroup = false
 	opts.SupportsDeleteTableHint = true
 
 	opts.UseFromClauseForMultipleUpdateTables = false
@@ -32,24 +35,25 @@ func DialectOptions() *depiq.SQLDialectOptions {
 	opts.False = []byte("0")
 	opts.TimeFormat = "2006-01-02 15:04:05"
 	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
-		exp.EqOp:             []byte("="),
-		exp.NeqOp:            []byte("!="),
-		exp.GtOp:             []byte(">"),
-		exp.GteOp:            []byte(">="),
-		exp.LtOp:             []byte("<"),
-		exp.LteOp:            []byte("<="),
-		exp.InOp:             []byte("IN"),
-		exp.NotInOp:          []byte("NOT IN"),
-		exp.IsOp:             []byte("IS"),
-		exp.IsNotOp:          []byte("IS NOT"),
-		exp.LikeOp:           []byte("LIKE BINARY"),
-		exp.NotLikeOp:        []byte("NOT LIKE BINARY"),
-		exp.ILikeOp:          []byte("LIKE"),
-		exp.NotILikeOp:       []byte("NOT LIKE"),
-		exp.RegexpLikeOp:     []byte("REGEXP BINARY"),
-		exp.RegexpNotLikeOp:  []byte("NOT REGEXP BINARY"),
-		exp.RegexpILikeOp:    []byte("REGEXP"),
-		exp.RegexpNotILikeOp: []byte("NOT REGEXP"),
+		exp.EqOp:                []byte("="),
+		exp.NeqOp:               []byte("!="),
+		exp.GtOp:                []byte(">"),
+		exp.GteOp:               []byte(">="),
+		exp.LtOp:                []byte("<"),
+		exp.LteOp:               []byte("<="),
+		exp.InOp:                []byte("IN"),
+		exp.NotInOp:             []byte("NOT IN"),
+		exp.IsOp:                []byte("IS"),
+		exp.IsNotOp:             []byte("IS NOT"),
+		exp.LikeOp:              []byte("LIKE BINARY"),
+		exp.NotLikeOp:           []byte("NOT LIKE BINARY"),
+		exp.ILikeOp:             []byte("LIKE"),
+		exp.NotILikeOp:          []byte("NOT LIKE"),
+		exp.RegexpLikeOp:        []byte("REGEXP BINARY"),
+		exp.RegexpNotLikeOp:     []byte("NOT REGEXP BINARY"),
+		exp.RegexpILikeOp:       []byte("REGEXP"),
+		exp.RegexpNotILikeOp:    []byte("NOT REGEXP"),
+		exp.IsNotDistinctFromOp: []byte("<=>"),
 	}
 	opts.BitwiseOperatorLookup = map[exp.BitwiseOperation][]byte{
 		exp.BitwiseInversionOp:  []byte("~"),
@@ -59,6 +63,80 @@ func DialectOptions() *depiq.SQLDialectOptions {
 		exp.BitwiseLeftShiftOp:  []byte("<<"),
 		exp.BitwiseRightShiftOp: []byte(">>"),
 	}
+	opts.WindowFrameUnitLookup = map[exp.WindowFrameUnit][]byte{
+		exp.RowsFrameUnit:  []byte("ROWS"),
+		exp.RangeFrameUnit: []byte("RANGE"),
+	}
+	opts.WindowFrameExclusionLookup = map[exp.WindowFrameExclusion][]byte{}
+	opts.GroupingTypeLookup = map[exp.GroupingType][]byte{}
+	opts.WithRollupFragment = []byte(" WITH ROLLUP")
+	opts.SupportsArrays = false
+	opts.TextSearchStyle = sqlgen.MatchAgainstTextSearchStyle
+	opts.TextSearchModeLookup = map[exp.TextSearchMode][]byte{
+		exp.NaturalLanguageTextSearchMode: []byte(" IN NATURAL LANGUAGE MODE"),
+		exp.BooleanTextSearchMode:         []byte(" IN BOOLEAN MODE"),
+	}
+	opts.TextSearchRankFunction = nil
+	opts.TextSearchMatchFragment = []byte("MATCH ")
+	opts.TextSearchAgainstFragment = []byte(" AGAINST ")
+	opts.DateTimeStyle = sqlgen.DateAddDateTimeStyle
+	opts.DateAddFunction = []byte("DATE_ADD")
+	opts.DateSubFunction = []byte("DATE_SUB")
+	opts.DateTruncFunction = []byte("DATE_FORMAT")
+	opts.DateTruncCastType = []byte("DATETIME")
+	// || is a logical OR unless PIPES_AS_CONCAT is enabled
+	opts.ConcatOperator = nil
+	opts.StringFunctionLookup = map[exp.StringOperation][]byte{
+		exp.ConcatOp:    []byte("CONCAT"),
+		exp.LowerOp:     []byte("LOWER"),
+		exp.UpperOp:     []byte("UPPER"),
+		exp.TrimOp:      []byte("TRIM"),
+		exp.SubstringOp: []byte("SUBSTRING"),
+		exp.LengthOp:    []byte("CHAR_LENGTH"),
+		exp.PositionOp:  []byte("POSITION"),
+		exp.ReplaceOp:   []byte("REPLACE"),
+	}
+	opts.IntervalUnitLookup = map[exp.DateTimeUnit][]byte{
+		exp.MicrosecondUnit: []byte("MICROSECOND"),
+		exp.SecondUnit:      []byte("SECOND"),
+		exp.MinuteUnit:      []byte("MINUTE"),
+		exp.HourUnit:        []byte("HOUR"),
+		exp.DayUnit:         []byte("DAY"),
+		exp.WeekUnit:        []byte("WEEK"),
+		exp.MonthUnit:       []byte("MONTH"),
+		exp.QuarterUnit:     []byte("QUARTER"),
+		exp.YearUnit:        []byte("YEAR"),
+	}
+	opts.ExtractFieldLookup = map[exp.DateTimeUnit][]byte{
+		exp.MicrosecondUnit: []byte("MICROSECOND"),
+		exp.SecondUnit:      []byte("SECOND"),
+		exp.MinuteUnit:      []byte("MINUTE"),
+		exp.HourUnit:        []byte("HOUR"),
+		exp.DayUnit:         []byte("DAY"),
+		exp.WeekUnit:        []byte("WEEK"),
+		exp.MonthUnit:       []byte("MONTH"),
+		exp.QuarterUnit:     []byte("QUARTER"),
+		exp.YearUnit:        []byte("YEAR"),
+	}
+	opts.DateTruncLookup = map[exp.DateTimeUnit][]byte{
+		exp.SecondUnit: []byte("%Y-%m-%d %H:%i:%s"),
+		exp.MinuteUnit: []byte("%Y-%m-%d %H:%i:00"),
+		exp.HourUnit:   []byte("%Y-%m-%d %H:00:00"),
+		exp.DayUnit:    []byte("%Y-%m-%d 00:00:00"),
+		exp.MonthUnit:  []byte("%Y-%m-01 00:00:00"),
+		exp.YearUnit:   []byte("%Y-01-01 00:00:00"),
+	}
+	opts.UseJSONPathString = true
+	opts.JSONHasKeyIsNotNull = true
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{
+		exp.JSONExtractTextOp: []byte("->>"),
+	}
+	opts.JSONFunctionLookup = map[exp.JSONOperation][]byte{
+		exp.JSONExtractOp:     []byte("JSON_EXTRACT"),
+		exp.JSONContainsOp:    []byte("JSON_CONTAINS"),
+		exp.JSONHasKeyOp:      []byte("JSON_EXTRACT"),
+		exp.JSONArrayLengthOp: []byte("JSON_LENGTH"),
+	}
 	opts.EscapedRunes = map[rune][]byte{
 		'\'': []byte("\\'"),
 		'"':  []byte("\\\""),
@@ -68,6 +146,8 @@ func DialectOptions() *depiq.SQLDialectOptions {
 		0:    []byte("\\x00"),
 		0x1a: []byte("\\x1a"),
 	}
+	opts.ExceptFragment = nil
+	opts.ExceptAllFragment = nil
 	opts.InsertIgnoreClause = []byte("INSERT IGNORE INTO")
 	opts.ConflictFragment = []byte("")
 	opts.ConflictDoUpdateFragment = []byte(" ON DUPLICATE KEY UPDATE ")
@@ -3,6 +3,7 @@ package mysql_test
 import (
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/orn-id/depiq"
 	"github.com/orn-id/depiq/exp"
@@ -101,6 +102,8 @@ func (mds *mysqlDialectSuite) TestBooleanOperations() {
 		sqlTestCase{ds: ds.Where(col.IsNot(false)), sql: "SELECT * FROM `test` WHERE (`a` IS NOT FALSE)"},
 		sqlTestCase{ds: ds.Where(col.IsNotTrue()), sql: "SELECT * FROM `test` WHERE (`a` IS NOT TRUE)"},
 		sqlTestCase{ds: ds.Where(col.IsNotFalse()), sql: "SELECT * FROM `test` WHERE (`a` IS NOT FALSE)"},
+		sqlTestCase{ds: ds.Where(col.IsNotDistinctFrom(depiq.C("b"))), sql: "SELECT * FROM `test` WHERE (`a` <=> `b`)"},
+		sqlTestCase{ds: ds.Where(col.IsDistinctFrom(depiq.C("b"))), sql: "SELECT * FROM `test` WHERE NOT (`a` <=> `b`)"},
 		sqlTestCase{ds: ds.Where(col.Like("a%")), sql: "SELECT * FROM `test` WHERE (`a` LIKE BINARY 'a%')"},
 		sqlTestCase{ds: ds.Where(col.NotLike("a%")), sql: "SELECT * FROM `test` WHERE (`a` NOT LIKE BINARY 'a%')"},
 		sqlTestCase{ds: ds.Where(col.ILike("a%")), sql: "SELECT * FROM `test` WHERE (`a` LIKE 'a%')"},
@@ -138,6 +141,197 @@ func (mds *mysqlDialectSuite) TestUpdateSQL() {
 	)
 }
 
+func (mds *mysqlDialectSuite) TestCompoundExpressions() {
+	ds1 := mds.GetDs("test").Select("a")
+	ds2 := mds.GetDs("test2").Select("b")
+	mds.assertSQL(
+		sqlTestCase{ds: ds1.Union(ds2), sql: "SELECT `a` FROM `test` UNION (SELECT `b` FROM `test2`)"},
+		sqlTestCase{
+			ds:  ds1.Except(ds2),
+			err: "depiq: dialect does not support EXCEPT compound expressions [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ds1.ExceptAll(ds2),
+			err: "depiq: dialect does not support EXCEPT ALL compound expressions [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestAggregateClauses() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(depiq.SUM("a").Filter(depiq.C("b").Gt(10))),
+			sql: "SELECT SUM(CASE  WHEN (`b` > 10) THEN `a` END) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.COUNT(depiq.Star()).Filter(depiq.C("b").Gt(10))),
+			sql: "SELECT COUNT(CASE  WHEN (`b` > 10) THEN 1 END) FROM `test`",
+		},
+		sqlTestCase{
+			ds: ds.Select(depiq.Func("JSON_ARRAYAGG", depiq.C("a")).Filter(depiq.C("b").Gt(10))),
+			err: "depiq: dialect does not support FILTER clauses and JSON_ARRAYAGG cannot be safely rewritten " +
+				"as a CASE expression [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.Func("GROUP_CONCAT", depiq.C("a")).OrderBy(depiq.C("a").Asc())),
+			sql: "SELECT GROUP_CONCAT(`a` ORDER BY `a` ASC) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.Func("percentile_cont", 0.5).WithinGroup(depiq.C("a").Asc())),
+			err: "depiq: dialect does not support WITHIN GROUP clauses [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestGroupingSets() {
+	ds := mds.GetDs("test").Select("a", "b", depiq.GROUPING("a"), depiq.SUM("c"))
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.GroupBy(depiq.Rollup("a", "b")),
+			sql: "SELECT `a`, `b`, GROUPING(`a`), SUM(`c`) FROM `test` GROUP BY `a`, `b` WITH ROLLUP",
+		},
+		sqlTestCase{
+			ds:  ds.GroupBy("a", depiq.Rollup("b")),
+			err: "depiq: dialect only supports ROLLUP of plain columns as the only GROUP BY expression [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ds.GroupBy(depiq.Cube("a", "b")),
+			err: "depiq: dialect does not support CUBE [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ds.GroupBy(depiq.GroupingSets(depiq.GroupingSet("a"), depiq.GroupingSet())),
+			err: "depiq: dialect does not support GROUPING SETS [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestJSONExpressions() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(depiq.JSONPathText("data", "address", "city").Eq("NYC")),
+			sql: "SELECT * FROM `test` WHERE ((`data`->>'$.address.city') = 'NYC')",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.Ex{"data->tags->0": "a"}),
+			sql: "SELECT * FROM `test` WHERE (JSON_EXTRACT(`data`, '$.tags[0]') = 'a')",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.JSONContains("data", map[string]int{"a": 1})),
+			sql: "SELECT * FROM `test` WHERE JSON_CONTAINS(`data`, '{\\\"a\\\":1}')",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.JSONHasKey("data", "a")),
+			sql: "SELECT * FROM `test` WHERE (JSON_EXTRACT(`data`, '$.a') IS NOT NULL)",
+		},
+		sqlTestCase{
+			ds:  ds.Order(depiq.JSONArrayLength(depiq.JSONPath("data", "tags")).Desc()),
+			sql: "SELECT * FROM `test` ORDER BY JSON_LENGTH(JSON_EXTRACT(`data`, '$.tags')) DESC",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestArrayExpressions() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(depiq.ArrayContains("tags", []string{"a"})),
+			err: "depiq: boolean operator 'arraycontains' not supported",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.C("id").Eq(depiq.AnyOf([]int{1, 2}))),
+			err: "depiq: dialect does not support arrays [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestTextSearchExpressions() {
+	ds := mds.GetDs("posts")
+	search := depiq.TextSearch("cats", "title", "body")
+	mds.assertSQL(
+		sqlTestCase{
+			ds: ds.Where(search).Order(search.Rank().Desc()),
+			sql: "SELECT * FROM `posts` WHERE MATCH (`title`, `body`) AGAINST ('cats' IN NATURAL LANGUAGE MODE) " +
+				"ORDER BY MATCH (`title`, `body`) AGAINST ('cats' IN NATURAL LANGUAGE MODE) DESC",
+		},
+		sqlTestCase{
+			ds:  ds.Where(search.WithMode(exp.BooleanTextSearchMode)),
+			sql: "SELECT * FROM `posts` WHERE MATCH (`title`, `body`) AGAINST ('cats' IN BOOLEAN MODE)",
+		},
+		sqlTestCase{
+			ds:  ds.Where(search.WithConfig("english")),
+			err: "depiq: dialect does not support full-text search configurations [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestDateTimeExpressions() {
+	ds := mds.GetDs("events")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(depiq.C("created_at").Gt(depiq.DateSub(depiq.Now(), depiq.Interval(7, exp.DayUnit)))),
+			sql: "SELECT * FROM `events` WHERE (`created_at` > DATE_SUB(NOW(), INTERVAL 7 DAY))",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.DateAdd("created_at", depiq.IntervalFromDuration(90*time.Minute))),
+			sql: "SELECT DATE_ADD(`created_at`, INTERVAL 90 MINUTE) FROM `events`",
+		},
+		sqlTestCase{
+			ds: ds.Select(depiq.Extract(exp.YearUnit, "created_at"), depiq.DateTrunc(exp.MonthUnit, "created_at")),
+			sql: "SELECT EXTRACT(YEAR FROM `created_at`), " +
+				"CAST(DATE_FORMAT(`created_at`, '%Y-%m-01 00:00:00') AS DATETIME) FROM `events`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.Interval(5, exp.MillisecondUnit)),
+			err: "depiq: dialect does not support millisecond intervals [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestStringExpressions() {
+	ds := mds.GetDs("users")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(depiq.Concat(depiq.C("first"), " ", depiq.C("last")).As("name")),
+			sql: "SELECT CONCAT(`first`, ' ', `last`) AS `name` FROM `users`",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.Length("name").Gt(3), depiq.Position("name", "a").Eq(1)),
+			sql: "SELECT * FROM `users` WHERE ((CHAR_LENGTH(`name`) > 3) AND (POSITION('a' IN `name`) = 1))",
+		},
+		sqlTestCase{
+			ds:  ds.Order(depiq.C("name").Collate("utf8mb4_bin").Asc()),
+			sql: "SELECT * FROM `users` ORDER BY `name` COLLATE `utf8mb4_bin` ASC",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestWindowFrames() {
+	ds := depiq.Dialect("mysql8").From("test")
+	w := depiq.W().OrderBy("ts")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(depiq.SUM("x").Over(w.RowsBetween(depiq.Preceding(6), depiq.CurrentRow()))),
+			sql: "SELECT SUM(`x`) OVER (ORDER BY `ts` ROWS BETWEEN 6 PRECEDING AND CURRENT ROW) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.SUM("x").Over(w.Range(depiq.UnboundedPreceding()))),
+			sql: "SELECT SUM(`x`) OVER (ORDER BY `ts` RANGE UNBOUNDED PRECEDING) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.SUM("x").Over(w.Groups(depiq.UnboundedPreceding()))),
+			err: "depiq: dialect does not support GROUPS window frames [dialect=mysql8]",
+		},
+		sqlTestCase{
+			ds: ds.Select(depiq.SUM("x").Over(
+				w.RowsBetween(depiq.Preceding(1), depiq.Following(1)).Exclude(exp.TiesFrameExclusion),
+			)),
+			err: "depiq: dialect does not support window frame EXCLUDE TIES [dialect=mysql8]",
+		},
+	)
+}
+
 func TestDatasetAdapterSuite(t *testing.T) {
 	suite.Run(t, new(mysqlDialectSuite))
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/orn-id/depiq"
 	"github.com/orn-id/depiq/exp"
+	"github.com/orn-id/depiq/sqlgen"
 )
 
 func DialectOptions() *depiq.SQLDialectOptions {
@@ -22,7 +23,9 @@ func DialectOptions() *depiq.SQLDialectOptions {
 	opts.WrapCompoundsInParens = false
 	opts.SupportsDistinctOn = false
 	opts.SupportsWindowFunction = false
+	opts.SupportsWithinGroup = false
 	opts.SupportsLateral = false
+	opts.SupportsRowValueInList = false
 
 	opts.PlaceHolderFragment = []byte("?")
 	opts.IncludePlaceholderNum = false
@@ -32,24 +35,26 @@ func DialectOptions() *depiq.SQLDialectOptions {
 	opts.False = []byte("0")
 	opts.TimeFormat = time.RFC3339Nano
 	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
-		exp.EqOp:             []byte("="),
-		exp.NeqOp:            []byte("!="),
-		exp.GtOp:             []byte(">"),
-		exp.GteOp:            []byte(">="),
-		exp.LtOp:             []byte("<"),
-		exp.LteOp:            []byte("<="),
-		exp.InOp:             []byte("IN"),
-		exp.NotInOp:          []byte("NOT IN"),
-		exp.IsOp:             []byte("IS"),
-		exp.IsNotOp:          []byte("IS NOT"),
-		exp.LikeOp:           []byte("LIKE"),
-		exp.NotLikeOp:        []byte("NOT LIKE"),
-		exp.ILikeOp:          []byte("LIKE"),
-		exp.NotILikeOp:       []byte("NOT LIKE"),
-		exp.RegexpLikeOp:     []byte("REGEXP"),
-		exp.RegexpNotLikeOp:  []byte("NOT REGEXP"),
-		exp.RegexpILikeOp:    []byte("REGEXP"),
-		exp.RegexpNotILikeOp: []byte("NOT REGEXP"),
+		exp.EqOp:                []byte("="),
+		exp.NeqOp:               []byte("!="),
+		exp.GtOp:                []byte(">"),
+		exp.GteOp:               []byte(">="),
+		exp.LtOp:                []byte("<"),
+		exp.LteOp:               []byte("<="),
+		exp.InOp:                []byte("IN"),
+		exp.NotInOp:             []byte("NOT IN"),
+		exp.IsOp:                []byte("IS"),
+		exp.IsNotOp:             []byte("IS NOT"),
+		exp.LikeOp:              []byte("LIKE"),
+		exp.NotLikeOp:           []byte("NOT LIKE"),
+		exp.ILikeOp:             []byte("LIKE"),
+		exp.NotILikeOp:          []byte("NOT LIKE"),
+		exp.RegexpLikeOp:        []byte("REGEXP"),
+		exp.RegexpNotLikeOp:     []byte("NOT REGEXP"),
+		exp.RegexpILikeOp:       []byte("REGEXP"),
+		exp.RegexpNotILikeOp:    []byte("NOT REGEXP"),
+		exp.IsDistinctFromOp:    []byte("IS DISTINCT FROM"),
+		exp.IsNotDistinctFromOp: []byte("IS NOT DISTINCT FROM"),
 	}
 	opts.UseLiteralIsBools = false
 	opts.BitwiseOperatorLookup = map[exp.BitwiseOperation][]byte{
@@ -61,6 +66,74 @@ func DialectOptions() *depiq.SQLDialectOptions {
 	opts.EscapedRunes = map[rune][]byte{
 		'\'': []byte("''"),
 	}
+	opts.ExceptAllFragment = nil
+	opts.GroupingTypeLookup = map[exp.GroupingType][]byte{}
+	opts.QuantifierLookup = map[exp.QuantifierType][]byte{}
+	opts.SupportsArrays = false
+	opts.TextSearchStyle = sqlgen.FTS5TextSearchStyle
+	opts.TextSearchModeLookup = map[exp.TextSearchMode][]byte{
+		exp.NaturalLanguageTextSearchMode: []byte("MATCH"),
+		exp.BooleanTextSearchMode:         []byte("MATCH"),
+	}
+	opts.TextSearchRankFunction = []byte("bm25")
+	opts.DateTimeStyle = sqlgen.ModifierDateTimeStyle
+	opts.DateAddFunction = []byte("datetime")
+	opts.ExtractFunction = []byte("strftime")
+	opts.ExtractCastType = []byte("INTEGER")
+	opts.DateTruncFunction = []byte("strftime")
+	opts.PositionStyle = sqlgen.StringFirstPositionStyle
+	opts.StringFunctionLookup = map[exp.StringOperation][]byte{
+		exp.LowerOp:     []byte("LOWER"),
+		exp.UpperOp:     []byte("UPPER"),
+		exp.TrimOp:      []byte("TRIM"),
+		exp.SubstringOp: []byte("SUBSTR"),
+		exp.LengthOp:    []byte("LENGTH"),
+		exp.PositionOp:  []byte("INSTR"),
+		exp.ReplaceOp:   []byte("REPLACE"),
+	}
+	opts.IntervalUnitLookup = map[exp.DateTimeUnit][]byte{
+		exp.SecondUnit: []byte("seconds"),
+		exp.MinuteUnit: []byte("minutes"),
+		exp.HourUnit:   []byte("hours"),
+		exp.DayUnit:    []byte("days"),
+		exp.MonthUnit:  []byte("months"),
+		exp.YearUnit:   []byte("years"),
+	}
+	opts.ExtractFieldLookup = map[exp.DateTimeUnit][]byte{
+		exp.SecondUnit:    []byte("%S"),
+		exp.MinuteUnit:    []byte("%M"),
+		exp.HourUnit:      []byte("%H"),
+		exp.DayUnit:       []byte("%d"),
+		exp.WeekUnit:      []byte("%W"),
+		exp.MonthUnit:     []byte("%m"),
+		exp.YearUnit:      []byte("%Y"),
+		exp.DayOfWeekUnit: []byte("%w"),
+		exp.DayOfYearUnit: []byte("%j"),
+		exp.EpochUnit:     []byte("%s"),
+	}
+	opts.DateTruncLookup = map[exp.DateTimeUnit][]byte{
+		exp.SecondUnit: []byte("%Y-%m-%d %H:%M:%S"),
+		exp.MinuteUnit: []byte("%Y-%m-%d %H:%M:00"),
+		exp.HourUnit:   []byte("%Y-%m-%d %H:00:00"),
+		exp.DayUnit:    []byte("%Y-%m-%d 00:00:00"),
+		exp.MonthUnit:  []byte("%Y-%m-01 00:00:00"),
+		exp.YearUnit:   []byte("%Y-01-01 00:00:00"),
+	}
+	opts.CurrentTimeLookup = map[exp.CurrentTimeType][]byte{
+		exp.NowCurrentTimeType:       []byte("CURRENT_TIMESTAMP"),
+		exp.TimestampCurrentTimeType: []byte("CURRENT_TIMESTAMP"),
+		exp.DateCurrentTimeType:      []byte("CURRENT_DATE"),
+		exp.TimeCurrentTimeType:      []byte("CURRENT_TIME"),
+	}
+	opts.UseJSONPathString = true
+	opts.JSONHasKeyIsNotNull = true
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{}
+	opts.JSONFunctionLookup = map[exp.JSONOperation][]byte{
+		exp.JSONExtractOp:     []byte("json_extract"),
+		exp.JSONExtractTextOp: []byte("json_extract"),
+		exp.JSONHasKeyOp:      []byte("json_type"),
+		exp.JSONArrayLengthOp: []byte("json_array_length"),
+	}
 	opts.InsertIgnoreClause = []byte("INSERT OR IGNORE INTO ")
 	opts.ConflictFragment = []byte(" ON CONFLICT ")
 	opts.ConflictDoUpdateFragment = []byte(" DO UPDATE SET ")
@@ -75,6 +75,137 @@ func (sds *sqlite3DialectSuite) TestCompoundExpressions() {
 		sqlTestCase{ds: ds1.Union(ds2), sql: "SELECT `a` FROM `test` UNION SELECT `b` FROM `test2`"},
 		sqlTestCase{ds: ds1.UnionAll(ds2), sql: "SELECT `a` FROM `test` UNION ALL SELECT `b` FROM `test2`"},
 		sqlTestCase{ds: ds1.Intersect(ds2), sql: "SELECT `a` FROM `test` INTERSECT SELECT `b` FROM `test2`"},
+		sqlTestCase{ds: ds1.Except(ds2), sql: "SELECT `a` FROM `test` EXCEPT SELECT `b` FROM `test2`"},
+		sqlTestCase{
+			ds:  ds1.ExceptAll(ds2),
+			err: "depiq: dialect does not support EXCEPT ALL compound expressions [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestSubqueryExpressions() {
+	ds := sds.GetDs("test")
+	sub := sds.GetDs("test2").Select("b")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(depiq.Exists(sub.Where(depiq.I("test2.a").Eq(depiq.I("test.a"))))),
+			sql: "SELECT * FROM `test` WHERE EXISTS (SELECT `b` FROM `test2` WHERE (`test2`.`a` = `test`.`a`))",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.NotExists(sub)),
+			sql: "SELECT * FROM `test` WHERE NOT EXISTS (SELECT `b` FROM `test2`)",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.C("a").Gt(depiq.AnyOf(sub))),
+			err: "depiq: dialect does not support ANY comparisons [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestRowValues() {
+	ds := sds.GetDs("test")
+	t := depiq.Tuple(depiq.C("a"), depiq.C("b"))
+	sds.assertSQL(
+		sqlTestCase{ds: ds.Where(t.Gt([]int{1, 2})), sql: "SELECT * FROM `test` WHERE ((`a`, `b`) > (1, 2))"},
+		sqlTestCase{
+			ds:  ds.Where(t.In([][]int{{1, 2}, {1, 3}})),
+			sql: "SELECT * FROM `test` WHERE (((`a` = 1) AND (`b` = 2)) OR ((`a` = 1) AND (`b` = 3)))",
+		},
+		sqlTestCase{
+			ds:  ds.Where(t.In(sds.GetDs("test2").Select("a", "b"))),
+			sql: "SELECT * FROM `test` WHERE ((`a`, `b`) IN ((SELECT `a`, `b` FROM `test2`)))",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestJSONExpressions() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(depiq.Ex{"data->address->>city": "NYC"}),
+			sql: "SELECT * FROM `test` WHERE (json_extract(`data`, '$.address.city') = 'NYC')",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.JSONHasKey("data", "a")),
+			sql: "SELECT * FROM `test` WHERE (json_type(`data`, '$.a') IS NOT NULL)",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.JSONArrayLength("data")),
+			sql: "SELECT json_array_length(`data`) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.JSONContains("data", `{"a":1}`)),
+			err: "depiq: dialect does not support JSON contains expressions [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestArrayExpressions() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(depiq.ArrayOverlaps("tags", []string{"a"})),
+			err: "depiq: boolean operator 'arrayoverlaps' not supported",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.Array(1, 2)),
+			err: "depiq: dialect does not support arrays [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestTextSearchExpressions() {
+	ds := sds.GetDs("docs")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(depiq.TextSearch("cats", "docs")).Order(depiq.TextSearchRank("cats", "docs").Asc()),
+			sql: "SELECT * FROM `docs` WHERE (`docs` MATCH 'cats') ORDER BY bm25(`docs`) ASC",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.TextSearch("cats", "title", "body")),
+			err: "depiq: dialect requires a single full-text search document got 2 [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestDateTimeExpressions() {
+	ds := sds.GetDs("events")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(depiq.C("created_at").Gt(depiq.DateSub(depiq.Now(), depiq.Interval(7, exp.DayUnit)))),
+			sql: "SELECT * FROM `events` WHERE (`created_at` > datetime(CURRENT_TIMESTAMP, '-7 days'))",
+		},
+		sqlTestCase{
+			ds: ds.Select(depiq.Extract(exp.YearUnit, "created_at"), depiq.DateTrunc(exp.MonthUnit, "created_at")),
+			sql: "SELECT CAST(strftime('%Y', `created_at`) AS INTEGER), " +
+				"strftime('%Y-%m-01 00:00:00', `created_at`) FROM `events`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.DateAdd("created_at", depiq.Interval(1, exp.WeekUnit))),
+			sql: "SELECT datetime(`created_at`, '+7 days') FROM `events`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.Interval(7, exp.DayUnit)),
+			err: "depiq: dialect does not support INTERVAL literals, use DateAdd or DateSub [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestStringExpressions() {
+	ds := sds.GetDs("users")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(depiq.Concat(depiq.C("first"), " ", depiq.C("last")).As("name")),
+			sql: "SELECT (`first` || ' ' || `last`) AS `name` FROM `users`",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.Substring("name", 1, 2).Eq("ab"), depiq.Position("name", "a").Eq(1)),
+			sql: "SELECT * FROM `users` WHERE ((SUBSTR(`name`, 1, 2) = 'ab') AND (INSTR(`name`, 'a') = 1))",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.C("name").Collate("NOCASE").Eq("bob")),
+			sql: "SELECT * FROM `users` WHERE (`name` COLLATE `NOCASE` = 'bob')",
+		},
 	)
 }
 
@@ -121,6 +252,14 @@ func (sds *sqlite3DialectSuite) TestBooleanOperations() {
 		sqlTestCase{ds: ds.Where(depiq.C("a").IsNot(false)), sql: "SELECT * FROM `test` WHERE (`a` IS NOT 0)"},
 		sqlTestCase{ds: ds.Where(depiq.C("a").IsNotTrue()), sql: "SELECT * FROM `test` WHERE (`a` IS NOT 1)"},
 		sqlTestCase{ds: ds.Where(depiq.C("a").IsNotFalse()), sql: "SELECT * FROM `test` WHERE (`a` IS NOT 0)"},
+		sqlTestCase{
+			ds:  ds.Where(depiq.C("a").IsDistinctFrom(depiq.C("b"))),
+			sql: "SELECT * FROM `test` WHERE (`a` IS DISTINCT FROM `b`)",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.C("a").IsNotDistinctFrom(depiq.C("b"))),
+			sql: "SELECT * FROM `test` WHERE (`a` IS NOT DISTINCT FROM `b`)",
+		},
 		sqlTestCase{ds: ds.Where(depiq.C("a").Like("a%")), sql: "SELECT * FROM `test` WHERE (`a` LIKE 'a%')"},
 		sqlTestCase{ds: ds.Where(depiq.C("a").NotLike("a%")), sql: "SELECT * FROM `test` WHERE (`a` NOT LIKE 'a%')"},
 		sqlTestCase{ds: ds.Where(depiq.C("a").ILike("a%")), sql: "SELECT * FROM `test` WHERE (`a` LIKE 'a%')"},
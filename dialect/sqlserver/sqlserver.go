@@ -24,6 +24,10 @@ func DialectOptions() *depiq.SQLDialectOptions {
 	opts.SupportsWithCTERecursive = false
 	opts.SupportsDistinctOn = false
 	opts.SupportsWindowFunction = false
+	opts.SupportsAggregateFilter = false
+	opts.SupportsAggregateOrderBy = false
+	opts.SupportsRowValueComparison = false
+	opts.SupportsRowValueInList = false
 	opts.SurroundLimitWithParentheses = true
 
 	opts.PlaceHolderFragment = []byte("@p")
@@ -59,8 +63,92 @@ func DialectOptions() *depiq.SQLDialectOptions {
 		exp.BitwiseAndOp:       []byte("&"),
 		exp.BitwiseXorOp:       []byte("^"),
 	}
+	opts.WindowFrameUnitLookup = map[exp.WindowFrameUnit][]byte{
+		exp.RowsFrameUnit:  []byte("ROWS"),
+		exp.RangeFrameUnit: []byte("RANGE"),
+	}
+	opts.WindowFrameExclusionLookup = map[exp.WindowFrameExclusion][]byte{}
+	opts.SupportsArrays = false
+	opts.TextSearchStyle = sqlgen.ContainsTextSearchStyle
+	opts.TextSearchModeLookup = map[exp.TextSearchMode][]byte{
+		exp.NaturalLanguageTextSearchMode: []byte("FREETEXT"),
+		exp.BooleanTextSearchMode:         []byte("CONTAINS"),
+	}
+	opts.TextSearchRankFunction = nil
+	opts.TextSearchLanguageFragment = []byte(", LANGUAGE ")
+	opts.DateTimeStyle = sqlgen.DatePartDateTimeStyle
+	opts.DateAddFunction = []byte("DATEADD")
+	opts.ExtractFunction = []byte("DATEPART")
+	// DATETRUNC requires SQL Server 2022 (16.x) or later
+	opts.DateTruncFunction = []byte("DATETRUNC")
+	opts.ConcatOperator = []byte("+")
+	opts.PositionStyle = sqlgen.SubstringFirstPositionStyle
+	opts.SubstringRequiresLength = true
+	opts.QuoteCollations = false
+	opts.StringFunctionLookup = map[exp.StringOperation][]byte{
+		exp.ConcatOp:    []byte("CONCAT"),
+		exp.LowerOp:     []byte("LOWER"),
+		exp.UpperOp:     []byte("UPPER"),
+		exp.TrimOp:      []byte("TRIM"),
+		exp.SubstringOp: []byte("SUBSTRING"),
+		exp.LengthOp:    []byte("LEN"),
+		exp.PositionOp:  []byte("CHARINDEX"),
+		exp.ReplaceOp:   []byte("REPLACE"),
+	}
+	opts.IntervalUnitLookup = map[exp.DateTimeUnit][]byte{
+		exp.MicrosecondUnit: []byte("microsecond"),
+		exp.MillisecondUnit: []byte("millisecond"),
+		exp.SecondUnit:      []byte("second"),
+		exp.MinuteUnit:      []byte("minute"),
+		exp.HourUnit:        []byte("hour"),
+		exp.DayUnit:         []byte("day"),
+		exp.WeekUnit:        []byte("week"),
+		exp.MonthUnit:       []byte("month"),
+		exp.QuarterUnit:     []byte("quarter"),
+		exp.YearUnit:        []byte("year"),
+	}
+	opts.ExtractFieldLookup = map[exp.DateTimeUnit][]byte{
+		exp.MicrosecondUnit: []byte("microsecond"),
+		exp.MillisecondUnit: []byte("millisecond"),
+		exp.SecondUnit:      []byte("second"),
+		exp.MinuteUnit:      []byte("minute"),
+		exp.HourUnit:        []byte("hour"),
+		exp.DayUnit:         []byte("day"),
+		exp.WeekUnit:        []byte("week"),
+		exp.MonthUnit:       []byte("month"),
+		exp.QuarterUnit:     []byte("quarter"),
+		exp.YearUnit:        []byte("year"),
+		exp.DayOfWeekUnit:   []byte("weekday"),
+		exp.DayOfYearUnit:   []byte("dayofyear"),
+	}
+	opts.DateTruncLookup = map[exp.DateTimeUnit][]byte{
+		exp.MicrosecondUnit: []byte("microsecond"),
+		exp.MillisecondUnit: []byte("millisecond"),
+		exp.SecondUnit:      []byte("second"),
+		exp.MinuteUnit:      []byte("minute"),
+		exp.HourUnit:        []byte("hour"),
+		exp.DayUnit:         []byte("day"),
+		exp.WeekUnit:        []byte("week"),
+		exp.MonthUnit:       []byte("month"),
+		exp.QuarterUnit:     []byte("quarter"),
+		exp.YearUnit:        []byte("year"),
+	}
+	opts.CurrentTimeLookup = map[exp.CurrentTimeType][]byte{
+		exp.NowCurrentTimeType:       []byte("SYSDATETIME()"),
+		exp.TimestampCurrentTimeType: []byte("CURRENT_TIMESTAMP"),
+		exp.DateCurrentTimeType:      []byte("CAST(GETDATE() AS DATE)"),
+		exp.TimeCurrentTimeType:      []byte("CAST(GETDATE() AS TIME)"),
+	}
+	opts.UseJSONPathString = true
+	opts.JSONHasKeyIsNotNull = true
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{}
+	opts.JSONFunctionLookup = map[exp.JSONOperation][]byte{
+		exp.JSONExtractOp:     []byte("JSON_QUERY"),
+		exp.JSONExtractTextOp: []byte("JSON_VALUE"),
+	}
 
 	opts.FetchFragment = []byte(" FETCH FIRST ")
+	opts.ExceptAllFragment = nil
 
 	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
 		sqlgen.CommonTableSQLFragment,
@@ -55,6 +55,165 @@ func (sds *sqlserverDialectSuite) TestBitwiseOperations() {
 	)
 }
 
+func (sds *sqlserverDialectSuite) TestCompoundExpressions() {
+	ds1 := sds.GetDs("test").Select("a")
+	ds2 := sds.GetDs("test2").Select("b")
+	sds.assertSQL(
+		sqlTestCase{ds: ds1.Except(ds2), sql: "SELECT \"a\" FROM \"test\" EXCEPT (SELECT \"b\" FROM \"test2\")"},
+		sqlTestCase{
+			ds:  ds1.ExceptAll(ds2),
+			err: "depiq: dialect does not support EXCEPT ALL compound expressions [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestDistinctFrom() {
+	col := depiq.C("a")
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds: ds.Where(col.IsDistinctFrom(depiq.C("b"))),
+			sql: "SELECT * FROM \"test\" WHERE (((\"a\" != \"b\") OR (\"a\" IS NULL) OR (\"b\" IS NULL)) AND " +
+				"((\"a\" IS NOT NULL) OR (\"b\" IS NOT NULL)))",
+		},
+		sqlTestCase{
+			ds: ds.Where(col.IsNotDistinctFrom(depiq.C("b"))),
+			sql: "SELECT * FROM \"test\" WHERE (((\"a\" = \"b\") AND (\"a\" IS NOT NULL) AND (\"b\" IS NOT NULL)) OR " +
+				"((\"a\" IS NULL) AND (\"b\" IS NULL)))",
+		},
+		sqlTestCase{ds: ds.Where(col.IsNotDistinctFrom(nil)), sql: "SELECT * FROM \"test\" WHERE (\"a\" IS NULL)"},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestJSONExpressions() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(depiq.JSONPathText("data", "address", "city").Eq("NYC")),
+			sql: "SELECT * FROM \"test\" WHERE (JSON_VALUE(\"data\", '$.address.city') = 'NYC')",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.JSONPath("data", "tags")),
+			sql: "SELECT JSON_QUERY(\"data\", '$.tags') FROM \"test\"",
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.JSONHasKey("data", "a")),
+			err: "depiq: dialect does not support JSON has key expressions [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestArrayExpressions() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(depiq.ArrayContainedBy("tags", []string{"a"})),
+			err: "depiq: boolean operator 'arraycontainedby' not supported",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.Array(1, 2)),
+			err: "depiq: dialect does not support arrays [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestTextSearchExpressions() {
+	ds := sds.GetDs("posts")
+	search := depiq.TextSearch("cats", "title", "body")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(search),
+			sql: `SELECT * FROM "posts" WHERE FREETEXT(("title", "body"), 'cats')`,
+		},
+		sqlTestCase{
+			ds:  ds.Where(search.WithMode(exp.BooleanTextSearchMode).WithConfig("English")),
+			sql: `SELECT * FROM "posts" WHERE CONTAINS(("title", "body"), 'cats', LANGUAGE 'English')`,
+		},
+		sqlTestCase{
+			ds:  ds.Order(search.Rank().Desc()),
+			err: "depiq: dialect does not support full-text search ranking [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestDateTimeExpressions() {
+	ds := sds.GetDs("events")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(depiq.C("created_at").Gt(depiq.DateSub(depiq.Now(), depiq.Interval(7, exp.DayUnit)))),
+			sql: `SELECT * FROM "events" WHERE ("created_at" > DATEADD(day, -7, SYSDATETIME()))`,
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.Extract(exp.DayOfWeekUnit, "created_at"), depiq.DateTrunc(exp.MonthUnit, "created_at")),
+			sql: `SELECT DATEPART(weekday, "created_at"), DATETRUNC(month, "created_at") FROM "events"`,
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.C("day").Eq(depiq.CurrentDate())),
+			sql: `SELECT * FROM "events" WHERE ("day" = CAST(GETDATE() AS DATE))`,
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestStringExpressions() {
+	ds := sds.GetDs("users")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(depiq.Concat(depiq.C("first"), " ", depiq.C("last")).As("name")),
+			sql: `SELECT ("first" + ' ' + "last") AS "name" FROM "users"`,
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.Length("name"), depiq.SubstringFrom("name", 2), depiq.Position("name", "a")),
+			sql: `SELECT LEN("name"), SUBSTRING("name", 2, LEN("name")), CHARINDEX('a', "name") FROM "users"`,
+		},
+		sqlTestCase{
+			ds:  ds.Where(depiq.C("name").Collate("Latin1_General_CS_AS").Eq("Bob")),
+			sql: `SELECT * FROM "users" WHERE ("name" COLLATE Latin1_General_CS_AS = 'Bob')`,
+		},
+		sqlTestCase{
+			ds: ds.Where(depiq.C("name").Collate("Latin1_General_CS_AS = 'Bob'; --").Eq("Bob")),
+			err: `depiq: invalid collation name "Latin1_General_CS_AS = 'Bob'; --", ` +
+				`unquoted collations may only contain letters, digits and _`,
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestRowValues() {
+	ds := sds.GetDs("test")
+	t := depiq.Tuple(depiq.C("a"), depiq.C("b"))
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(t.Gt([]int{1, 2})),
+			sql: "SELECT * FROM \"test\" WHERE ((\"a\" > 1) OR ((\"a\" = 1) AND (\"b\" > 2)))",
+		},
+		sqlTestCase{
+			ds:  ds.Where(t.In([][]int{{1, 2}, {1, 3}})),
+			sql: "SELECT * FROM \"test\" WHERE (((\"a\" = 1) AND (\"b\" = 2)) OR ((\"a\" = 1) AND (\"b\" = 3)))",
+		},
+		sqlTestCase{
+			ds:  ds.Where(t.In(sds.GetDs("test2").Select("a", "b"))),
+			err: "depiq: dialect does not support row value in comparisons [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestAggregateClauses() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(depiq.AVG("a").Filter(depiq.C("b").Gt(10))),
+			sql: "SELECT AVG(CASE  WHEN (\"b\" > 10) THEN \"a\" END) FROM \"test\"",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.Func("STRING_AGG", depiq.C("a"), ",").OrderBy(depiq.C("a").Asc())),
+			err: "depiq: dialect does not support ORDER BY in aggregate function calls [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  ds.Select(depiq.Func("STRING_AGG", depiq.C("a"), ",").WithinGroup(depiq.C("a").Asc())),
+			sql: "SELECT STRING_AGG(\"a\", ',') WITHIN GROUP (ORDER BY \"a\" ASC) FROM \"test\"",
+		},
+	)
+}
+
 func TestDatasetAdapterSuite(t *testing.T) {
 	suite.Run(t, new(sqlserverDialectSuite))
 }
@@ -5,6 +5,7 @@ import (
 	gsql "database/sql"
 	"reflect"
 
+	"github.com/orn-id/depiq/exp"
 	"github.com/orn-id/depiq/internal/errors"
 	"github.com/orn-id/depiq/internal/util"
 )
@@ -24,6 +25,7 @@ var (
 	errUnsupportedScanValsType    = errors.New("type must be a pointer to a slice when scanning into vals")
 	errScanValPointer             = errors.New("type must be a pointer when scanning into val")
 	errScanValNonSlice            = errors.New("type cannot be a pointer to a slice when scanning into val")
+	errMapScannerNotSupported     = errors.New("scanner does not support scanning into maps, see MapScanner")
 )
 
 func newQueryExecutor(de DbExecutor, err error, query string, args ...interface{}) QueryExecutor {
@@ -87,6 +89,48 @@ func (q QueryExecutor) ScanStructsContext(ctx context.Context, i interface{}) er
 	return scanner.ScanStructs(i)
 }
 
+// This will execute the SQL and append results to the slice like ScanStructs, except the column col is scanned into
+// dest instead of a field of the structs (e.g. a COUNT(*) OVER () column selected next to the columns of the structs)
+//    var myStructs []MyStruct
+//    var total int64
+//    err := db.From("test").
+//        Select(&myStructs).
+//        SelectAppend(depiq.COUNT("*").Over(depiq.W()).As("total")).
+//        Executor().
+//        ScanStructsWithColumn(&myStructs, "total", &total)
+//
+// i: A pointer to a slice of structs.
+//
+// col: The name of the column that is scanned into dest.
+//
+// dest: A pointer to the value of col, it holds the value of the last row.
+func (q QueryExecutor) ScanStructsWithColumn(i interface{}, col string, dest interface{}) error {
+	return q.ScanStructsWithColumnContext(context.Background(), i, col, dest)
+}
+
+// This will execute the SQL and append results to the slice like ScanStructsContext, except the column col is scanned
+// into dest instead of a field of the structs, see ScanStructsWithColumn
+//
+// i: A pointer to a slice of structs.
+//
+// col: The name of the column that is scanned into dest.
+//
+// dest: A pointer to the value of col, it holds the value of the last row.
+func (q QueryExecutor) ScanStructsWithColumnContext(
+	ctx context.Context, i interface{}, col string, dest interface{},
+) error {
+	if _, err := checkScanStructsTarget(i); err != nil {
+		return err
+	}
+	rows, err := q.QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	s := &scanner{rows: rows}
+	defer func() { _ = s.Close() }()
+	return s.scanStructsWithExtras(i, map[string]interface{}{col: dest})
+}
+
 // This will execute the SQL and fill out the struct with the fields returned.
 // This method returns a boolean value that is false if no record was found
 //    var myStruct MyStruct
@@ -232,6 +276,138 @@ func (q QueryExecutor) ScanValContext(ctx context.Context, i interface{}) (bool,
 	return false, scanner.Err()
 }
 
+// This will execute the SQL and append a Record for each row to the slice. The values are normalized using the
+// database type of the columns, see MapScanner#ScanMap
+//    var records []exp.Record
+//    if err := db.From("test").Executor().ScanMaps(&records); err != nil{
+//        panic(err.Error()
+//    }
+//
+// rs: A pointer to a slice of Records.
+func (q QueryExecutor) ScanMaps(rs *[]exp.Record) error {
+	return q.ScanMapsContext(context.Background(), rs)
+}
+
+// This will execute the SQL and append a Record for each row to the slice. The values are normalized using the
+// database type of the columns, see MapScanner#ScanMap
+//    var records []exp.Record
+//    if err := db.From("test").Executor().ScanMapsContext(ctx, &records); err != nil{
+//        panic(err.Error()
+//    }
+//
+// rs: A pointer to a slice of Records.
+func (q QueryExecutor) ScanMapsContext(ctx context.Context, rs *[]exp.Record) error {
+	scanner, err := q.mapScannerContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = scanner.Close() }()
+	return scanner.ScanMaps(rs)
+}
+
+// This will execute the SQL and scan the first row into the Record. This method will return false if no record is
+// found.
+//    var record exp.Record
+//    found, err := db.From("test").Limit(1).Executor().ScanMap(&record)
+//    if err != nil{
+//        panic(err.Error()
+//    }
+//    if !found{
+//        fmt.Println("NOT FOUND")
+//    }
+//
+// r: A pointer to a Record.
+func (q QueryExecutor) ScanMap(r *exp.Record) (bool, error) {
+	return q.ScanMapContext(context.Background(), r)
+}
+
+// This will execute the SQL and scan the first row into the Record. This method will return false if no record is
+// found.
+//    var record exp.Record
+//    found, err := db.From("test").Limit(1).Executor().ScanMapContext(ctx, &record)
+//    if err != nil{
+//        panic(err.Error()
+//    }
+//    if !found{
+//        fmt.Println("NOT FOUND")
+//    }
+//
+// r: A pointer to a Record.
+func (q QueryExecutor) ScanMapContext(ctx context.Context, r *exp.Record) (bool, error) {
+	scanner, err := q.mapScannerContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = scanner.Close() }()
+	if scanner.Next() {
+		if err := scanner.ScanMap(r); err != nil {
+			return false, err
+		}
+		return true, scanner.Err()
+	}
+	return false, scanner.Err()
+}
+
+// Same as ScanMaps but the columns of the rows are kept in the order of the query, see MapScanner#ScanOrderedMap
+//
+// rs: A pointer to a slice of OrderedRecords.
+func (q QueryExecutor) ScanOrderedMaps(rs *[]exp.OrderedRecord) error {
+	return q.ScanOrderedMapsContext(context.Background(), rs)
+}
+
+// Same as ScanMapsContext but the columns of the rows are kept in the order of the query, see
+// MapScanner#ScanOrderedMap
+//
+// rs: A pointer to a slice of OrderedRecords.
+func (q QueryExecutor) ScanOrderedMapsContext(ctx context.Context, rs *[]exp.OrderedRecord) error {
+	scanner, err := q.mapScannerContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = scanner.Close() }()
+	return scanner.ScanOrderedMaps(rs)
+}
+
+// Same as ScanMap but the columns of the row are kept in the order of the query, see MapScanner#ScanOrderedMap
+//
+// r: A pointer to an OrderedRecord.
+func (q QueryExecutor) ScanOrderedMap(r *exp.OrderedRecord) (bool, error) {
+	return q.ScanOrderedMapContext(context.Background(), r)
+}
+
+// Same as ScanMapContext but the columns of the row are kept in the order of the query, see
+// MapScanner#ScanOrderedMap
+//
+// r: A pointer to an OrderedRecord.
+func (q QueryExecutor) ScanOrderedMapContext(ctx context.Context, r *exp.OrderedRecord) (bool, error) {
+	scanner, err := q.mapScannerContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = scanner.Close() }()
+	if scanner.Next() {
+		if err := scanner.ScanOrderedMap(r); err != nil {
+			return false, err
+		}
+		return true, scanner.Err()
+	}
+	return false, scanner.Err()
+}
+
+// returns the Scanner of the query as a MapScanner, the rows are closed if the Scanner is not a MapScanner
+func (q QueryExecutor) mapScannerContext(ctx context.Context) (MapScanner, error) {
+	scanner, err := q.ScannerContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ms, ok := scanner.(MapScanner)
+	if !ok {
+		_ = scanner.Close()
+		return nil, errMapScannerNotSupported
+	}
+	return ms, nil
+}
+
 // Scanner will return a Scanner that can be used for manually scanning rows.
 func (q QueryExecutor) Scanner() (Scanner, error) {
 	return q.ScannerContext(context.Background())
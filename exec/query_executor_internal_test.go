@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/orn-id/depiq/exp"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -1050,6 +1051,52 @@ func (qes *queryExecutorSuite) TestScanStruct_taggedStructs() {
 	}, item)
 }
 
+func (qes *queryExecutorSuite) TestScanStructsWithColumn() {
+	type StructWithTags struct {
+		Address string `db:"address"`
+		Name    string `db:"name"`
+	}
+
+	db, mock, err := sqlmock.New()
+	qes.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WillReturnError(fmt.Errorf("queryExecutor error"))
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "total", "name"}).
+			AddRow(testAddr1, 2, testName1).
+			AddRow(testAddr2, 2, testName2))
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "other", "name"}).AddRow(testAddr1, 2, testName1))
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	var items []StructWithTags
+	var total int64
+	qes.Equal(errUnsupportedScanStructsType, e.ScanStructsWithColumn(items, "total", &total))
+	qes.Equal(errUnsupportedScanStructsType, e.ScanStructsWithColumn(&StructWithTags{}, "total", &total))
+
+	qes.EqualError(e.ScanStructsWithColumn(&items, "total", &total), "queryExecutor error")
+
+	qes.NoError(e.ScanStructsWithColumn(&items, "total", &total))
+	qes.Equal([]StructWithTags{
+		{Address: testAddr1, Name: testName1},
+		{Address: testAddr2, Name: testName2},
+	}, items)
+	qes.Equal(int64(2), total)
+
+	items = nil
+	qes.Equal(
+		unableToFindFieldError("other"),
+		e.ScanStructsWithColumn(&items, "total", &total),
+	)
+	qes.NoError(mock.ExpectationsWereMet())
+}
+
 func (qes *queryExecutorSuite) TestScanVals() {
 	db, mock, err := sqlmock.New()
 	qes.NoError(err)
@@ -1242,6 +1289,105 @@ func (qes *queryExecutorSuite) TestScanVal_withValuerSlice() {
 	qes.Equal(JSONBoolArray{true, false, true}, bools)
 }
 
+func (qes *queryExecutorSuite) TestScanMaps() {
+	db, mock, err := sqlmock.New()
+	qes.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WillReturnError(fmt.Errorf("queryExecutor error"))
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(
+			sqlmock.NewColumn("name").OfType("TEXT", ""),
+			sqlmock.NewColumn("price").OfType("NUMERIC", ""),
+		).
+			AddRow([]byte(testName1), []byte("1.50")).
+			AddRow([]byte(testName2), []byte("2.50")))
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	var records []exp.Record
+	qes.EqualError(e.ScanMaps(&records), "queryExecutor error")
+
+	qes.NoError(e.ScanMaps(&records))
+	qes.Equal([]exp.Record{
+		{"name": testName1, "price": Decimal("1.50")},
+		{"name": testName2, "price": Decimal("2.50")},
+	}, records)
+}
+
+func (qes *queryExecutorSuite) TestScanMap() {
+	db, mock, err := sqlmock.New()
+	qes.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WillReturnError(fmt.Errorf("queryExecutor error"))
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).RowError(0, fmt.Errorf("row error")).AddRow(testName1))
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name", "address"}).AddRow(testName1, testAddr1))
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	var record exp.Record
+	found, err := e.ScanMap(&record)
+	qes.EqualError(err, "queryExecutor error")
+	qes.False(found)
+
+	found, err = e.ScanMap(&record)
+	qes.EqualError(err, "row error")
+	qes.False(found)
+
+	found, err = e.ScanMap(&record)
+	qes.NoError(err)
+	qes.False(found)
+	qes.Nil(record)
+
+	found, err = e.ScanMap(&record)
+	qes.NoError(err)
+	qes.True(found)
+	qes.Equal(exp.Record{"name": testName1, "address": testAddr1}, record)
+}
+
+func (qes *queryExecutorSuite) TestScanOrderedMap() {
+	db, mock, err := sqlmock.New()
+	qes.NoError(err)
+
+	mock.ExpectQuery(`SELECT "name", "address" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name", "address"}).AddRow(testName1, testAddr1))
+
+	mock.ExpectQuery(`SELECT "name", "address" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name", "address"}).
+			AddRow(testName1, testAddr1).
+			AddRow(testName2, testAddr2))
+
+	e := newQueryExecutor(db, nil, `SELECT "name", "address" FROM "items"`)
+
+	var record exp.OrderedRecord
+	found, err := e.ScanOrderedMap(&record)
+	qes.NoError(err)
+	qes.True(found)
+	qes.Equal(exp.OrderedRecord{{Name: "name", Value: testName1}, {Name: "address", Value: testAddr1}}, record)
+
+	var records []exp.OrderedRecord
+	qes.NoError(e.ScanOrderedMaps(&records))
+	qes.Equal([]exp.OrderedRecord{
+		{{Name: "name", Value: testName1}, {Name: "address", Value: testAddr1}},
+		{{Name: "name", Value: testName2}, {Name: "address", Value: testAddr2}},
+	}, records)
+}
+
 func TestQueryExecutorSuite(t *testing.T) {
 	suite.Run(t, new(queryExecutorSuite))
 }
@@ -21,10 +21,21 @@ type (
 		Err() error
 	}
 
+	// MapScanner is a Scanner that can also scan rows into Records of their columns. The Scanner returned by
+	// NewScanner is a MapScanner.
+	MapScanner interface {
+		Scanner
+		ScanMap(r *exp.Record) error
+		ScanMaps(rs *[]exp.Record) error
+		ScanOrderedMap(r *exp.OrderedRecord) error
+		ScanOrderedMaps(rs *[]exp.OrderedRecord) error
+	}
+
 	scanner struct {
-		rows      *sql.Rows
-		columnMap util.ColumnMap
-		columns   []string
+		rows        *sql.Rows
+		columnMap   util.ColumnMap
+		columns     []string
+		columnTypes []*sql.ColumnType
 	}
 )
 
@@ -37,6 +48,11 @@ func NewScanner(rows *sql.Rows) Scanner {
 	return &scanner{rows: rows}
 }
 
+// NewMapScanner returns a scanner that can be used for scanning rows into structs or Records.
+func NewMapScanner(rows *sql.Rows) MapScanner {
+	return &scanner{rows: rows}
+}
+
 // Next prepares the next row for Scanning. See sql.Rows#Next for more
 // information.
 func (s *scanner) Next() bool {
@@ -51,6 +67,11 @@ func (s *scanner) Err() error {
 
 // ScanStruct will scan the current row into i.
 func (s *scanner) ScanStruct(i interface{}) error {
+	return s.scanStruct(i, nil)
+}
+
+// scans the current row into i, the columns in extras are scanned into their destination instead of a field of i
+func (s *scanner) scanStruct(i interface{}, extras map[string]interface{}) error {
 	// Setup columnMap and columns, but only once.
 	if s.columnMap == nil || s.columns == nil {
 		cm, err := util.GetColumnMap(i)
@@ -69,6 +90,10 @@ func (s *scanner) ScanStruct(i interface{}) error {
 
 	scans := make([]interface{}, 0, len(s.columns))
 	for _, col := range s.columns {
+		if dest, ok := extras[col]; ok {
+			scans = append(scans, dest)
+			continue
+		}
 		data, ok := s.columnMap[col]
 		switch {
 		case !ok:
@@ -84,7 +109,9 @@ func (s *scanner) ScanStruct(i interface{}) error {
 
 	record := exp.Record{}
 	for index, col := range s.columns {
-		record[col] = scans[index]
+		if _, ok := extras[col]; !ok {
+			record[col] = scans[index]
+		}
 	}
 
 	util.AssignStructVals(i, record, s.columnMap)
@@ -103,6 +130,17 @@ func (s *scanner) ScanStructs(i interface{}) error {
 	})
 }
 
+// scans the rows into the slice of structs i, see scanStruct
+func (s *scanner) scanStructsWithExtras(i interface{}, extras map[string]interface{}) error {
+	val, err := checkScanStructsTarget(i)
+	if err != nil {
+		return err
+	}
+	return s.scanIntoSlice(val, func(i interface{}) error {
+		return s.scanStruct(i, extras)
+	})
+}
+
 // ScanVal will scan the current row and column into i.
 func (s *scanner) ScanVal(i interface{}) error {
 	if err := s.rows.Scan(i); err != nil {
@@ -123,12 +161,91 @@ func (s *scanner) ScanVals(i interface{}) error {
 	})
 }
 
+// ScanMap scans the current row into a Record of the columns of the row. The values are normalized using the
+// database type of the columns, e.g. []byte values of text columns are converted to a string and the values of
+// DECIMAL columns to a Decimal. See ScanOrderedMap to keep the order of the columns.
+func (s *scanner) ScanMap(r *exp.Record) error {
+	or, err := s.scanColumns()
+	if err != nil {
+		return err
+	}
+	*r = or.Record()
+	return nil
+}
+
+// ScanMaps appends a Record for each row to rs, see ScanMap
+func (s *scanner) ScanMaps(rs *[]exp.Record) error {
+	for s.Next() {
+		var r exp.Record
+		if err := s.ScanMap(&r); err != nil {
+			return err
+		}
+		*rs = append(*rs, r)
+	}
+	return s.Err()
+}
+
+// ScanOrderedMap scans the current row into an OrderedRecord that keeps the order of the columns of the row, the
+// values are normalized like ScanMap
+func (s *scanner) ScanOrderedMap(r *exp.OrderedRecord) error {
+	or, err := s.scanColumns()
+	if err != nil {
+		return err
+	}
+	*r = or
+	return nil
+}
+
+// ScanOrderedMaps appends an OrderedRecord for each row to rs, see ScanOrderedMap
+func (s *scanner) ScanOrderedMaps(rs *[]exp.OrderedRecord) error {
+	for s.Next() {
+		var r exp.OrderedRecord
+		if err := s.ScanOrderedMap(&r); err != nil {
+			return err
+		}
+		*rs = append(*rs, r)
+	}
+	return s.Err()
+}
+
 // Close closes the Rows, preventing further enumeration. See sql.Rows#Close
 // for more info.
 func (s *scanner) Close() error {
 	return s.rows.Close()
 }
 
+// scans the current row into the normalized values of its columns
+func (s *scanner) scanColumns() (exp.OrderedRecord, error) {
+	// Setup columns and columnTypes, but only once.
+	if s.columnTypes == nil {
+		cols, err := s.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		types, err := s.rows.ColumnTypes()
+		if err != nil {
+			return nil, err
+		}
+		s.columns = cols
+		s.columnTypes = types
+	}
+
+	vals := make([]interface{}, len(s.columns))
+	scans := make([]interface{}, len(s.columns))
+	for i := range vals {
+		scans[i] = &vals[i]
+	}
+	if err := s.rows.Scan(scans...); err != nil {
+		return nil, err
+	}
+
+	r := make(exp.OrderedRecord, len(s.columns))
+	for i, col := range s.columns {
+		r[i] = exp.RecordColumn{Name: col, Value: normalizeValue(s.columnTypes[i].DatabaseTypeName(), vals[i])}
+	}
+	return r, s.Err()
+}
+
 func (s *scanner) scanIntoSlice(val reflect.Value, it func(i interface{}) error) error {
 	elemType := util.GetSliceElementType(val)
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/orn-id/depiq/exp"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -67,3 +68,66 @@ func (s *scannerSuite) TestScanVals() {
 	s.Require().NoError(err)
 	s.Require().ElementsMatch([]int{1, 2}, result)
 }
+
+func (s *scannerSuite) TestNewScanner_isMapScanner() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	mock.ExpectQuery(`SELECT \* FROM "items"`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	rows, err := db.Query(`SELECT * FROM "items"`)
+	s.Require().NoError(err)
+
+	_, ok := NewScanner(rows).(MapScanner)
+	s.True(ok)
+}
+
+func (s *scannerSuite) TestScanMaps() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(
+			sqlmock.NewColumn("name").OfType("VARCHAR", ""),
+			sqlmock.NewColumn("price").OfType("DECIMAL", ""),
+			sqlmock.NewColumn("age").OfType("INT", int64(0)),
+			sqlmock.NewColumn("data").OfType("BLOB", []byte{}),
+		).
+			AddRow([]byte(testName1), []byte("10.50"), []byte("10"), []byte{1, 2}).
+			AddRow(testName2, "20.00", testAge2, nil),
+		)
+	rows, err := db.Query(`SELECT * FROM "items"`)
+	s.Require().NoError(err)
+
+	sc := NewMapScanner(rows)
+
+	var result []exp.Record
+	s.Require().NoError(sc.ScanMaps(&result))
+	s.Equal([]exp.Record{
+		{"name": testName1, "price": Decimal("10.50"), "age": testAge1, "data": []byte{1, 2}},
+		{"name": testName2, "price": Decimal("20.00"), "age": testAge2, "data": nil},
+	}, result)
+}
+
+func (s *scannerSuite) TestScanOrderedMaps() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+
+	mock.ExpectQuery(`SELECT "name", "address" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name", "address"}).
+			AddRow(testName1, testAddr1).
+			AddRow(testName2, testAddr2),
+		)
+	rows, err := db.Query(`SELECT "name", "address" FROM "items"`)
+	s.Require().NoError(err)
+
+	sc := NewMapScanner(rows)
+
+	var result []exp.OrderedRecord
+	s.Require().NoError(sc.ScanOrderedMaps(&result))
+	s.Equal([]exp.OrderedRecord{
+		{{Name: "name", Value: testName1}, {Name: "address", Value: testAddr1}},
+		{{Name: "name", Value: testName2}, {Name: "address", Value: testAddr2}},
+	}, result)
+	s.Equal([]string{"name", "address"}, result[0].Cols())
+}
@@ -0,0 +1,91 @@
+package exec
+
+import (
+	"database/sql/driver"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// The value of a DECIMAL or NUMERIC column scanned into a map (see MapScanner#ScanMap). The text of the value is kept
+// so no precision is lost, use Rat or Float64 to convert it to a number
+type Decimal string
+
+var (
+	decimalTypes = map[string]bool{
+		"DECIMAL": true, "NUMERIC": true, "NEWDECIMAL": true, "MONEY": true, "SMALLMONEY": true,
+	}
+	floatTypes = map[string]bool{
+		"FLOAT": true, "FLOAT4": true, "FLOAT8": true, "DOUBLE": true, "DOUBLE PRECISION": true, "REAL": true,
+	}
+	intTypes = map[string]bool{
+		"INT": true, "INTEGER": true, "TINYINT": true, "SMALLINT": true, "MEDIUMINT": true, "BIGINT": true,
+		"INT2": true, "INT4": true, "INT8": true, "UNSIGNED INT": true, "UNSIGNED TINYINT": true,
+		"UNSIGNED SMALLINT": true, "UNSIGNED MEDIUMINT": true, "UNSIGNED BIGINT": true, "YEAR": true,
+	}
+	binaryTypes = map[string]bool{
+		"BINARY": true, "VARBINARY": true, "BLOB": true, "TINYBLOB": true, "MEDIUMBLOB": true, "LONGBLOB": true,
+		"BYTEA": true, "IMAGE": true, "BIT": true, "GEOMETRY": true, "UNIQUEIDENTIFIER": true,
+	}
+)
+
+// Returns the decimal as a big.Rat, false if it is not a valid number
+func (d Decimal) Rat() (*big.Rat, bool) {
+	return new(big.Rat).SetString(string(d))
+}
+
+// Returns the decimal as a float64, precision may be lost
+func (d Decimal) Float64() (float64, error) {
+	return strconv.ParseFloat(string(d), 64)
+}
+
+func (d Decimal) String() string {
+	return string(d)
+}
+
+// Implements driver.Valuer so a Decimal can be used as a value in a query
+func (d Decimal) Value() (driver.Value, error) {
+	return string(d), nil
+}
+
+// normalizes a value scanned into an interface{} using the database type of its column, the type is matched without
+// its parameters (e.g. DECIMAL(10,2) is a DECIMAL)
+//
+//   - DECIMAL and NUMERIC values are converted to a Decimal
+//   - []byte values of integer and float columns are parsed into an int64 or a float64
+//   - []byte values of any other column (e.g. VARCHAR, TEXT, JSON or a column of an unknown type) are converted to a
+//     string, except binary columns (e.g. BLOB, BYTEA or UNIQUEIDENTIFIER)
+func normalizeValue(databaseType string, val interface{}) interface{} {
+	var text string
+	switch t := val.(type) {
+	case []byte:
+		text = string(t)
+	case string:
+		text = t
+	default:
+		return val
+	}
+	switch typeName := baseTypeName(databaseType); {
+	case decimalTypes[typeName]:
+		return Decimal(text)
+	case floatTypes[typeName]:
+		if f, err := strconv.ParseFloat(text, 64); err == nil {
+			return f
+		}
+	case intTypes[typeName]:
+		if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return i
+		}
+	case binaryTypes[typeName]:
+		return val
+	}
+	return text
+}
+
+// returns the upper case name of a database type without its parameters, e.g. "numeric(12, 4)" is "NUMERIC"
+func baseTypeName(databaseType string) string {
+	if i := strings.IndexByte(databaseType, '('); i >= 0 {
+		databaseType = databaseType[:i]
+	}
+	return strings.ToUpper(strings.TrimSpace(databaseType))
+}
@@ -0,0 +1,71 @@
+package exec
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type valuesSuite struct {
+	suite.Suite
+}
+
+func TestValues(t *testing.T) {
+	suite.Run(t, &valuesSuite{})
+}
+
+func (vs *valuesSuite) TestNormalizeValue() {
+	guid := []byte{0x6f, 0x96, 0x19, 0xff, 0x8b, 0x86, 0xd0, 0x11, 0xb4, 0x2d, 0x00, 0xc0, 0x4f, 0xc9, 0x64, 0xff}
+	cases := []struct {
+		databaseType string
+		val          interface{}
+		expected     interface{}
+	}{
+		{databaseType: "VARCHAR", val: []byte("a"), expected: "a"},
+		{databaseType: "", val: []byte("a"), expected: "a"},
+		{databaseType: "json", val: []byte(`{"a":1}`), expected: `{"a":1}`},
+		{databaseType: "NUMERIC", val: []byte("1.10"), expected: Decimal("1.10")},
+		{databaseType: "decimal", val: "1.10", expected: Decimal("1.10")},
+		{databaseType: "DECIMAL(10,2)", val: "1.10", expected: Decimal("1.10")},
+		{databaseType: "numeric(12, 4)", val: []byte("1.1000"), expected: Decimal("1.1000")},
+		{databaseType: "MONEY", val: []byte("1.10"), expected: Decimal("1.10")},
+		{databaseType: "BIGINT", val: []byte("10"), expected: int64(10)},
+		{databaseType: "INT4", val: []byte("10"), expected: int64(10)},
+		{databaseType: "UNSIGNED INT", val: []byte("10"), expected: int64(10)},
+		{databaseType: "INT", val: []byte("abc"), expected: "abc"},
+		{databaseType: "INTERVAL", val: []byte("10"), expected: "10"},
+		{databaseType: "INTERVAL", val: []byte("1 day"), expected: "1 day"},
+		{databaseType: "POINT", val: []byte("(1,2)"), expected: "(1,2)"},
+		{databaseType: "FLOAT8", val: []byte("1.5"), expected: 1.5},
+		{databaseType: "DOUBLE", val: []byte("abc"), expected: "abc"},
+		{databaseType: "BYTEA", val: []byte{1, 2}, expected: []byte{1, 2}},
+		{databaseType: "VARBINARY(16)", val: []byte{1, 2}, expected: []byte{1, 2}},
+		{databaseType: "UNIQUEIDENTIFIER", val: guid, expected: guid},
+		{databaseType: "VARCHAR", val: int64(10), expected: int64(10)},
+		{databaseType: "VARCHAR", val: nil, expected: nil},
+	}
+	for _, c := range cases {
+		vs.Equal(c.expected, normalizeValue(c.databaseType, c.val), "%s %v", c.databaseType, c.val)
+	}
+}
+
+func (vs *valuesSuite) TestDecimal() {
+	d := Decimal("12.345")
+	vs.Equal("12.345", d.String())
+
+	r, ok := d.Rat()
+	vs.True(ok)
+	vs.Equal(big.NewRat(12345, 1000), r)
+
+	f, err := d.Float64()
+	vs.NoError(err)
+	vs.Equal(12.345, f)
+
+	v, err := d.Value()
+	vs.NoError(err)
+	vs.Equal("12.345", v)
+
+	_, ok = Decimal("abc").Rat()
+	vs.False(ok)
+}
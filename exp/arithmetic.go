@@ -0,0 +1,95 @@
+package exp
+
+type arithmetic struct {
+	lhs Expression
+	rhs interface{}
+	op  ArithmeticOperation
+}
+
+func NewArithmeticExpression(op ArithmeticOperation, lhs Expression, rhs interface{}) ArithmeticExpression {
+	return arithmetic{op: op, lhs: lhs, rhs: rhs}
+}
+
+func (a arithmetic) Clone() Expression {
+	if a.lhs == nil {
+		return NewArithmeticExpression(a.op, nil, a.rhs)
+	}
+	return NewArithmeticExpression(a.op, a.lhs.Clone(), a.rhs)
+}
+
+func (a arithmetic) RHS() interface{} {
+	return a.rhs
+}
+
+func (a arithmetic) LHS() Expression {
+	return a.lhs
+}
+
+func (a arithmetic) Op() ArithmeticOperation {
+	return a.op
+}
+
+func (a arithmetic) Expression() Expression                           { return a }
+func (a arithmetic) As(val interface{}) AliasedExpression             { return NewAliasExpression(a, val) }
+func (a arithmetic) Eq(val interface{}) BooleanExpression             { return eq(a, val) }
+func (a arithmetic) Neq(val interface{}) BooleanExpression            { return neq(a, val) }
+func (a arithmetic) Gt(val interface{}) BooleanExpression             { return gt(a, val) }
+func (a arithmetic) Gte(val interface{}) BooleanExpression            { return gte(a, val) }
+func (a arithmetic) Lt(val interface{}) BooleanExpression             { return lt(a, val) }
+func (a arithmetic) Lte(val interface{}) BooleanExpression            { return lte(a, val) }
+func (a arithmetic) Asc() OrderedExpression                           { return asc(a) }
+func (a arithmetic) Desc() OrderedExpression                          { return desc(a) }
+func (a arithmetic) In(i ...interface{}) BooleanExpression            { return in(a, i...) }
+func (a arithmetic) NotIn(i ...interface{}) BooleanExpression         { return notIn(a, i...) }
+func (a arithmetic) Is(i interface{}) BooleanExpression               { return is(a, i) }
+func (a arithmetic) IsNot(i interface{}) BooleanExpression            { return isNot(a, i) }
+func (a arithmetic) IsNull() BooleanExpression                        { return is(a, nil) }
+func (a arithmetic) IsNotNull() BooleanExpression                     { return isNot(a, nil) }
+func (a arithmetic) IsTrue() BooleanExpression                        { return is(a, true) }
+func (a arithmetic) IsNotTrue() BooleanExpression                     { return isNot(a, true) }
+func (a arithmetic) IsFalse() BooleanExpression                       { return is(a, false) }
+func (a arithmetic) IsNotFalse() BooleanExpression                    { return isNot(a, false) }
+func (a arithmetic) IsDistinctFrom(val interface{}) BooleanExpression { return isDistinctFrom(a, val) }
+func (a arithmetic) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(a, val)
+}
+func (a arithmetic) Distinct() SQLFunctionExpression          { return NewSQLFunctionExpression("DISTINCT", a) }
+func (a arithmetic) Cast(t string) CastExpression             { return NewCastExpression(a, t) }
+func (a arithmetic) Between(val RangeVal) RangeExpression     { return between(a, val) }
+func (a arithmetic) NotBetween(val RangeVal) RangeExpression  { return notBetween(a, val) }
+func (a arithmetic) Add(val interface{}) ArithmeticExpression { return add(a, val) }
+func (a arithmetic) Sub(val interface{}) ArithmeticExpression { return sub(a, val) }
+func (a arithmetic) Mul(val interface{}) ArithmeticExpression { return mul(a, val) }
+func (a arithmetic) Div(val interface{}) ArithmeticExpression { return div(a, val) }
+func (a arithmetic) Mod(val interface{}) ArithmeticExpression { return mod(a, val) }
+func (a arithmetic) Neg() ArithmeticExpression                { return neg(a) }
+
+// used internally to create an addition ArithmeticExpression
+func add(lhs Expression, rhs interface{}) ArithmeticExpression {
+	return NewArithmeticExpression(AddOp, lhs, rhs)
+}
+
+// used internally to create a subtraction ArithmeticExpression
+func sub(lhs Expression, rhs interface{}) ArithmeticExpression {
+	return NewArithmeticExpression(SubOp, lhs, rhs)
+}
+
+// used internally to create a multiplication ArithmeticExpression
+func mul(lhs Expression, rhs interface{}) ArithmeticExpression {
+	return NewArithmeticExpression(MulOp, lhs, rhs)
+}
+
+// used internally to create a division ArithmeticExpression
+func div(lhs Expression, rhs interface{}) ArithmeticExpression {
+	return NewArithmeticExpression(DivOp, lhs, rhs)
+}
+
+// used internally to create a modulo ArithmeticExpression
+func mod(lhs Expression, rhs interface{}) ArithmeticExpression {
+	return NewArithmeticExpression(ModOp, lhs, rhs)
+}
+
+// used internally to create a unary minus ArithmeticExpression
+func neg(rhs Expression) ArithmeticExpression {
+	return NewArithmeticExpression(NegOp, nil, rhs)
+}
@@ -0,0 +1,87 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type arithmeticExpressionSuite struct {
+	suite.Suite
+}
+
+func TestArithmeticExpressionSuite(t *testing.T) {
+	suite.Run(t, &arithmeticExpressionSuite{})
+}
+
+func (aes *arithmeticExpressionSuite) TestClone() {
+	ae := exp.NewArithmeticExpression(exp.AddOp, exp.NewIdentifierExpression("", "", "col"), 1)
+	aes.Equal(ae, ae.Clone())
+
+	ae = exp.NewArithmeticExpression(exp.NegOp, nil, exp.NewIdentifierExpression("", "", "col"))
+	aes.Equal(ae, ae.Clone())
+}
+
+func (aes *arithmeticExpressionSuite) TestExpression() {
+	ae := exp.NewArithmeticExpression(exp.AddOp, exp.NewIdentifierExpression("", "", "col"), 1)
+	aes.Equal(ae, ae.Expression())
+}
+
+func (aes *arithmeticExpressionSuite) TestAs() {
+	ae := exp.NewArithmeticExpression(exp.SubOp, exp.NewIdentifierExpression("", "", "col"), 1)
+	aes.Equal(exp.NewAliasExpression(ae, "a"), ae.As("a"))
+}
+
+func (aes *arithmeticExpressionSuite) TestAsc() {
+	ae := exp.NewArithmeticExpression(exp.MulOp, exp.NewIdentifierExpression("", "", "col"), 1)
+	aes.Equal(exp.NewOrderedExpression(ae, exp.AscDir, exp.NoNullsSortType), ae.Asc())
+}
+
+func (aes *arithmeticExpressionSuite) TestDesc() {
+	ae := exp.NewArithmeticExpression(exp.DivOp, exp.NewIdentifierExpression("", "", "col"), 1)
+	aes.Equal(exp.NewOrderedExpression(ae, exp.DescSortDir, exp.NoNullsSortType), ae.Desc())
+}
+
+func (aes *arithmeticExpressionSuite) TestAllOthers() {
+	ae := exp.NewArithmeticExpression(exp.ModOp, exp.NewIdentifierExpression("", "", "col"), 1)
+	rv := exp.NewRangeVal(1, 2)
+	inVals := []interface{}{1, 2}
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: ae.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, ae, 1)},
+		{Ex: ae.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, ae, 1)},
+		{Ex: ae.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, ae, 1)},
+		{Ex: ae.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, ae, 1)},
+		{Ex: ae.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, ae, 1)},
+		{Ex: ae.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, ae, 1)},
+		{Ex: ae.Between(rv), Expected: exp.NewRangeExpression(exp.BetweenOp, ae, rv)},
+		{Ex: ae.NotBetween(rv), Expected: exp.NewRangeExpression(exp.NotBetweenOp, ae, rv)},
+		{Ex: ae.In(inVals), Expected: exp.NewBooleanExpression(exp.InOp, ae, inVals)},
+		{Ex: ae.NotIn(inVals), Expected: exp.NewBooleanExpression(exp.NotInOp, ae, inVals)},
+		{Ex: ae.Is(true), Expected: exp.NewBooleanExpression(exp.IsOp, ae, true)},
+		{Ex: ae.IsNot(true), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, true)},
+		{Ex: ae.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, ae, nil)},
+		{Ex: ae.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, nil)},
+		{Ex: ae.IsTrue(), Expected: exp.NewBooleanExpression(exp.IsOp, ae, true)},
+		{Ex: ae.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, true)},
+		{Ex: ae.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, ae, false)},
+		{Ex: ae.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, false)},
+		{Ex: ae.IsDistinctFrom(nil), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, ae, nil)},
+		{Ex: ae.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, ae, 1)},
+		{Ex: ae.Distinct(), Expected: exp.NewSQLFunctionExpression("DISTINCT", ae)},
+		{Ex: ae.Cast("NUMERIC"), Expected: exp.NewCastExpression(ae, "NUMERIC")},
+		{Ex: ae.Add(2), Expected: exp.NewArithmeticExpression(exp.AddOp, ae, 2)},
+		{Ex: ae.Sub(2), Expected: exp.NewArithmeticExpression(exp.SubOp, ae, 2)},
+		{Ex: ae.Mul(2), Expected: exp.NewArithmeticExpression(exp.MulOp, ae, 2)},
+		{Ex: ae.Div(2), Expected: exp.NewArithmeticExpression(exp.DivOp, ae, 2)},
+		{Ex: ae.Mod(2), Expected: exp.NewArithmeticExpression(exp.ModOp, ae, 2)},
+		{Ex: ae.Neg(), Expected: exp.NewArithmeticExpression(exp.NegOp, nil, ae)},
+	}
+
+	for _, tc := range testCases {
+		aes.Equal(tc.Expected, tc.Ex)
+	}
+}
@@ -0,0 +1,68 @@
+package exp
+
+import "reflect"
+
+type array struct {
+	elements interface{}
+}
+
+// Creates a new array expression, a single slice argument is used as the elements of the array
+//
+//	NewArrayExpression("a", "b") -> ARRAY['a', 'b']
+//	NewArrayExpression([]int64{1, 2}) -> ARRAY[1, 2]
+func NewArrayExpression(vals ...interface{}) ArrayExpression {
+	if len(vals) == 1 && reflect.Indirect(reflect.ValueOf(vals[0])).Kind() == reflect.Slice {
+		if _, ok := vals[0].([]byte); !ok {
+			return array{elements: vals[0]}
+		}
+	}
+	return array{elements: vals}
+}
+
+func (a array) Clone() Expression {
+	vals, ok := a.elements.([]interface{})
+	if !ok {
+		return array{elements: a.elements}
+	}
+	cloned := make([]interface{}, 0, len(vals))
+	for _, v := range vals {
+		if e, ok := v.(Expression); ok {
+			cloned = append(cloned, e.Clone())
+		} else {
+			cloned = append(cloned, v)
+		}
+	}
+	return array{elements: cloned}
+}
+
+func (a array) Expression() Expression               { return a }
+func (a array) Elements() interface{}                { return a.elements }
+func (a array) As(val interface{}) AliasedExpression { return NewAliasExpression(a, val) }
+
+// The equality operators do not go through checkBoolExpType because a slice on the right hand side is the array
+// being compared against and not an IN list.
+func (a array) Eq(val interface{}) BooleanExpression          { return NewBooleanExpression(EqOp, a, val) }
+func (a array) Neq(val interface{}) BooleanExpression         { return NewBooleanExpression(NeqOp, a, val) }
+func (a array) Gt(val interface{}) BooleanExpression          { return gt(a, val) }
+func (a array) Gte(val interface{}) BooleanExpression         { return gte(a, val) }
+func (a array) Lt(val interface{}) BooleanExpression          { return lt(a, val) }
+func (a array) Lte(val interface{}) BooleanExpression         { return lte(a, val) }
+func (a array) Cast(t string) CastExpression                  { return NewCastExpression(a, t) }
+func (a array) Contains(val interface{}) BooleanExpression    { return arrayContains(a, val) }
+func (a array) ContainedBy(val interface{}) BooleanExpression { return arrayContainedBy(a, val) }
+func (a array) Overlaps(val interface{}) BooleanExpression    { return arrayOverlaps(a, val) }
+
+// used internally to create an array contains (@>) BooleanExpression
+func arrayContains(lhs Expression, val interface{}) BooleanExpression {
+	return NewBooleanExpression(ArrayContainsOp, lhs, val)
+}
+
+// used internally to create an array contained by (<@) BooleanExpression
+func arrayContainedBy(lhs Expression, val interface{}) BooleanExpression {
+	return NewBooleanExpression(ArrayContainedByOp, lhs, val)
+}
+
+// used internally to create an array overlaps (&&) BooleanExpression
+func arrayOverlaps(lhs Expression, val interface{}) BooleanExpression {
+	return NewBooleanExpression(ArrayOverlapsOp, lhs, val)
+}
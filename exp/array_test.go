@@ -0,0 +1,61 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type arrayExpressionSuite struct {
+	suite.Suite
+}
+
+func TestArrayExpressionSuite(t *testing.T) {
+	suite.Run(t, &arrayExpressionSuite{})
+}
+
+func (aes *arrayExpressionSuite) TestClone() {
+	a := exp.NewArrayExpression(exp.NewIdentifierExpression("", "", "a"), 1)
+	aes.Equal(exp.NewArrayExpression(exp.NewIdentifierExpression("", "", "a"), 1), a.Clone())
+
+	s := exp.NewArrayExpression([]int{1, 2})
+	aes.Equal(s, s.Clone())
+}
+
+func (aes *arrayExpressionSuite) TestExpression() {
+	a := exp.NewArrayExpression(1, 2)
+	aes.Equal(a, a.Expression())
+}
+
+func (aes *arrayExpressionSuite) TestElements() {
+	aes.Equal([]interface{}{1, 2}, exp.NewArrayExpression(1, 2).Elements())
+	aes.Equal([]int{1, 2}, exp.NewArrayExpression([]int{1, 2}).Elements())
+	aes.Equal([]interface{}{[]byte("a")}, exp.NewArrayExpression([]byte("a")).Elements())
+	aes.Empty(exp.NewArrayExpression().Elements())
+}
+
+func (aes *arrayExpressionSuite) TestAllOthers() {
+	a := exp.NewArrayExpression("a", "b")
+	rv := []string{"b"}
+	cases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: a.As("b"), Expected: exp.NewAliasExpression(a, "b")},
+		{Ex: a.Eq(rv), Expected: exp.NewBooleanExpression(exp.EqOp, a, rv)},
+		{Ex: a.Neq(rv), Expected: exp.NewBooleanExpression(exp.NeqOp, a, rv)},
+		{Ex: a.Gt(rv), Expected: exp.NewBooleanExpression(exp.GtOp, a, rv)},
+		{Ex: a.Gte(rv), Expected: exp.NewBooleanExpression(exp.GteOp, a, rv)},
+		{Ex: a.Lt(rv), Expected: exp.NewBooleanExpression(exp.LtOp, a, rv)},
+		{Ex: a.Lte(rv), Expected: exp.NewBooleanExpression(exp.LteOp, a, rv)},
+		{Ex: a.Cast("text[]"), Expected: exp.NewCastExpression(a, "text[]")},
+		{Ex: a.Contains(rv), Expected: exp.NewBooleanExpression(exp.ArrayContainsOp, a, rv)},
+		{Ex: a.ContainedBy(rv), Expected: exp.NewBooleanExpression(exp.ArrayContainedByOp, a, rv)},
+		{Ex: a.Overlaps(rv), Expected: exp.NewBooleanExpression(exp.ArrayOverlapsOp, a, rv)},
+	}
+
+	for _, tc := range cases {
+		aes.Equal(tc.Expected, tc.Ex)
+	}
+}
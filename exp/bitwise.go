@@ -54,9 +54,13 @@ func (b bitwise) IsTrue() BooleanExpression                        { return is(b
 func (b bitwise) IsNotTrue() BooleanExpression                     { return isNot(b, true) }
 func (b bitwise) IsFalse() BooleanExpression                       { return is(b, false) }
 func (b bitwise) IsNotFalse() BooleanExpression                    { return isNot(b, false) }
-func (b bitwise) Distinct() SQLFunctionExpression                  { return NewSQLFunctionExpression("DISTINCT", b) }
-func (b bitwise) Between(val RangeVal) RangeExpression             { return between(b, val) }
-func (b bitwise) NotBetween(val RangeVal) RangeExpression          { return notBetween(b, val) }
+func (b bitwise) IsDistinctFrom(val interface{}) BooleanExpression { return isDistinctFrom(b, val) }
+func (b bitwise) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(b, val)
+}
+func (b bitwise) Distinct() SQLFunctionExpression         { return NewSQLFunctionExpression("DISTINCT", b) }
+func (b bitwise) Between(val RangeVal) RangeExpression    { return between(b, val) }
+func (b bitwise) NotBetween(val RangeVal) RangeExpression { return notBetween(b, val) }
 
 // used internally to create a Bitwise Inversion BitwiseExpression
 func bitwiseInversion(rhs Expression) BitwiseExpression {
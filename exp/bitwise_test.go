@@ -75,6 +75,8 @@ func (bes *bitwiseExpressionSuite) TestAllOthers() {
 		{Ex: be.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, be, true)},
 		{Ex: be.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, be, false)},
 		{Ex: be.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, be, false)},
+		{Ex: be.IsDistinctFrom(nil), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, be, nil)},
+		{Ex: be.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, be, 1)},
 		{Ex: be.Distinct(), Expected: exp.NewSQLFunctionExpression("DISTINCT", be)},
 	}
 
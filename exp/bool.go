@@ -95,6 +95,16 @@ func isNot(lhs Expression, val interface{}) BooleanExpression {
 	return checkBoolExpType(IsOp, lhs, val, true)
 }
 
+// used internally to create an IS DISTINCT FROM BooleanExpression
+func isDistinctFrom(lhs Expression, val interface{}) BooleanExpression {
+	return NewBooleanExpression(IsDistinctFromOp, lhs, val)
+}
+
+// used internally to create an IS NOT DISTINCT FROM BooleanExpression
+func isNotDistinctFrom(lhs Expression, val interface{}) BooleanExpression {
+	return NewBooleanExpression(IsNotDistinctFromOp, lhs, val)
+}
+
 // used internally to create a LIKE BooleanExpression
 func like(lhs Expression, val interface{}) BooleanExpression {
 	return checkLikeExp(LikeOp, lhs, val, false)
@@ -72,7 +72,15 @@ func (ces *castExpressionSuite) TestAllOthers() {
 		{Ex: ce.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ce, true)},
 		{Ex: ce.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, ce, false)},
 		{Ex: ce.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ce, false)},
+		{Ex: ce.IsDistinctFrom(nil), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, ce, nil)},
+		{Ex: ce.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, ce, 1)},
 		{Ex: ce.Distinct(), Expected: exp.NewSQLFunctionExpression("DISTINCT", ce)},
+		{Ex: ce.Add(2), Expected: exp.NewArithmeticExpression(exp.AddOp, ce, 2)},
+		{Ex: ce.Sub(2), Expected: exp.NewArithmeticExpression(exp.SubOp, ce, 2)},
+		{Ex: ce.Mul(2), Expected: exp.NewArithmeticExpression(exp.MulOp, ce, 2)},
+		{Ex: ce.Div(2), Expected: exp.NewArithmeticExpression(exp.DivOp, ce, 2)},
+		{Ex: ce.Mod(2), Expected: exp.NewArithmeticExpression(exp.ModOp, ce, 2)},
+		{Ex: ce.Neg(), Expected: exp.NewArithmeticExpression(exp.NegOp, nil, ce)},
 	}
 
 	for _, tc := range testCases {
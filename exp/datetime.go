@@ -0,0 +1,219 @@
+package exp
+
+import "time"
+
+type (
+	interval struct {
+		amount int64
+		unit   DateTimeUnit
+	}
+	dateArithmetic struct {
+		op       DateArithmeticOperation
+		lhs      Expression
+		interval IntervalExpression
+	}
+	extract struct {
+		field  DateTimeUnit
+		source Expression
+	}
+	dateTrunc struct {
+		unit   DateTimeUnit
+		source Expression
+	}
+	currentTime struct {
+		timeType CurrentTimeType
+	}
+)
+
+// used to convert a time.Duration into the largest unit that represents it exactly
+var durationUnits = []struct {
+	duration time.Duration
+	unit     DateTimeUnit
+}{
+	{duration: time.Hour, unit: HourUnit},
+	{duration: time.Minute, unit: MinuteUnit},
+	{duration: time.Second, unit: SecondUnit},
+}
+
+// Creates a new interval of a single unit
+//
+//	NewIntervalExpression(7, DayUnit) -> INTERVAL '7 days'
+func NewIntervalExpression(amount int64, unit DateTimeUnit) IntervalExpression {
+	return interval{amount: amount, unit: unit}
+}
+
+// Creates a new interval from a time.Duration using the largest of hours, minutes or seconds that represents the
+// duration exactly, otherwise microseconds are used and anything below a microsecond is truncated
+//
+//	NewDurationIntervalExpression(90 * time.Minute) -> INTERVAL '90 minutes'
+//	NewDurationIntervalExpression(48 * time.Hour) -> INTERVAL '48 hours'
+func NewDurationIntervalExpression(d time.Duration) IntervalExpression {
+	if d != 0 {
+		for _, du := range durationUnits {
+			if d%du.duration == 0 {
+				return NewIntervalExpression(int64(d/du.duration), du.unit)
+			}
+		}
+	}
+	return NewIntervalExpression(int64(d/time.Microsecond), MicrosecondUnit)
+}
+
+func (i interval) Clone() Expression      { return i }
+func (i interval) Expression() Expression { return i }
+func (i interval) Amount() int64          { return i.amount }
+func (i interval) Unit() DateTimeUnit     { return i.unit }
+
+// Creates a new expression adding or subtracting the interval from lhs
+//
+//	NewDateArithmeticExpression(DateAddOp, I("ts"), NewIntervalExpression(1, MonthUnit)) -> ("ts" + INTERVAL '1 months')
+func NewDateArithmeticExpression(
+	op DateArithmeticOperation,
+	lhs Expression,
+	i IntervalExpression,
+) DateArithmeticExpression {
+	return dateArithmetic{op: op, lhs: lhs, interval: i}
+}
+
+func (da dateArithmetic) Clone() Expression {
+	return NewDateArithmeticExpression(da.op, da.lhs.Clone(), da.interval)
+}
+
+func (da dateArithmetic) Expression() Expression                { return da }
+func (da dateArithmetic) Op() DateArithmeticOperation           { return da.op }
+func (da dateArithmetic) LHS() Expression                       { return da.lhs }
+func (da dateArithmetic) Interval() IntervalExpression          { return da.interval }
+func (da dateArithmetic) As(val interface{}) AliasedExpression  { return NewAliasExpression(da, val) }
+func (da dateArithmetic) Eq(val interface{}) BooleanExpression  { return eq(da, val) }
+func (da dateArithmetic) Neq(val interface{}) BooleanExpression { return neq(da, val) }
+func (da dateArithmetic) Gt(val interface{}) BooleanExpression  { return gt(da, val) }
+func (da dateArithmetic) Gte(val interface{}) BooleanExpression { return gte(da, val) }
+func (da dateArithmetic) Lt(val interface{}) BooleanExpression  { return lt(da, val) }
+func (da dateArithmetic) Lte(val interface{}) BooleanExpression { return lte(da, val) }
+func (da dateArithmetic) In(i ...interface{}) BooleanExpression { return in(da, i...) }
+func (da dateArithmetic) NotIn(i ...interface{}) BooleanExpression {
+	return notIn(da, i...)
+}
+func (da dateArithmetic) Is(i interface{}) BooleanExpression    { return is(da, i) }
+func (da dateArithmetic) IsNot(i interface{}) BooleanExpression { return isNot(da, i) }
+func (da dateArithmetic) IsNull() BooleanExpression             { return is(da, nil) }
+func (da dateArithmetic) IsNotNull() BooleanExpression          { return isNot(da, nil) }
+func (da dateArithmetic) IsTrue() BooleanExpression             { return is(da, true) }
+func (da dateArithmetic) IsNotTrue() BooleanExpression          { return isNot(da, true) }
+func (da dateArithmetic) IsFalse() BooleanExpression            { return is(da, false) }
+func (da dateArithmetic) IsNotFalse() BooleanExpression         { return isNot(da, false) }
+func (da dateArithmetic) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(da, val)
+}
+func (da dateArithmetic) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(da, val)
+}
+func (da dateArithmetic) Asc() OrderedExpression                  { return asc(da) }
+func (da dateArithmetic) Desc() OrderedExpression                 { return desc(da) }
+func (da dateArithmetic) Between(val RangeVal) RangeExpression    { return between(da, val) }
+func (da dateArithmetic) NotBetween(val RangeVal) RangeExpression { return notBetween(da, val) }
+
+// Creates a new expression extracting the field from source
+//
+//	NewExtractExpression(YearUnit, I("ts")) -> EXTRACT(YEAR FROM "ts")
+func NewExtractExpression(field DateTimeUnit, source Expression) ExtractExpression {
+	return extract{field: field, source: source}
+}
+
+func (e extract) Clone() Expression {
+	return NewExtractExpression(e.field, e.source.Clone())
+}
+
+func (e extract) Expression() Expression                   { return e }
+func (e extract) Field() DateTimeUnit                      { return e.field }
+func (e extract) Source() Expression                       { return e.source }
+func (e extract) As(val interface{}) AliasedExpression     { return NewAliasExpression(e, val) }
+func (e extract) Eq(val interface{}) BooleanExpression     { return eq(e, val) }
+func (e extract) Neq(val interface{}) BooleanExpression    { return neq(e, val) }
+func (e extract) Gt(val interface{}) BooleanExpression     { return gt(e, val) }
+func (e extract) Gte(val interface{}) BooleanExpression    { return gte(e, val) }
+func (e extract) Lt(val interface{}) BooleanExpression     { return lt(e, val) }
+func (e extract) Lte(val interface{}) BooleanExpression    { return lte(e, val) }
+func (e extract) In(i ...interface{}) BooleanExpression    { return in(e, i...) }
+func (e extract) NotIn(i ...interface{}) BooleanExpression { return notIn(e, i...) }
+func (e extract) Is(i interface{}) BooleanExpression       { return is(e, i) }
+func (e extract) IsNot(i interface{}) BooleanExpression    { return isNot(e, i) }
+func (e extract) IsNull() BooleanExpression                { return is(e, nil) }
+func (e extract) IsNotNull() BooleanExpression             { return isNot(e, nil) }
+func (e extract) IsTrue() BooleanExpression                { return is(e, true) }
+func (e extract) IsNotTrue() BooleanExpression             { return isNot(e, true) }
+func (e extract) IsFalse() BooleanExpression               { return is(e, false) }
+func (e extract) IsNotFalse() BooleanExpression            { return isNot(e, false) }
+func (e extract) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(e, val)
+}
+func (e extract) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(e, val)
+}
+func (e extract) Asc() OrderedExpression                  { return asc(e) }
+func (e extract) Desc() OrderedExpression                 { return desc(e) }
+func (e extract) Between(val RangeVal) RangeExpression    { return between(e, val) }
+func (e extract) NotBetween(val RangeVal) RangeExpression { return notBetween(e, val) }
+
+// Creates a new expression truncating source to the unit
+//
+//	NewDateTruncExpression(MonthUnit, I("ts")) -> date_trunc('month', "ts")
+func NewDateTruncExpression(unit DateTimeUnit, source Expression) DateTruncExpression {
+	return dateTrunc{unit: unit, source: source}
+}
+
+func (dt dateTrunc) Clone() Expression {
+	return NewDateTruncExpression(dt.unit, dt.source.Clone())
+}
+
+func (dt dateTrunc) Expression() Expression                   { return dt }
+func (dt dateTrunc) Unit() DateTimeUnit                       { return dt.unit }
+func (dt dateTrunc) Source() Expression                       { return dt.source }
+func (dt dateTrunc) As(val interface{}) AliasedExpression     { return NewAliasExpression(dt, val) }
+func (dt dateTrunc) Eq(val interface{}) BooleanExpression     { return eq(dt, val) }
+func (dt dateTrunc) Neq(val interface{}) BooleanExpression    { return neq(dt, val) }
+func (dt dateTrunc) Gt(val interface{}) BooleanExpression     { return gt(dt, val) }
+func (dt dateTrunc) Gte(val interface{}) BooleanExpression    { return gte(dt, val) }
+func (dt dateTrunc) Lt(val interface{}) BooleanExpression     { return lt(dt, val) }
+func (dt dateTrunc) Lte(val interface{}) BooleanExpression    { return lte(dt, val) }
+func (dt dateTrunc) In(i ...interface{}) BooleanExpression    { return in(dt, i...) }
+func (dt dateTrunc) NotIn(i ...interface{}) BooleanExpression { return notIn(dt, i...) }
+func (dt dateTrunc) Is(i interface{}) BooleanExpression       { return is(dt, i) }
+func (dt dateTrunc) IsNot(i interface{}) BooleanExpression    { return isNot(dt, i) }
+func (dt dateTrunc) IsNull() BooleanExpression                { return is(dt, nil) }
+func (dt dateTrunc) IsNotNull() BooleanExpression             { return isNot(dt, nil) }
+func (dt dateTrunc) IsTrue() BooleanExpression                { return is(dt, true) }
+func (dt dateTrunc) IsNotTrue() BooleanExpression             { return isNot(dt, true) }
+func (dt dateTrunc) IsFalse() BooleanExpression               { return is(dt, false) }
+func (dt dateTrunc) IsNotFalse() BooleanExpression            { return isNot(dt, false) }
+func (dt dateTrunc) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(dt, val)
+}
+func (dt dateTrunc) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(dt, val)
+}
+func (dt dateTrunc) Asc() OrderedExpression                  { return asc(dt) }
+func (dt dateTrunc) Desc() OrderedExpression                 { return desc(dt) }
+func (dt dateTrunc) Between(val RangeVal) RangeExpression    { return between(dt, val) }
+func (dt dateTrunc) NotBetween(val RangeVal) RangeExpression { return notBetween(dt, val) }
+
+// Creates a new expression for the current date or time
+//
+//	NewCurrentTimeExpression(NowCurrentTimeType) -> NOW()
+func NewCurrentTimeExpression(timeType CurrentTimeType) CurrentTimeExpression {
+	return currentTime{timeType: timeType}
+}
+
+func (ct currentTime) Clone() Expression                       { return ct }
+func (ct currentTime) Expression() Expression                  { return ct }
+func (ct currentTime) Type() CurrentTimeType                   { return ct.timeType }
+func (ct currentTime) As(val interface{}) AliasedExpression    { return NewAliasExpression(ct, val) }
+func (ct currentTime) Eq(val interface{}) BooleanExpression    { return eq(ct, val) }
+func (ct currentTime) Neq(val interface{}) BooleanExpression   { return neq(ct, val) }
+func (ct currentTime) Gt(val interface{}) BooleanExpression    { return gt(ct, val) }
+func (ct currentTime) Gte(val interface{}) BooleanExpression   { return gte(ct, val) }
+func (ct currentTime) Lt(val interface{}) BooleanExpression    { return lt(ct, val) }
+func (ct currentTime) Lte(val interface{}) BooleanExpression   { return lte(ct, val) }
+func (ct currentTime) Asc() OrderedExpression                  { return asc(ct) }
+func (ct currentTime) Desc() OrderedExpression                 { return desc(ct) }
+func (ct currentTime) Between(val RangeVal) RangeExpression    { return between(ct, val) }
+func (ct currentTime) NotBetween(val RangeVal) RangeExpression { return notBetween(ct, val) }
@@ -0,0 +1,253 @@
+package exp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type intervalExpressionSuite struct {
+	suite.Suite
+}
+
+func TestIntervalExpressionSuite(t *testing.T) {
+	suite.Run(t, &intervalExpressionSuite{})
+}
+
+func (ies *intervalExpressionSuite) TestClone() {
+	i := exp.NewIntervalExpression(7, exp.DayUnit)
+	ies.Equal(i, i.Clone())
+}
+
+func (ies *intervalExpressionSuite) TestExpression() {
+	i := exp.NewIntervalExpression(7, exp.DayUnit)
+	ies.Equal(i, i.Expression())
+}
+
+func (ies *intervalExpressionSuite) TestAmountAndUnit() {
+	i := exp.NewIntervalExpression(7, exp.DayUnit)
+	ies.Equal(int64(7), i.Amount())
+	ies.Equal(exp.DayUnit, i.Unit())
+}
+
+func (ies *intervalExpressionSuite) TestNewDurationIntervalExpression() {
+	cases := []struct {
+		d      time.Duration
+		amount int64
+		unit   exp.DateTimeUnit
+	}{
+		{d: 48 * time.Hour, amount: 48, unit: exp.HourUnit},
+		{d: 90 * time.Minute, amount: 90, unit: exp.MinuteUnit},
+		{d: -30 * time.Second, amount: -30, unit: exp.SecondUnit},
+		{d: 1500 * time.Millisecond, amount: 1500000, unit: exp.MicrosecondUnit},
+		{d: 1500 * time.Nanosecond, amount: 1, unit: exp.MicrosecondUnit},
+		{d: 0, amount: 0, unit: exp.MicrosecondUnit},
+	}
+	for _, tc := range cases {
+		i := exp.NewDurationIntervalExpression(tc.d)
+		ies.Equal(tc.amount, i.Amount(), "duration %s", tc.d)
+		ies.Equal(tc.unit, i.Unit(), "duration %s", tc.d)
+	}
+}
+
+type dateArithmeticExpressionSuite struct {
+	suite.Suite
+}
+
+func TestDateArithmeticExpressionSuite(t *testing.T) {
+	suite.Run(t, &dateArithmeticExpressionSuite{})
+}
+
+func (daes *dateArithmeticExpressionSuite) TestClone() {
+	da := exp.NewDateArithmeticExpression(
+		exp.DateAddOp, exp.NewIdentifierExpression("", "", "a"), exp.NewIntervalExpression(1, exp.DayUnit),
+	)
+	daes.Equal(da, da.Clone())
+}
+
+func (daes *dateArithmeticExpressionSuite) TestExpression() {
+	da := exp.NewDateArithmeticExpression(
+		exp.DateAddOp, exp.NewIdentifierExpression("", "", "a"), exp.NewIntervalExpression(1, exp.DayUnit),
+	)
+	daes.Equal(da, da.Expression())
+}
+
+func (daes *dateArithmeticExpressionSuite) TestAccessors() {
+	ident := exp.NewIdentifierExpression("", "", "a")
+	i := exp.NewIntervalExpression(1, exp.DayUnit)
+	da := exp.NewDateArithmeticExpression(exp.DateSubOp, ident, i)
+	daes.Equal(exp.DateSubOp, da.Op())
+	daes.Equal(ident, da.LHS())
+	daes.Equal(i, da.Interval())
+}
+
+func (daes *dateArithmeticExpressionSuite) TestAllOthers() {
+	da := exp.NewDateArithmeticExpression(
+		exp.DateAddOp, exp.NewIdentifierExpression("", "", "a"), exp.NewIntervalExpression(1, exp.DayUnit),
+	)
+	rv := exp.NewRangeVal(1, 2)
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: da.As("a"), Expected: exp.NewAliasExpression(da, "a")},
+		{Ex: da.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, da, 1)},
+		{Ex: da.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, da, 1)},
+		{Ex: da.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, da, 1)},
+		{Ex: da.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, da, 1)},
+		{Ex: da.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, da, 1)},
+		{Ex: da.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, da, 1)},
+		{Ex: da.In(1, 2), Expected: exp.NewBooleanExpression(exp.InOp, da, []interface{}{1, 2})},
+		{Ex: da.NotIn(1, 2), Expected: exp.NewBooleanExpression(exp.NotInOp, da, []interface{}{1, 2})},
+		{Ex: da.Is(nil), Expected: exp.NewBooleanExpression(exp.IsOp, da, nil)},
+		{Ex: da.IsNot(nil), Expected: exp.NewBooleanExpression(exp.IsNotOp, da, nil)},
+		{Ex: da.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, da, nil)},
+		{Ex: da.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, da, nil)},
+		{Ex: da.IsTrue(), Expected: exp.NewBooleanExpression(exp.IsOp, da, true)},
+		{Ex: da.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, da, true)},
+		{Ex: da.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, da, false)},
+		{Ex: da.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, da, false)},
+		{Ex: da.IsDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, da, 1)},
+		{Ex: da.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, da, 1)},
+		{Ex: da.Asc(), Expected: exp.NewOrderedExpression(da, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: da.Desc(), Expected: exp.NewOrderedExpression(da, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: da.Between(rv), Expected: exp.NewRangeExpression(exp.BetweenOp, da, rv)},
+		{Ex: da.NotBetween(rv), Expected: exp.NewRangeExpression(exp.NotBetweenOp, da, rv)},
+	}
+
+	for _, tc := range testCases {
+		daes.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+type extractExpressionSuite struct {
+	suite.Suite
+}
+
+func TestExtractExpressionSuite(t *testing.T) {
+	suite.Run(t, &extractExpressionSuite{})
+}
+
+func (ees *extractExpressionSuite) TestClone() {
+	e := exp.NewExtractExpression(exp.YearUnit, exp.NewIdentifierExpression("", "", "a"))
+	ees.Equal(e, e.Clone())
+}
+
+func (ees *extractExpressionSuite) TestExpression() {
+	e := exp.NewExtractExpression(exp.YearUnit, exp.NewIdentifierExpression("", "", "a"))
+	ees.Equal(e, e.Expression())
+}
+
+func (ees *extractExpressionSuite) TestAccessors() {
+	ident := exp.NewIdentifierExpression("", "", "a")
+	e := exp.NewExtractExpression(exp.YearUnit, ident)
+	ees.Equal(exp.YearUnit, e.Field())
+	ees.Equal(ident, e.Source())
+}
+
+func (ees *extractExpressionSuite) TestAllOthers() {
+	e := exp.NewExtractExpression(exp.YearUnit, exp.NewIdentifierExpression("", "", "a"))
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: e.As("a"), Expected: exp.NewAliasExpression(e, "a")},
+		{Ex: e.Eq(2024), Expected: exp.NewBooleanExpression(exp.EqOp, e, 2024)},
+		{Ex: e.Gte(2024), Expected: exp.NewBooleanExpression(exp.GteOp, e, 2024)},
+		{Ex: e.In(1, 2), Expected: exp.NewBooleanExpression(exp.InOp, e, []interface{}{1, 2})},
+		{Ex: e.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, e, nil)},
+		{Ex: e.Asc(), Expected: exp.NewOrderedExpression(e, exp.AscDir, exp.NoNullsSortType)},
+	}
+
+	for _, tc := range testCases {
+		ees.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+type dateTruncExpressionSuite struct {
+	suite.Suite
+}
+
+func TestDateTruncExpressionSuite(t *testing.T) {
+	suite.Run(t, &dateTruncExpressionSuite{})
+}
+
+func (dtes *dateTruncExpressionSuite) TestClone() {
+	dt := exp.NewDateTruncExpression(exp.MonthUnit, exp.NewIdentifierExpression("", "", "a"))
+	dtes.Equal(dt, dt.Clone())
+}
+
+func (dtes *dateTruncExpressionSuite) TestExpression() {
+	dt := exp.NewDateTruncExpression(exp.MonthUnit, exp.NewIdentifierExpression("", "", "a"))
+	dtes.Equal(dt, dt.Expression())
+}
+
+func (dtes *dateTruncExpressionSuite) TestAccessors() {
+	ident := exp.NewIdentifierExpression("", "", "a")
+	dt := exp.NewDateTruncExpression(exp.MonthUnit, ident)
+	dtes.Equal(exp.MonthUnit, dt.Unit())
+	dtes.Equal(ident, dt.Source())
+}
+
+func (dtes *dateTruncExpressionSuite) TestAllOthers() {
+	dt := exp.NewDateTruncExpression(exp.MonthUnit, exp.NewIdentifierExpression("", "", "a"))
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: dt.As("a"), Expected: exp.NewAliasExpression(dt, "a")},
+		{Ex: dt.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, dt, 1)},
+		{Ex: dt.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, dt, 1)},
+		{Ex: dt.NotIn(1, 2), Expected: exp.NewBooleanExpression(exp.NotInOp, dt, []interface{}{1, 2})},
+		{Ex: dt.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, dt, nil)},
+		{Ex: dt.Desc(), Expected: exp.NewOrderedExpression(dt, exp.DescSortDir, exp.NoNullsSortType)},
+	}
+
+	for _, tc := range testCases {
+		dtes.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+type currentTimeExpressionSuite struct {
+	suite.Suite
+}
+
+func TestCurrentTimeExpressionSuite(t *testing.T) {
+	suite.Run(t, &currentTimeExpressionSuite{})
+}
+
+func (ctes *currentTimeExpressionSuite) TestClone() {
+	ct := exp.NewCurrentTimeExpression(exp.NowCurrentTimeType)
+	ctes.Equal(ct, ct.Clone())
+}
+
+func (ctes *currentTimeExpressionSuite) TestType() {
+	ctes.Equal(exp.DateCurrentTimeType, exp.NewCurrentTimeExpression(exp.DateCurrentTimeType).Type())
+}
+
+func (ctes *currentTimeExpressionSuite) TestAllOthers() {
+	ct := exp.NewCurrentTimeExpression(exp.NowCurrentTimeType)
+	rv := exp.NewRangeVal(1, 2)
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: ct.As("a"), Expected: exp.NewAliasExpression(ct, "a")},
+		{Ex: ct.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, ct, 1)},
+		{Ex: ct.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, ct, 1)},
+		{Ex: ct.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, ct, 1)},
+		{Ex: ct.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, ct, 1)},
+		{Ex: ct.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, ct, 1)},
+		{Ex: ct.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, ct, 1)},
+		{Ex: ct.Asc(), Expected: exp.NewOrderedExpression(ct, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: ct.Desc(), Expected: exp.NewOrderedExpression(ct, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: ct.Between(rv), Expected: exp.NewRangeExpression(exp.BetweenOp, ct, rv)},
+		{Ex: ct.NotBetween(rv), Expected: exp.NewRangeExpression(exp.NotBetweenOp, ct, rv)},
+	}
+
+	for _, tc := range testCases {
+		ctes.Equal(tc.Expected, tc.Ex)
+	}
+}
@@ -0,0 +1,297 @@
+package exp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+var (
+	// boolean operations where the lhs and rhs can be swapped
+	commutativeBooleanOperations = map[BooleanOperation]bool{
+		EqOp: true, NeqOp: true, IsDistinctFromOp: true, IsNotDistinctFromOp: true,
+	}
+	commutativeArithmeticOperations = map[ArithmeticOperation]bool{AddOp: true, MulOp: true}
+	commutativeBitwiseOperations    = map[BitwiseOperation]bool{
+		BitwiseOrOp: true, BitwiseAndOp: true, BitwiseXorOp: true,
+	}
+)
+
+// Reports whether a and b have the same structure and values. a and b can be Expressions or clauses (SelectClauses,
+// InsertClauses, UpdateClauses, DeleteClauses or TruncateClauses).
+//
+// An Ex or ExOr is equal to the ExpressionList it creates and datasets are equal if their clauses are equal, the
+// dialect is not compared. The order of operands is compared, use Canonicalize on both sides to ignore it.
+//
+// Expressions that cannot be compared structurally (e.g. custom Expression types) are compared with reflect.DeepEqual.
+func Equal(a, b interface{}) bool {
+	ka, err := structureKey(a, false)
+	if err != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	kb, err := structureKey(b, false)
+	if err != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return bytes.Equal(ka, kb)
+}
+
+// Returns a key of the structure and values of an Expression or clauses, the keys of two values are equal if the
+// values are Equal. Use the key to compare a value with many others without encoding it every time (e.g. as the key of
+// a map). false is returned if the value cannot be compared structurally, see Equal.
+func StructureKey(v interface{}) (string, bool) {
+	key, err := structureKey(v, false)
+	if err != nil {
+		return "", false
+	}
+	return string(key), true
+}
+
+// Returns a stable fingerprint of the structure of an Expression or clauses (SelectClauses, InsertClauses...) that
+// can be used as a cache key for the shape of a query.
+//
+// The value is canonicalized (see Canonicalize) and literal values are ignored so
+//
+//	Ex{"a": 1, "b": "x"} and And(C("b").Eq("y"), C("a").Eq(2))
+//
+// have the same fingerprint. The type of a value, the number of values in a list and the presence of a LIMIT or OFFSET
+// are part of the fingerprint. An error is returned if the value contains an Expression type that is not supported by
+// MarshalExpressionJSON.
+func Fingerprint(v interface{}) (string, error) {
+	key, err := structureKey(canonical(v), true)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Returns a copy of the expression in a canonical form so that equivalent expressions are Equal
+//
+//   - Ex and ExOr maps are converted to ExpressionLists
+//   - empty ExpressionLists are removed from a list, lists with a single expression and lists of the same type are
+//     flattened into the list they are in, e.g. a AND (b AND c) -> a AND b AND c
+//   - the expressions of AND and OR lists are sorted
+//   - the operands of commutative operations (=, !=, IS DISTINCT FROM, IS NOT DISTINCT FROM, +, *, |, & and #) are
+//     sorted when both operands are Expressions
+//
+// Subqueries, including datasets, are canonicalized. See Rewrite
+func Canonicalize(e Expression) Expression {
+	return Rewrite(e, canonicalize)
+}
+
+func canonicalize(e Expression) Expression {
+	switch t := e.(type) {
+	case expressionList:
+		exps := make([]Expression, 0, len(t.expressions))
+		for _, c := range t.expressions {
+			exps = flattenExpressionList(exps, t.operator, c)
+		}
+		sort.SliceStable(exps, func(i, j int) bool {
+			return sortKey(exps[i]) < sortKey(exps[j])
+		})
+		t.expressions = exps
+		return t
+	case boolean:
+		if commutativeBooleanOperations[t.op] {
+			t.lhs, t.rhs = sortOperands(t.lhs, t.rhs)
+		}
+		return t
+	case arithmetic:
+		if commutativeArithmeticOperations[t.op] {
+			t.lhs, t.rhs = sortOperands(t.lhs, t.rhs)
+		}
+		return t
+	case bitwise:
+		if commutativeBitwiseOperations[t.op] {
+			t.lhs, t.rhs = sortOperands(t.lhs, t.rhs)
+		}
+		return t
+	}
+	return e
+}
+
+// appends the expression to exps, inlining the expressions of ExpressionLists with the same type or a single expression
+func flattenExpressionList(exps []Expression, listType ExpressionListType, e Expression) []Expression {
+	el, ok := e.(ExpressionList)
+	if !ok {
+		return append(exps, e)
+	}
+	children := el.Expressions()
+	if len(children) != 1 && el.Type() != listType {
+		return append(exps, e)
+	}
+	for _, c := range children {
+		exps = flattenExpressionList(exps, listType, c)
+	}
+	return exps
+}
+
+// swaps the operands if the rhs is an Expression that sorts before the lhs
+func sortOperands(lhs Expression, rhs interface{}) (Expression, interface{}) {
+	if r, ok := rhs.(Expression); ok && lhs != nil && !isNilExpression(r) && sortKey(r) < sortKey(lhs) {
+		return r, lhs
+	}
+	return lhs, rhs
+}
+
+// the key used to sort an expression, expressions that cannot be encoded keep their order
+func sortKey(e Expression) string {
+	key, err := structureKey(e, false)
+	if err != nil {
+		return ""
+	}
+	return string(key)
+}
+
+// canonicalizes an expression or clauses
+func canonical(v interface{}) interface{} {
+	switch t := v.(type) {
+	case Expression:
+		return Canonicalize(t)
+	case SelectClauses:
+		return RewriteSelectClauses(t, canonicalize)
+	case InsertClauses:
+		return RewriteInsertClauses(t, canonicalize)
+	case UpdateClauses:
+		return RewriteUpdateClauses(t, canonicalize)
+	case DeleteClauses:
+		return RewriteDeleteClauses(t, canonicalize)
+	case TruncateClauses:
+		return RewriteTruncateClauses(t, canonicalize)
+	}
+	return v
+}
+
+// encodes the structure of an expression or clauses
+func structureKey(v interface{}, omitValues bool) ([]byte, error) {
+	enc := &jsonEncoder{statements: true, omitValues: omitValues}
+	var encoded interface{}
+	if e, ok := v.(Expression); ok {
+		encoded = enc.node(e)
+	} else {
+		encoded = enc.statement(v)
+	}
+	if enc.err != nil {
+		return nil, enc.err
+	}
+	return json.Marshal(encoded)
+}
+
+// returns the clauses of an InsertClausesExpression, UpdateClausesExpression, DeleteClausesExpression or
+// TruncateClausesExpression
+func clausesOf(e Expression) interface{} {
+	switch t := e.(type) {
+	case InsertClausesExpression:
+		return t.GetClauses()
+	case UpdateClausesExpression:
+		return t.GetClauses()
+	case DeleteClausesExpression:
+		return t.GetClauses()
+	case TruncateClausesExpression:
+		return t.GetClauses()
+	}
+	return nil
+}
+
+// encodes clauses, the result is only used by Equal and Fingerprint and is not part of the JSON representation
+func (enc *jsonEncoder) statement(v interface{}) interface{} {
+	switch c := v.(type) {
+	case nil:
+		return nil
+	case SelectClauses:
+		return enc.selectClauses(c)
+	case InsertClauses:
+		return enc.insertClauses(c)
+	case UpdateClauses:
+		s := map[string]interface{}{
+			"type":      "update",
+			"with":      enc.commonTables(c.CommonTables()),
+			"table":     enc.node(c.Table()),
+			"from":      enc.node(c.From()),
+			"where":     enc.node(c.Where()),
+			"order":     enc.node(c.Order()),
+			"limit":     enc.optionalValue(c.Limit()),
+			"returning": enc.node(c.Returning()),
+		}
+		if c.SetValues() != nil {
+			updates, err := NewUpdateExpressions(c.SetValues())
+			if err != nil {
+				enc.setError(err)
+				return nil
+			}
+			set := make([]interface{}, 0, len(updates))
+			for _, u := range updates {
+				set = append(set, []interface{}{enc.node(u.Col()), enc.value(u.Val())})
+			}
+			s["set"] = set
+		}
+		return s
+	case DeleteClauses:
+		return map[string]interface{}{
+			"type":      "delete",
+			"with":      enc.commonTables(c.CommonTables()),
+			"from":      enc.node(c.From()),
+			"where":     enc.node(c.Where()),
+			"order":     enc.node(c.Order()),
+			"limit":     enc.optionalValue(c.Limit()),
+			"returning": enc.node(c.Returning()),
+		}
+	case TruncateClauses:
+		return map[string]interface{}{
+			"type":    "truncate",
+			"tables":  enc.node(c.Table()),
+			"options": c.Options(),
+		}
+	}
+	enc.fail("unsupported clauses type %T", v)
+	return nil
+}
+
+func (enc *jsonEncoder) insertClauses(c InsertClauses) interface{} {
+	s := map[string]interface{}{
+		"type":        "insert",
+		"with":        enc.commonTables(c.CommonTables()),
+		"into":        enc.node(c.Into()),
+		"alias":       enc.node(c.Alias()),
+		"cols":        enc.node(c.Cols()),
+		"from":        enc.node(c.From()),
+		"on_conflict": enc.node(c.OnConflict()),
+		"returning":   enc.node(c.Returning()),
+	}
+	if c.HasRows() {
+		ie, err := NewInsertExpression(c.Rows()...)
+		if err != nil {
+			enc.setError(err)
+			return nil
+		}
+		s["rows"] = enc.node(ie)
+	}
+	if c.HasVals() {
+		vals := make([][]*jsonValue, 0, len(c.Vals()))
+		for _, row := range c.Vals() {
+			vals = append(vals, enc.values(row))
+		}
+		s["vals"] = vals
+	}
+	return s
+}
+
+func (enc *jsonEncoder) commonTables(ctes []CommonTableExpression) []*jsonNode {
+	nodes := make([]*jsonNode, 0, len(ctes))
+	for _, cte := range ctes {
+		nodes = append(nodes, enc.node(cte))
+	}
+	return nodes
+}
+
+// encodes a value that is nil when it is not set (e.g. LIMIT)
+func (enc *jsonEncoder) optionalValue(val interface{}) *jsonValue {
+	if val == nil {
+		return nil
+	}
+	return enc.value(val)
+}
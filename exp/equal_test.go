@@ -0,0 +1,225 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type equalSuite struct {
+	suite.Suite
+}
+
+func TestEqualSuite(t *testing.T) {
+	suite.Run(t, &equalSuite{})
+}
+
+// an Expression type that is not supported by MarshalExpressionJSON
+type customExpression struct {
+	val int
+}
+
+func (ce customExpression) Expression() exp.Expression { return ce }
+func (ce customExpression) Clone() exp.Expression      { return ce }
+
+func (es *equalSuite) fingerprint(v interface{}) string {
+	f, err := exp.Fingerprint(v)
+	es.Require().NoError(err)
+	es.Len(f, 64)
+	return f
+}
+
+func (es *equalSuite) TestEqual() {
+	sub := func(val interface{}) exp.AppendableExpression {
+		return newJSONSubquery(exp.NewSelectClauses().WhereAppend(col("a").Eq(val)))
+	}
+	es.True(exp.Equal(nil, nil))
+	es.True(exp.Equal(col("a").Eq(1), col("a").Eq(1)))
+	es.True(exp.Equal(col("a").In(sub(1)), col("a").In(sub(1))))
+	es.True(exp.Equal(exp.Ex{"a": 1, "b": 2}, exp.NewExpressionList(exp.AndType, col("a").Eq(1), col("b").Eq(2))))
+	es.True(exp.Equal(exp.Ex{"a": 1, "b": 2}, exp.Ex{"b": 2, "a": 1}))
+	es.True(exp.Equal(customExpression{val: 1}, customExpression{val: 1}))
+
+	es.False(exp.Equal(col("a").Eq(1), nil))
+	es.False(exp.Equal(col("a").Eq(1), col("a").Eq(2)))
+	es.False(exp.Equal(col("a").Eq(1), col("a").Eq(int64(1))))
+	es.False(exp.Equal(col("a").Eq(1), col("a").Neq(1)))
+	es.False(exp.Equal(col("a").Eq(1), col("b").Eq(1)))
+	es.False(exp.Equal(col("a").In(sub(1)), col("a").In(sub(2))))
+	es.False(exp.Equal(
+		exp.NewExpressionList(exp.AndType, col("a").Eq(1), col("b").Eq(2)),
+		exp.NewExpressionList(exp.AndType, col("b").Eq(2), col("a").Eq(1)),
+	))
+	es.False(exp.Equal(customExpression{val: 1}, customExpression{val: 2}))
+	es.False(exp.Equal(col("a").Eq(1), exp.NewSelectClauses()))
+}
+
+func (es *equalSuite) TestEqual_clauses() {
+	es.True(exp.Equal(
+		exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("a")).SetLimit(1),
+		exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("a")).SetLimit(1),
+	))
+	es.False(exp.Equal(
+		exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("a")).SetLimit(1),
+		exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("a")).SetLimit(2),
+	))
+	es.True(exp.Equal(
+		exp.NewInsertClauses().SetInto(col("a")).SetRows([]interface{}{exp.Record{"a": 1}}),
+		exp.NewInsertClauses().SetInto(col("a")).SetRows([]interface{}{exp.Record{"a": 1}}),
+	))
+	es.False(exp.Equal(
+		exp.NewInsertClauses().SetInto(col("a")).SetRows([]interface{}{exp.Record{"a": 1}}),
+		exp.NewInsertClauses().SetInto(col("a")).SetRows([]interface{}{exp.Record{"b": 1}}),
+	))
+	es.True(exp.Equal(
+		exp.NewUpdateClauses().SetTable(col("a")).SetSetValues(exp.Record{"a": 1}),
+		exp.NewUpdateClauses().SetTable(col("a")).SetSetValues(exp.Record{"a": 1}),
+	))
+	es.False(exp.Equal(
+		exp.NewUpdateClauses().SetTable(col("a")).SetSetValues(exp.Record{"a": 1}),
+		exp.NewUpdateClauses().SetTable(col("a")).SetSetValues(exp.Record{"a": 2}),
+	))
+	es.True(exp.Equal(
+		exp.NewDeleteClauses().SetFrom(col("a")).WhereAppend(col("a").Eq(1)),
+		exp.NewDeleteClauses().SetFrom(col("a")).WhereAppend(col("a").Eq(1)),
+	))
+	es.False(exp.Equal(
+		exp.NewDeleteClauses().SetFrom(col("a")).WhereAppend(col("a").Eq(1)),
+		exp.NewDeleteClauses().SetFrom(col("b")).WhereAppend(col("a").Eq(1)),
+	))
+	es.True(exp.Equal(
+		exp.NewTruncateClauses().SetTable(exp.NewColumnListExpression("a")),
+		exp.NewTruncateClauses().SetTable(exp.NewColumnListExpression("a")),
+	))
+	es.False(exp.Equal(
+		exp.NewTruncateClauses().SetTable(exp.NewColumnListExpression("a")),
+		exp.NewTruncateClauses().SetTable(exp.NewColumnListExpression("a")).
+			SetOptions(exp.TruncateOptions{Cascade: true}),
+	))
+	es.False(exp.Equal(exp.NewDeleteClauses(), exp.NewTruncateClauses()))
+}
+
+func (es *equalSuite) TestStructureKey() {
+	key := func(v interface{}) string {
+		k, ok := exp.StructureKey(v)
+		es.Require().True(ok)
+		return k
+	}
+	es.Equal(key(col("a").Eq(1)), key(col("a").Eq(1)))
+	es.Equal(key(exp.Ex{"a": 1}), key(exp.NewExpressionList(exp.AndType, col("a").Eq(1))))
+	es.NotEqual(key(col("a").Eq(1)), key(col("a").Eq(2)))
+	es.NotEqual(key(col("a").Eq(1)), key(col("a").Eq("1")))
+	es.NotEqual(key(col("a").Add(col("b"))), key(col("b").Add(col("a"))))
+
+	_, ok := exp.StructureKey(col("a").Eq(customExpression{}))
+	es.False(ok)
+}
+
+func (es *equalSuite) TestFingerprint() {
+	es.Equal(
+		es.fingerprint(exp.Ex{"a": 1, "b": "x"}),
+		es.fingerprint(exp.NewExpressionList(exp.AndType, col("b").Eq("y"), col("a").Eq(2))),
+	)
+	es.Equal(
+		es.fingerprint(exp.NewLiteralExpression("? + ?", 1, 2)),
+		es.fingerprint(exp.NewLiteralExpression("? + ?", 3, 4)),
+	)
+	es.Equal(
+		es.fingerprint(col("a").Add(col("b"))),
+		es.fingerprint(col("b").Add(col("a"))),
+	)
+	interval := func(amount int64) exp.Expression {
+		return exp.NewDateArithmeticExpression(exp.DateAddOp, col("a"), exp.NewIntervalExpression(amount, exp.DayUnit))
+	}
+	es.Equal(es.fingerprint(interval(1)), es.fingerprint(interval(2)))
+
+	es.NotEqual(es.fingerprint(col("a").Eq(1)), es.fingerprint(col("a").Eq("1")))
+	es.NotEqual(es.fingerprint(col("a").Eq(1)), es.fingerprint(col("a").Gt(1)))
+	es.NotEqual(es.fingerprint(col("a").Eq(1)), es.fingerprint(col("b").Eq(1)))
+	es.NotEqual(es.fingerprint(col("a").In([]int{1})), es.fingerprint(col("a").In([]int{1, 2})))
+	es.NotEqual(es.fingerprint(col("a").Sub(col("b"))), es.fingerprint(col("b").Sub(col("a"))))
+	es.NotEqual(es.fingerprint(exp.NewLiteralExpression("a")), es.fingerprint(exp.NewLiteralExpression("b")))
+
+	_, err := exp.Fingerprint(col("a").Eq(customExpression{}))
+	es.EqualError(err, "depiq: unable to encode JSON: unsupported expression type exp_test.customExpression")
+}
+
+func (es *equalSuite) TestFingerprint_clauses() {
+	sc := func(limit, offset uint) exp.SelectClauses {
+		c := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("a"))
+		if limit > 0 {
+			c = c.SetLimit(limit)
+		}
+		return c.SetOffset(offset)
+	}
+	es.Equal(es.fingerprint(sc(10, 10)), es.fingerprint(sc(20, 30)))
+	es.NotEqual(es.fingerprint(sc(10, 10)), es.fingerprint(sc(0, 10)))
+	es.NotEqual(es.fingerprint(sc(10, 10)), es.fingerprint(sc(10, 0)))
+
+	es.Equal(
+		es.fingerprint(exp.NewInsertClauses().SetInto(col("a")).SetRows([]interface{}{exp.Record{"a": 1}})),
+		es.fingerprint(exp.NewInsertClauses().SetInto(col("a")).SetRows([]interface{}{exp.Record{"a": 2}})),
+	)
+	es.NotEqual(
+		es.fingerprint(exp.NewInsertClauses().SetInto(col("a")).SetRows([]interface{}{exp.Record{"a": 1}})),
+		es.fingerprint(exp.NewInsertClauses().SetInto(col("a")).SetRows([]interface{}{exp.Record{"b": 1}})),
+	)
+	es.Equal(
+		es.fingerprint(exp.NewUpdateClauses().SetTable(col("a")).SetSetValues(exp.Record{"a": 1}).
+			WhereAppend(exp.Ex{"b": 1, "c": 2})),
+		es.fingerprint(exp.NewUpdateClauses().SetTable(col("a")).SetSetValues(exp.Record{"a": 2}).
+			WhereAppend(exp.Ex{"c": 3}, exp.Ex{"b": 4})),
+	)
+	es.Equal(
+		es.fingerprint(exp.NewDeleteClauses().SetFrom(col("a")).WhereAppend(col("a").Eq(1))),
+		es.fingerprint(exp.NewDeleteClauses().SetFrom(col("a")).WhereAppend(col("a").Eq(2))),
+	)
+	es.NotEqual(
+		es.fingerprint(exp.NewDeleteClauses().SetFrom(col("a"))),
+		es.fingerprint(exp.NewTruncateClauses().SetTable(exp.NewColumnListExpression("a"))),
+	)
+
+	_, err := exp.Fingerprint(exp.NewUpdateClauses().SetSetValues(1))
+	es.EqualError(err, "depiq: unsupported update interface type int")
+}
+
+func (es *equalSuite) TestCanonicalize() {
+	a, b, c := col("a").Eq(1), col("b").Eq(2), col("c").Eq(3)
+	cases := []struct {
+		e        exp.Expression
+		expected exp.Expression
+	}{
+		{
+			e:        exp.Ex{"b": 2, "a": 1},
+			expected: exp.NewExpressionList(exp.AndType, a, b),
+		},
+		{
+			e: exp.NewExpressionList(exp.AndType,
+				c, exp.NewExpressionList(exp.AndType, b, exp.NewExpressionList(exp.AndType, a))),
+			expected: exp.NewExpressionList(exp.AndType, a, b, c),
+		},
+		{
+			e: exp.NewExpressionList(exp.OrType,
+				c, exp.NewExpressionList(exp.AndType, exp.NewExpressionList(exp.OrType, b, a))),
+			expected: exp.NewExpressionList(exp.OrType, a, b, c),
+		},
+		{
+			e:        exp.NewExpressionList(exp.OrType, c, exp.NewExpressionList(exp.AndType, b, a)),
+			expected: exp.NewExpressionList(exp.OrType, c, exp.NewExpressionList(exp.AndType, a, b)),
+		},
+		{e: col("b").Eq(col("a")), expected: col("a").Eq(col("b"))},
+		{e: col("b").Eq(1), expected: col("b").Eq(1)},
+		{e: col("b").Gt(col("a")), expected: col("b").Gt(col("a"))},
+		{e: col("b").Mul(col("a")), expected: col("a").Mul(col("b"))},
+		{e: col("b").BitwiseAnd(col("a")), expected: col("a").BitwiseAnd(col("b"))},
+		{
+			e:        newJSONSubquery(exp.NewSelectClauses().WhereAppend(exp.Ex{"b": 2, "a": 1})),
+			expected: newJSONSubquery(exp.NewSelectClauses().WhereAppend(a, b)),
+		},
+	}
+	for _, tc := range cases {
+		canonical := exp.Canonicalize(tc.e)
+		es.True(exp.Equal(tc.expected, canonical), "%+v", canonical)
+	}
+}
@@ -0,0 +1,46 @@
+package exp
+
+type (
+	exists struct {
+		existsType ExistsType
+		subquery   AppendableExpression
+	}
+	quantified struct {
+		quantifier QuantifierType
+		value      interface{}
+	}
+)
+
+// Creates a new EXISTS or NOT EXISTS expression
+//
+//	NewExistsExpression(ExistsSubqueryType, From("test")) -> EXISTS (SELECT * FROM "test")
+func NewExistsExpression(existsType ExistsType, subquery AppendableExpression) ExistsExpression {
+	return exists{existsType: existsType, subquery: subquery}
+}
+
+func (e exists) Clone() Expression {
+	return NewExistsExpression(e.existsType, e.subquery)
+}
+
+func (e exists) Expression() Expression               { return e }
+func (e exists) As(val interface{}) AliasedExpression { return NewAliasExpression(e, val) }
+func (e exists) Type() ExistsType                     { return e.existsType }
+func (e exists) Subquery() AppendableExpression       { return e.subquery }
+
+// Creates a new quantified expression to be used as the right hand side of a comparison
+//
+//	I("a").Eq(NewQuantifiedExpression(AllQuantifierType, From("test").Select("b"))) -> ("a" = ALL(SELECT "b" FROM "test"))
+func NewQuantifiedExpression(quantifier QuantifierType, value interface{}) QuantifiedExpression {
+	return quantified{quantifier: quantifier, value: value}
+}
+
+func (q quantified) Clone() Expression {
+	if e, ok := q.value.(Expression); ok {
+		return NewQuantifiedExpression(q.quantifier, e.Clone())
+	}
+	return NewQuantifiedExpression(q.quantifier, q.value)
+}
+
+func (q quantified) Expression() Expression     { return q }
+func (q quantified) Quantifier() QuantifierType { return q.quantifier }
+func (q quantified) Value() interface{}         { return q.value }
@@ -0,0 +1,87 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type existsExpressionSuite struct {
+	suite.Suite
+}
+
+func TestExistsExpressionSuite(t *testing.T) {
+	suite.Run(t, &existsExpressionSuite{})
+}
+
+func (ees *existsExpressionSuite) TestClone() {
+	ee := exp.NewExistsExpression(exp.ExistsSubqueryType, newTestAppendableExpression(`SELECT * FROM "test"`, []interface{}{}))
+	ees.Equal(
+		exp.NewExistsExpression(exp.ExistsSubqueryType, newTestAppendableExpression(`SELECT * FROM "test"`, []interface{}{})),
+		ee.Clone(),
+	)
+}
+
+func (ees *existsExpressionSuite) TestExpression() {
+	ee := exp.NewExistsExpression(exp.ExistsSubqueryType, newTestAppendableExpression(`SELECT * FROM "test"`, []interface{}{}))
+	ees.Equal(ee, ee.Expression())
+}
+
+func (ees *existsExpressionSuite) TestType() {
+	ae := newTestAppendableExpression(`SELECT * FROM "test"`, []interface{}{})
+	ees.Equal(exp.ExistsSubqueryType, exp.NewExistsExpression(exp.ExistsSubqueryType, ae).Type())
+	ees.Equal(exp.NotExistsSubqueryType, exp.NewExistsExpression(exp.NotExistsSubqueryType, ae).Type())
+}
+
+func (ees *existsExpressionSuite) TestSubquery() {
+	ee := exp.NewExistsExpression(exp.ExistsSubqueryType, newTestAppendableExpression(`SELECT * FROM "test"`, []interface{}{}))
+	ees.Equal(newTestAppendableExpression(`SELECT * FROM "test"`, []interface{}{}), ee.Subquery())
+}
+
+func (ees *existsExpressionSuite) TestAs() {
+	ee := exp.NewExistsExpression(exp.ExistsSubqueryType, newTestAppendableExpression(`SELECT * FROM "test"`, []interface{}{}))
+	ees.Equal(exp.NewAliasExpression(ee, "foo"), ee.As("foo"))
+}
+
+func (ees *existsExpressionSuite) TestExistsType_String() {
+	ees.Equal("EXISTS", exp.ExistsSubqueryType.String())
+	ees.Equal("NOT EXISTS", exp.NotExistsSubqueryType.String())
+}
+
+type quantifiedExpressionSuite struct {
+	suite.Suite
+}
+
+func TestQuantifiedExpressionSuite(t *testing.T) {
+	suite.Run(t, &quantifiedExpressionSuite{})
+}
+
+func (qes *quantifiedExpressionSuite) TestClone() {
+	qe := exp.NewQuantifiedExpression(exp.AnyQuantifierType, exp.NewIdentifierExpression("", "", "a"))
+	qes.Equal(exp.NewQuantifiedExpression(exp.AnyQuantifierType, exp.NewIdentifierExpression("", "", "a")), qe.Clone())
+
+	qe = exp.NewQuantifiedExpression(exp.AllQuantifierType, []int{1, 2})
+	qes.Equal(exp.NewQuantifiedExpression(exp.AllQuantifierType, []int{1, 2}), qe.Clone())
+}
+
+func (qes *quantifiedExpressionSuite) TestExpression() {
+	qe := exp.NewQuantifiedExpression(exp.AnyQuantifierType, exp.NewIdentifierExpression("", "", "a"))
+	qes.Equal(qe, qe.Expression())
+}
+
+func (qes *quantifiedExpressionSuite) TestQuantifier() {
+	ae := newTestAppendableExpression(`SELECT "a" FROM "test"`, []interface{}{})
+	qes.Equal(exp.AnyQuantifierType, exp.NewQuantifiedExpression(exp.AnyQuantifierType, ae).Quantifier())
+	qes.Equal(exp.AllQuantifierType, exp.NewQuantifiedExpression(exp.AllQuantifierType, ae).Quantifier())
+}
+
+func (qes *quantifiedExpressionSuite) TestValue() {
+	ae := newTestAppendableExpression(`SELECT "a" FROM "test"`, []interface{}{})
+	qes.Equal(ae, exp.NewQuantifiedExpression(exp.AnyQuantifierType, ae).Value())
+}
+
+func (qes *quantifiedExpressionSuite) TestQuantifierType_String() {
+	qes.Equal("ANY", exp.AnyQuantifierType.String())
+	qes.Equal("ALL", exp.AllQuantifierType.String())
+}
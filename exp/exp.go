@@ -56,6 +56,12 @@ type (
 		IsFalse() BooleanExpression
 		// Shortcut for IsNot(false)
 		IsNotFalse() BooleanExpression
+		// Creates a NULL safe inequality Boolean expression
+		//   ds.Where(I("a").IsDistinctFrom(I("b"))) //("a" IS DISTINCT FROM "b")
+		IsDistinctFrom(interface{}) BooleanExpression
+		// Creates a NULL safe equality Boolean expression
+		//   ds.Where(I("a").IsNotDistinctFrom(I("b"))) //("a" IS NOT DISTINCT FROM "b")
+		IsNotDistinctFrom(interface{}) BooleanExpression
 	}
 
 	Likeable interface {
@@ -159,6 +165,27 @@ type (
 		// I("col").BitRighttShift(1) // ("col" >> 1)
 		BitwiseRightShift(interface{}) BitwiseExpression
 	}
+
+	Arithmeticable interface {
+		// Creates an Arithmetic Expression for sql +
+		// I("col").Add(1) // ("col" + 1)
+		Add(interface{}) ArithmeticExpression
+		// Creates an Arithmetic Expression for sql -
+		// I("col").Sub(1) // ("col" - 1)
+		Sub(interface{}) ArithmeticExpression
+		// Creates an Arithmetic Expression for sql *
+		// I("col").Mul(2) // ("col" * 2)
+		Mul(interface{}) ArithmeticExpression
+		// Creates an Arithmetic Expression for sql /
+		// I("col").Div(2) // ("col" / 2)
+		Div(interface{}) ArithmeticExpression
+		// Creates an Arithmetic Expression for sql %
+		// I("col").Mod(2) // ("col" % 2)
+		Mod(interface{}) ArithmeticExpression
+		// Creates an Arithmetic Expression for sql unary minus
+		// I("col").Neg() // (- "col")
+		Neg() ArithmeticExpression
+	}
 )
 
 type (
@@ -237,6 +264,26 @@ type (
 		RHS() interface{}
 	}
 
+	ArithmeticOperation  int
+	ArithmeticExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Isable
+		Inable
+		Rangeable
+		Orderable
+		Distinctable
+		Castable
+		Arithmeticable
+		// Returns the operator for the expression
+		Op() ArithmeticOperation
+		// The left hand side of the expression (e.g. I("a"), nil for unary operations
+		LHS() Expression
+		// The right hand side of the expression could be a primitive value, dataset, or expression
+		RHS() interface{}
+	}
+
 	// An Expression that represents another Expression casted to a SQL type
 	CastExpression interface {
 		Expression
@@ -248,6 +295,7 @@ type (
 		Orderable
 		Distinctable
 		Rangeable
+		Arithmeticable
 		// The exression being casted
 		Casted() Expression
 		// The the SQL type to cast the expression to
@@ -263,6 +311,19 @@ type (
 		// Returns a new ColumnListExpression with the columns appended.
 		Append(...Expression) ColumnListExpression
 	}
+	GroupingType int
+	// An Expression for advanced GROUP BY constructs
+	//   ROLLUP("a", "b")
+	//   CUBE("a", "b")
+	//   GROUPING SETS(("a", "b"), ("a"), ())
+	GroupingExpression interface {
+		Expression
+		// Returns the type of grouping (e.g. ROLLUP, CUBE, GROUPING SETS)
+		Type() GroupingType
+		// Returns the grouping elements. For ROLLUP and CUBE an element with more than one column is a composite
+		// column, for GROUPING SETS each element is a grouping set.
+		Sets() []ColumnListExpression
+	}
 	CompoundType       int
 	CompoundExpression interface {
 		Expression
@@ -319,6 +380,7 @@ type (
 		Distinctable
 		Castable
 		Bitwiseable
+		Arithmeticable
 		// returns true if this identifier has more more than on part (Schema, Table or Col)
 		//	"schema" -> true //cant qualify anymore
 		//	"schema.table" -> true
@@ -345,6 +407,9 @@ type (
 
 		// Returns true if schema table and identifier are all zero values.
 		IsEmpty() bool
+		// Returns a new expression that compares and orders using the collation
+		//   I("name").Collate("C") -> "name" COLLATE "C"
+		Collate(collation string) CollateExpression
 	}
 	InsertExpression interface {
 		Expression
@@ -376,6 +441,256 @@ type (
 		Table() AppendableExpression
 	}
 
+	ExistsType int
+	// Expression for an EXISTS or NOT EXISTS subquery
+	//   NewExistsExpression(ExistsSubqueryType, From("test")) -> EXISTS (SELECT * FROM "test")
+	//   NewExistsExpression(NotExistsSubqueryType, From("test")) -> NOT EXISTS (SELECT * FROM "test")
+	ExistsExpression interface {
+		Expression
+		Aliaseable
+		// Returns the type of the expression (EXISTS, NOT EXISTS)
+		Type() ExistsType
+		// Returns the subquery
+		Subquery() AppendableExpression
+	}
+
+	QuantifierType int
+	// Expression for the right hand side of a quantified comparison
+	//   I("a").Gt(NewQuantifiedExpression(AnyQuantifierType, From("test").Select("b")))
+	//     -> ("a" > ANY(SELECT "b" FROM "test"))
+	QuantifiedExpression interface {
+		Expression
+		// Returns the quantifier (ANY, ALL)
+		Quantifier() QuantifierType
+		// Returns the quantified value, typically a subquery
+		Value() interface{}
+	}
+
+	DateTimeUnit int
+	// Expression for an interval of a single unit, used with DateAdd and DateSub
+	//   NewIntervalExpression(7, DayUnit) -> INTERVAL '7 days'
+	IntervalExpression interface {
+		Expression
+		// Returns the number of units in the interval
+		Amount() int64
+		// Returns the unit of the interval
+		Unit() DateTimeUnit
+	}
+
+	DateArithmeticOperation int
+	// Expression for adding or subtracting an interval from a date or time
+	//   NewDateArithmeticExpression(DateSubOp, I("ts"), NewIntervalExpression(7, DayUnit))
+	//     -> ("ts" - INTERVAL '7 days') //postgres
+	//     -> DATE_SUB(`ts`, INTERVAL 7 DAY) //mysql
+	DateArithmeticExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Inable
+		Isable
+		Orderable
+		Rangeable
+		// Returns the operation (add, sub)
+		Op() DateArithmeticOperation
+		// Returns the date or time the interval is added to or subtracted from
+		LHS() Expression
+		// Returns the interval
+		Interval() IntervalExpression
+	}
+
+	// Expression for extracting a field from a date or time
+	//   NewExtractExpression(YearUnit, I("ts")) -> EXTRACT(YEAR FROM "ts")
+	ExtractExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Inable
+		Isable
+		Orderable
+		Rangeable
+		// Returns the field to extract
+		Field() DateTimeUnit
+		// Returns the date or time the field is extracted from
+		Source() Expression
+	}
+
+	// Expression for truncating a date or time to the unit
+	//   NewDateTruncExpression(MonthUnit, I("ts")) -> date_trunc('month', "ts")
+	DateTruncExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Inable
+		Isable
+		Orderable
+		Rangeable
+		// Returns the unit the date or time is truncated to
+		Unit() DateTimeUnit
+		// Returns the date or time being truncated
+		Source() Expression
+	}
+
+	CurrentTimeType int
+	// Expression for the current date or time
+	//   NewCurrentTimeExpression(NowCurrentTimeType) -> NOW()
+	//   NewCurrentTimeExpression(TimestampCurrentTimeType) -> CURRENT_TIMESTAMP
+	CurrentTimeExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Orderable
+		Rangeable
+		// Returns the type of the current time (NOW(), CURRENT_TIMESTAMP, CURRENT_DATE, CURRENT_TIME)
+		Type() CurrentTimeType
+	}
+
+	StringOperation int
+	// Expression for a string operation, the function name and argument shape are mapped per dialect
+	//   NewStringExpression(ConcatOp, I("first"), " ", I("last")) -> ("first" || ' ' || "last")
+	//   NewStringExpression(LowerOp, I("name")) -> LOWER("name")
+	//   NewStringExpression(SubstringOp, I("name"), 1, 3) -> SUBSTRING("name", 1, 3)
+	//   NewStringExpression(PositionOp, I("name"), "a") -> POSITION('a' IN "name")
+	StringExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Inable
+		Isable
+		Likeable
+		Orderable
+		Rangeable
+		Castable
+		// Returns the operation (concat, lower, upper, trim, substring, length, position, replace)
+		Op() StringOperation
+		// Returns the arguments of the operation, except for concat the first argument is the string operated on
+		Args() []interface{}
+		// Returns a new expression that compares and orders using the collation
+		Collate(collation string) CollateExpression
+	}
+
+	// Expression applying a collation to an expression
+	//   NewCollateExpression(I("name"), "C") -> "name" COLLATE "C"
+	CollateExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Inable
+		Isable
+		Likeable
+		Orderable
+		Rangeable
+		// Returns the expression the collation is applied to
+		Collated() Expression
+		// Returns the name of the collation
+		Collation() string
+	}
+
+	TextSearchType int
+	TextSearchMode int
+	// Expression for a full-text search match or the rank of the match
+	//   NewTextSearchExpression(TextSearchMatchType, []Expression{I("body")}, "cats")
+	//     -> (to_tsvector("body") @@ websearch_to_tsquery('cats'))
+	//   NewTextSearchExpression(TextSearchRankType, []Expression{I("body")}, "cats")
+	//     -> ts_rank(to_tsvector("body"), websearch_to_tsquery('cats'))
+	TextSearchExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Orderable
+		// Returns the type of the expression (match, rank)
+		Type() TextSearchType
+		// Returns the documents (columns or tables) that are searched
+		Documents() []Expression
+		// Returns the search query
+		Query() interface{}
+		// Returns the search mode (natural language, boolean)
+		Mode() TextSearchMode
+		// Returns the text search configuration or language, an empty string uses the database default
+		Config() string
+		// Returns a copy of the expression using the text search configuration or language (e.g. english)
+		WithConfig(config string) TextSearchExpression
+		// Returns a copy of the expression using the search mode
+		WithMode(mode TextSearchMode) TextSearchExpression
+		// Returns a rank expression for the same documents, query, mode and config
+		Rank() TextSearchExpression
+	}
+
+	JSONOperation int
+	// Expression for operations on JSON documents
+	//   NewJSONExpression(JSONExtractOp, I("data"), []interface{}{"a", 0}) -> ("data"->'a'->0)
+	//   NewJSONExpression(JSONExtractTextOp, I("data"), []interface{}{"a"}) -> ("data"->>'a')
+	//   NewJSONExpression(JSONContainsOp, I("data"), `{"a":1}`) -> ("data" @> '{"a":1}')
+	//   NewJSONExpression(JSONHasKeyOp, I("data"), "a") -> jsonb_exists("data", 'a')
+	//   NewJSONExpression(JSONArrayLengthOp, I("data"), nil) -> jsonb_array_length("data")
+	JSONExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Inable
+		Isable
+		Likeable
+		Rangeable
+		Orderable
+		Distinctable
+		Castable
+		Arithmeticable
+		// Returns the JSON operation
+		Op() JSONOperation
+		// Returns the JSON document the operation is applied to
+		LHS() Expression
+		// Returns the right hand side of the operation: the path ([]interface{} of string keys and integer indexes) for
+		// JSONExtractOp and JSONExtractTextOp, the key for JSONHasKeyOp, the candidate for JSONContainsOp and nil for
+		// JSONArrayLengthOp
+		RHS() interface{}
+		// Returns a new expression extracting the value at the path as JSON, extending the path of a
+		// JSONExtractOp expression
+		//   I("data").Get("a").Get("b") -> ("data"->'a'->'b')
+		Get(path ...interface{}) JSONExpression
+		// Returns a new expression extracting the value at the path as text, extending the path of a
+		// JSONExtractOp expression
+		//   I("data").Get("a").GetText("b") -> ("data"->'a'->>'b')
+		GetText(path ...interface{}) JSONExpression
+		// Returns a new JSON containment expression, the candidate is encoded as JSON if it is not a string,
+		// []byte or Expression
+		Contains(candidate interface{}) JSONExpression
+		// Returns a new JSON key existence expression
+		HasKey(key string) JSONExpression
+		// Returns a new JSON array length expression
+		ArrayLength() JSONExpression
+	}
+
+	// Expression for an array value
+	//   NewArrayExpression("a", "b") -> ARRAY['a', 'b']
+	//   NewArrayExpression([]int{1, 2}).Contains([]int{1}) -> (ARRAY[1, 2] @> ARRAY[1])
+	ArrayExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Castable
+		// Returns the elements of the array, always a slice
+		Elements() interface{}
+		// Returns a BooleanExpression checking that the array contains every element of val
+		//   NewArrayExpression("a", "b").Contains([]string{"a"}) -> (ARRAY['a', 'b'] @> ARRAY['a'])
+		Contains(val interface{}) BooleanExpression
+		// Returns a BooleanExpression checking that every element of the array is in val
+		//   NewArrayExpression("a").ContainedBy([]string{"a", "b"}) -> (ARRAY['a'] <@ ARRAY['a', 'b'])
+		ContainedBy(val interface{}) BooleanExpression
+		// Returns a BooleanExpression checking that the array and val have at least one element in common
+		//   NewArrayExpression("a", "b").Overlaps([]string{"b", "c"}) -> (ARRAY['a', 'b'] && ARRAY['b', 'c'])
+		Overlaps(val interface{}) BooleanExpression
+	}
+
+	// Expression for a row value (tuple) used in multi-column comparisons and IN lists
+	//   NewTupleExpression(I("a"), I("b")).Gt([]interface{}{1, 2}) -> (("a", "b") > (1, 2))
+	//   NewTupleExpression(I("a"), I("b")).In([][]interface{}{{1, 2}, {1, 3}}) -> (("a", "b") IN ((1, 2), (1, 3)))
+	TupleExpression interface {
+		Expression
+		Comparable
+		Inable
+		// Returns the values of the tuple
+		Values() []interface{}
+	}
+
 	// Expression for representing "literal" sql.
 	//  L("col = 1") -> col = 1)
 	//  L("? = ?", I("col"), 1) -> "col" = 1
@@ -389,6 +704,7 @@ type (
 		Rangeable
 		Orderable
 		Bitwiseable
+		Arithmeticable
 		// Returns the literal sql
 		Literal() string
 		// Arguments to be replaced within the sql
@@ -443,10 +759,34 @@ type (
 		Inable
 		Likeable
 		Windowable
+		Arithmeticable
 		// The function name
 		Name() string
 		// Arguments to be passed to the function
 		Args() []interface{}
+
+		// Returns a new SQLFunctionExpression with a FILTER (WHERE ...) clause. Calling Filter multiple
+		// times will AND the conditions together
+		//   SUM("a").Filter(C("b").Gt(10)) -> SUM("a") FILTER (WHERE ("b" > 10))
+		Filter(conditions ...Expression) SQLFunctionExpression
+		// The conditions of the FILTER clause
+		FilterConditions() ExpressionList
+		// Returns true if the function has a FILTER clause
+		HasFilter() bool
+		// Returns a new SQLFunctionExpression with an ORDER BY inside the function call
+		//   Func("string_agg", I("name"), ",").OrderBy(I("name").Asc()) -> string_agg("name", ',' ORDER BY "name" ASC)
+		OrderBy(cols ...interface{}) SQLFunctionExpression
+		// The columns of the in-call ORDER BY
+		OrderCols() ColumnListExpression
+		// Returns true if the function has an in-call ORDER BY
+		HasOrder() bool
+		// Returns a new SQLFunctionExpression with a WITHIN GROUP (ORDER BY ...) clause
+		//   Func("percentile_cont", 0.5).WithinGroup(I("a").Asc()) -> percentile_cont(0.5) WITHIN GROUP (ORDER BY "a" ASC)
+		WithinGroup(cols ...interface{}) SQLFunctionExpression
+		// The columns of the WITHIN GROUP clause
+		WithinGroupCols() ColumnListExpression
+		// Returns true if the function has a WITHIN GROUP clause
+		HasWithinGroup() bool
 	}
 
 	UpdateExpression interface {
@@ -485,9 +825,62 @@ type (
 		OrderCols() ColumnListExpression
 		HasOrder() bool
 
+		Frame() WindowFrameExpression
+		HasFrame() bool
+
 		Inherit(parent string) WindowExpression
 		PartitionBy(cols ...interface{}) WindowExpression
 		OrderBy(cols ...interface{}) WindowExpression
+		// Sets a ROWS frame with only a start bound
+		//   W().OrderBy("ts").Rows(UnboundedPreceding()) -> (ORDER BY "ts" ROWS UNBOUNDED PRECEDING)
+		Rows(start WindowFrameBound) WindowExpression
+		// Sets a ROWS BETWEEN frame
+		//   W().OrderBy("ts").RowsBetween(Preceding(6), CurrentRow())
+		//     -> (ORDER BY "ts" ROWS BETWEEN 6 PRECEDING AND CURRENT ROW)
+		RowsBetween(start, end WindowFrameBound) WindowExpression
+		// Sets a RANGE frame with only a start bound
+		Range(start WindowFrameBound) WindowExpression
+		// Sets a RANGE BETWEEN frame
+		RangeBetween(start, end WindowFrameBound) WindowExpression
+		// Sets a GROUPS frame with only a start bound
+		Groups(start WindowFrameBound) WindowExpression
+		// Sets a GROUPS BETWEEN frame
+		GroupsBetween(start, end WindowFrameBound) WindowExpression
+		// Sets the EXCLUDE option of the frame
+		//   W().OrderBy("ts").RowsBetween(Preceding(1), Following(1)).Exclude(TiesFrameExclusion)
+		//     -> (ORDER BY "ts" ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE TIES)
+		Exclude(exclusion WindowFrameExclusion) WindowExpression
+	}
+
+	WindowFrameUnit      int
+	WindowFrameExclusion int
+	// Expression for the frame portion of a window (e.g. ROWS BETWEEN 6 PRECEDING AND CURRENT ROW)
+	WindowFrameExpression interface {
+		Expression
+		// Returns the frame unit (ROWS, RANGE, GROUPS)
+		Unit() WindowFrameUnit
+		// Returns the start bound of the frame
+		Start() WindowFrameBound
+		// Returns the end bound of the frame, nil if the frame only has a start bound
+		End() WindowFrameBound
+		// Returns true if the frame has an end bound
+		HasEnd() bool
+		// Returns the EXCLUDE option of the frame
+		Exclusion() WindowFrameExclusion
+		// Returns true if the frame has an EXCLUDE option
+		HasExclusion() bool
+		// Returns a new WindowFrameExpression with the EXCLUDE option set
+		Exclude(exclusion WindowFrameExclusion) WindowFrameExpression
+	}
+
+	WindowFrameBoundType int
+	// A single bound of a window frame (e.g. UNBOUNDED PRECEDING, 6 PRECEDING, CURRENT ROW)
+	WindowFrameBound interface {
+		Expression
+		// Returns the type of the bound
+		Type() WindowFrameBoundType
+		// Returns the offset for n PRECEDING and n FOLLOWING bounds
+		Offset() interface{}
 	}
 	CaseElse interface {
 		Result() interface{}
@@ -514,6 +907,8 @@ const (
 	UnionAllCompoundType
 	IntersectCompoundType
 	IntersectAllCompoundType
+	ExceptCompoundType
+	ExceptAllCompoundType
 
 	DoNothingConflictAction ConflictAction = iota
 	DoUpdateConflictAction
@@ -589,6 +984,16 @@ const (
 	RegexpILikeOp
 	// !~*, NOT REGEXP
 	RegexpNotILikeOp
+	// IS DISTINCT FROM
+	IsDistinctFromOp
+	// IS NOT DISTINCT FROM, <=>
+	IsNotDistinctFromOp
+	// @>
+	ArrayContainsOp
+	// <@
+	ArrayContainedByOp
+	// &&
+	ArrayOverlapsOp
 
 	betweenStr = "between"
 
@@ -598,6 +1003,134 @@ const (
 	BitwiseXorOp
 	BitwiseLeftShiftOp
 	BitwiseRightShiftOp
+
+	// +
+	AddOp ArithmeticOperation = iota
+	// -
+	SubOp
+	// *
+	MulOp
+	// /
+	DivOp
+	// %
+	ModOp
+	// unary -
+	NegOp
+
+	// ->, JSON_EXTRACT, json_extract, JSON_QUERY
+	JSONExtractOp JSONOperation = iota
+	// ->>, json_extract, JSON_VALUE
+	JSONExtractTextOp
+	// @>, JSON_CONTAINS
+	JSONContainsOp
+	// ?
+	JSONHasKeyOp
+	// jsonb_array_length, JSON_LENGTH, json_array_length
+	JSONArrayLengthOp
+
+	MicrosecondUnit DateTimeUnit = iota
+	MillisecondUnit
+	SecondUnit
+	MinuteUnit
+	HourUnit
+	DayUnit
+	WeekUnit
+	MonthUnit
+	QuarterUnit
+	YearUnit
+	// only used with EXTRACT, the day of the week
+	DayOfWeekUnit
+	// only used with EXTRACT, the day of the year
+	DayOfYearUnit
+	// only used with EXTRACT, seconds since 1970-01-01 00:00:00 UTC
+	EpochUnit
+
+	// +, DATE_ADD, DATEADD
+	DateAddOp DateArithmeticOperation = iota
+	// -, DATE_SUB, DATEADD with a negated amount
+	DateSubOp
+
+	// NOW()
+	NowCurrentTimeType CurrentTimeType = iota
+	// CURRENT_TIMESTAMP
+	TimestampCurrentTimeType
+	// CURRENT_DATE
+	DateCurrentTimeType
+	// CURRENT_TIME
+	TimeCurrentTimeType
+
+	// ||, CONCAT, +
+	ConcatOp StringOperation = iota
+	// LOWER
+	LowerOp
+	// UPPER
+	UpperOp
+	// TRIM
+	TrimOp
+	// SUBSTRING, SUBSTR
+	SubstringOp
+	// LENGTH, CHAR_LENGTH, LEN
+	LengthOp
+	// POSITION, INSTR, CHARINDEX
+	PositionOp
+	// REPLACE
+	ReplaceOp
+
+	// to_tsvector @@ websearch_to_tsquery, MATCH ... AGAINST, MATCH, CONTAINS
+	TextSearchMatchType TextSearchType = iota
+	// ts_rank, MATCH ... AGAINST, bm25
+	TextSearchRankType
+
+	// websearch_to_tsquery, IN NATURAL LANGUAGE MODE, FREETEXT
+	NaturalLanguageTextSearchMode TextSearchMode = iota
+	// to_tsquery, IN BOOLEAN MODE, CONTAINS
+	BooleanTextSearchMode
+
+	// EXISTS
+	ExistsSubqueryType ExistsType = iota
+	// NOT EXISTS
+	NotExistsSubqueryType
+
+	// ANY
+	AnyQuantifierType QuantifierType = iota
+	// ALL
+	AllQuantifierType
+
+	// ROLLUP(...)
+	RollupGroupingType GroupingType = iota
+	// CUBE(...)
+	CubeGroupingType
+	// GROUPING SETS(...)
+	GroupingSetsGroupingType
+
+	// ROWS
+	RowsFrameUnit WindowFrameUnit = iota
+	// RANGE
+	RangeFrameUnit
+	// GROUPS
+	GroupsFrameUnit
+
+	// UNBOUNDED PRECEDING
+	UnboundedPrecedingFrameBound WindowFrameBoundType = iota
+	// n PRECEDING
+	PrecedingFrameBound
+	// CURRENT ROW
+	CurrentRowFrameBound
+	// n FOLLOWING
+	FollowingFrameBound
+	// UNBOUNDED FOLLOWING
+	UnboundedFollowingFrameBound
+
+	// Default frame exclusion with no EXCLUDE clause
+	NoFrameExclusion WindowFrameExclusion = iota
+	// EXCLUDE CURRENT ROW
+	CurrentRowFrameExclusion
+	// EXCLUDE GROUP
+	GroupFrameExclusion
+	// EXCLUDE TIES
+	TiesFrameExclusion
+	// EXCLUDE NO OTHERS
+	NoOthersFrameExclusion
 )
 
 var (
@@ -612,24 +1145,26 @@ var (
 	}
 	// used internally for inverting operators
 	operatorInversions = map[BooleanOperation]BooleanOperation{
-		IsOp:             IsNotOp,
-		EqOp:             NeqOp,
-		GtOp:             LteOp,
-		GteOp:            LtOp,
-		LtOp:             GteOp,
-		LteOp:            GtOp,
-		InOp:             NotInOp,
-		LikeOp:           NotLikeOp,
-		ILikeOp:          NotILikeOp,
-		RegexpLikeOp:     RegexpNotLikeOp,
-		RegexpILikeOp:    RegexpNotILikeOp,
-		IsNotOp:          IsOp,
-		NeqOp:            EqOp,
-		NotInOp:          InOp,
-		NotLikeOp:        LikeOp,
-		NotILikeOp:       ILikeOp,
-		RegexpNotLikeOp:  RegexpLikeOp,
-		RegexpNotILikeOp: RegexpILikeOp,
+		IsOp:                IsNotOp,
+		EqOp:                NeqOp,
+		GtOp:                LteOp,
+		GteOp:               LtOp,
+		LtOp:                GteOp,
+		LteOp:               GtOp,
+		InOp:                NotInOp,
+		LikeOp:              NotLikeOp,
+		ILikeOp:             NotILikeOp,
+		RegexpLikeOp:        RegexpNotLikeOp,
+		RegexpILikeOp:       RegexpNotILikeOp,
+		IsDistinctFromOp:    IsNotDistinctFromOp,
+		IsNotOp:             IsOp,
+		NeqOp:               EqOp,
+		NotInOp:             InOp,
+		NotLikeOp:           LikeOp,
+		NotILikeOp:          ILikeOp,
+		RegexpNotLikeOp:     RegexpLikeOp,
+		RegexpNotILikeOp:    RegexpILikeOp,
+		IsNotDistinctFromOp: IsDistinctFromOp,
 	}
 )
 
@@ -671,6 +1206,16 @@ func (bo BooleanOperation) String() string {
 		return "regexpilike"
 	case RegexpNotILikeOp:
 		return "regexpnotilike"
+	case IsDistinctFromOp:
+		return "isdistinctfrom"
+	case IsNotDistinctFromOp:
+		return "isnotdistinctfrom"
+	case ArrayContainsOp:
+		return "arraycontains"
+	case ArrayContainedByOp:
+		return "arraycontainedby"
+	case ArrayOverlapsOp:
+		return "arrayoverlaps"
 	}
 	return fmt.Sprintf("%d", bo)
 }
@@ -693,6 +1238,138 @@ func (bi BitwiseOperation) String() string {
 	return fmt.Sprintf("%d", bi)
 }
 
+func (du DateTimeUnit) String() string {
+	switch du {
+	case MicrosecondUnit:
+		return "microsecond"
+	case MillisecondUnit:
+		return "millisecond"
+	case SecondUnit:
+		return "second"
+	case MinuteUnit:
+		return "minute"
+	case HourUnit:
+		return "hour"
+	case DayUnit:
+		return "day"
+	case WeekUnit:
+		return "week"
+	case MonthUnit:
+		return "month"
+	case QuarterUnit:
+		return "quarter"
+	case YearUnit:
+		return "year"
+	case DayOfWeekUnit:
+		return "day of week"
+	case DayOfYearUnit:
+		return "day of year"
+	case EpochUnit:
+		return "epoch"
+	}
+	return fmt.Sprintf("%d", du)
+}
+
+func (dao DateArithmeticOperation) String() string {
+	switch dao {
+	case DateAddOp:
+		return "add"
+	case DateSubOp:
+		return "sub"
+	}
+	return fmt.Sprintf("%d", dao)
+}
+
+func (ct CurrentTimeType) String() string {
+	switch ct {
+	case NowCurrentTimeType:
+		return "NOW"
+	case TimestampCurrentTimeType:
+		return "CURRENT_TIMESTAMP"
+	case DateCurrentTimeType:
+		return "CURRENT_DATE"
+	case TimeCurrentTimeType:
+		return "CURRENT_TIME"
+	}
+	return fmt.Sprintf("%d", ct)
+}
+
+func (so StringOperation) String() string {
+	switch so {
+	case ConcatOp:
+		return "concat"
+	case LowerOp:
+		return "lower"
+	case UpperOp:
+		return "upper"
+	case TrimOp:
+		return "trim"
+	case SubstringOp:
+		return "substring"
+	case LengthOp:
+		return "length"
+	case PositionOp:
+		return "position"
+	case ReplaceOp:
+		return "replace"
+	}
+	return fmt.Sprintf("%d", so)
+}
+
+func (tt TextSearchType) String() string {
+	switch tt {
+	case TextSearchMatchType:
+		return "match"
+	case TextSearchRankType:
+		return "rank"
+	}
+	return fmt.Sprintf("%d", tt)
+}
+
+func (tm TextSearchMode) String() string {
+	switch tm {
+	case NaturalLanguageTextSearchMode:
+		return "natural language"
+	case BooleanTextSearchMode:
+		return "boolean"
+	}
+	return fmt.Sprintf("%d", tm)
+}
+
+func (jo JSONOperation) String() string {
+	switch jo {
+	case JSONExtractOp:
+		return "extract"
+	case JSONExtractTextOp:
+		return "extract text"
+	case JSONContainsOp:
+		return "contains"
+	case JSONHasKeyOp:
+		return "has key"
+	case JSONArrayLengthOp:
+		return "array length"
+	}
+	return fmt.Sprintf("%d", jo)
+}
+
+func (ao ArithmeticOperation) String() string {
+	switch ao {
+	case AddOp:
+		return "Add"
+	case SubOp:
+		return "Subtract"
+	case MulOp:
+		return "Multiply"
+	case DivOp:
+		return "Divide"
+	case ModOp:
+		return "Modulo"
+	case NegOp:
+		return "Negate"
+	}
+	return fmt.Sprintf("%d", ao)
+}
+
 func (ro RangeOperation) String() string {
 	switch ro {
 	case BetweenOp:
@@ -703,6 +1380,56 @@ func (ro RangeOperation) String() string {
 	return fmt.Sprintf("%d", ro)
 }
 
+func (ct CompoundType) String() string {
+	switch ct {
+	case UnionCompoundType:
+		return "UNION"
+	case UnionAllCompoundType:
+		return "UNION ALL"
+	case IntersectCompoundType:
+		return "INTERSECT"
+	case IntersectAllCompoundType:
+		return "INTERSECT ALL"
+	case ExceptCompoundType:
+		return "EXCEPT"
+	case ExceptAllCompoundType:
+		return "EXCEPT ALL"
+	}
+	return fmt.Sprintf("%d", ct)
+}
+
+func (et ExistsType) String() string {
+	switch et {
+	case ExistsSubqueryType:
+		return "EXISTS"
+	case NotExistsSubqueryType:
+		return "NOT EXISTS"
+	}
+	return fmt.Sprintf("%d", et)
+}
+
+func (qt QuantifierType) String() string {
+	switch qt {
+	case AnyQuantifierType:
+		return "ANY"
+	case AllQuantifierType:
+		return "ALL"
+	}
+	return fmt.Sprintf("%d", qt)
+}
+
+func (gt GroupingType) String() string {
+	switch gt {
+	case RollupGroupingType:
+		return "ROLLUP"
+	case CubeGroupingType:
+		return "CUBE"
+	case GroupingSetsGroupingType:
+		return "GROUPING SETS"
+	}
+	return fmt.Sprintf("%d", gt)
+}
+
 func (jt JoinType) String() string {
 	switch jt {
 	case InnerJoinType:
@@ -732,3 +1459,47 @@ func (jt JoinType) String() string {
 	}
 	return fmt.Sprintf("%d", jt)
 }
+
+func (wfu WindowFrameUnit) String() string {
+	switch wfu {
+	case RowsFrameUnit:
+		return "ROWS"
+	case RangeFrameUnit:
+		return "RANGE"
+	case GroupsFrameUnit:
+		return "GROUPS"
+	}
+	return fmt.Sprintf("%d", wfu)
+}
+
+func (wfb WindowFrameBoundType) String() string {
+	switch wfb {
+	case UnboundedPrecedingFrameBound:
+		return "UNBOUNDED PRECEDING"
+	case PrecedingFrameBound:
+		return "PRECEDING"
+	case CurrentRowFrameBound:
+		return "CURRENT ROW"
+	case FollowingFrameBound:
+		return "FOLLOWING"
+	case UnboundedFollowingFrameBound:
+		return "UNBOUNDED FOLLOWING"
+	}
+	return fmt.Sprintf("%d", wfb)
+}
+
+func (wfe WindowFrameExclusion) String() string {
+	switch wfe {
+	case NoFrameExclusion:
+		return "NONE"
+	case CurrentRowFrameExclusion:
+		return "CURRENT ROW"
+	case GroupFrameExclusion:
+		return "GROUP"
+	case TiesFrameExclusion:
+		return "TIES"
+	case NoOthersFrameExclusion:
+		return "NO OTHERS"
+	}
+	return fmt.Sprintf("%d", wfe)
+}
@@ -2,6 +2,7 @@ package exp
 
 import (
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/orn-id/depiq/internal/errors"
@@ -20,6 +21,16 @@ type (
 	ExOr map[string]interface{}
 	// Used in tandem with the Ex map to create complex comparisons such as LIKE, GT, LT... See examples
 	Op map[string]interface{}
+
+	// the left hand side of an expression created from an Ex or ExOr key
+	exMapLHS interface {
+		Expression
+		Comparable
+		Inable
+		Isable
+		Likeable
+		Rangeable
+	}
 )
 
 func (e Ex) Expression() Expression {
@@ -75,7 +86,10 @@ func mapToExpressionList(ex map[string]interface{}, eType ExpressionListType) (E
 	keys := getExMapKeys(ex)
 	ret := make([]Expression, 0, len(keys))
 	for _, key := range keys {
-		lhs := ParseIdentifier(key)
+		lhs, err := parseExMapKey(key)
+		if err != nil {
+			return nil, err
+		}
 		rhs := ex[key]
 		var exp Expression
 		if op, ok := rhs.(Op); ok {
@@ -95,7 +109,49 @@ func mapToExpressionList(ex map[string]interface{}, eType ExpressionListType) (E
 	return NewExpressionList(AndType, ret...), nil
 }
 
-func createOredExpressionFromMap(lhs IdentifierExpression, op Op) ([]Expression, error) {
+func errInvalidExMapJSONPath(key, reason string) error {
+	return errors.New("invalid JSON path in key %q, %s", key, reason)
+}
+
+// Parses the key of an Ex or ExOr map. Keys containing -> are JSON paths where each segment is an object key or an
+// array index, if the last segment is preceded by ->> the value is extracted as text. A column whose name contains ->
+// has to be compared with an IdentifierExpression instead (e.g. C("a->b").Eq(1))
+//
+//	"a" -> "a"
+//	"data->address->>city" -> ("data"->'address'->>'city')
+//	"data->tags->0" -> ("data"->'tags'->0)
+func parseExMapKey(key string) (exMapLHS, error) {
+	idx := strings.Index(key, "->")
+	if idx == -1 {
+		return ParseIdentifier(key), nil
+	}
+	if idx == 0 {
+		return nil, errInvalidExMapJSONPath(key, "a column is required before ->")
+	}
+	op := JSONExtractOp
+	parts := strings.Split(key[idx+2:], "->")
+	path := make([]interface{}, 0, len(parts))
+	for i, part := range parts {
+		if strings.HasPrefix(part, ">") {
+			if i != len(parts)-1 {
+				return nil, errInvalidExMapJSONPath(key, "->> is only allowed before the last element")
+			}
+			part = part[1:]
+			op = JSONExtractTextOp
+		}
+		if part == "" || strings.HasPrefix(part, ">") {
+			return nil, errInvalidExMapJSONPath(key, "path elements can not be empty or start with >")
+		}
+		if index, err := strconv.Atoi(part); err == nil {
+			path = append(path, index)
+		} else {
+			path = append(path, part)
+		}
+	}
+	return NewJSONExpression(op, ParseIdentifier(key[:idx]), path), nil
+}
+
+func createOredExpressionFromMap(lhs exMapLHS, op Op) ([]Expression, error) {
 	opKeys := getExMapKeys(op)
 	ors := make([]Expression, 0, len(opKeys))
 	for _, opKey := range opKeys {
@@ -109,7 +165,7 @@ func createOredExpressionFromMap(lhs IdentifierExpression, op Op) ([]Expression,
 }
 
 // nolint:gocyclo // not complex just long
-func createExpressionFromOp(lhs IdentifierExpression, opKey string, op Op) (exp Expression, err error) {
+func createExpressionFromOp(lhs exMapLHS, opKey string, op Op) (exp Expression, err error) {
 	switch strings.ToLower(opKey) {
 	case EqOp.String():
 		exp = lhs.Eq(op[opKey])
@@ -119,6 +175,10 @@ func createExpressionFromOp(lhs IdentifierExpression, opKey string, op Op) (exp
 		exp = lhs.Is(op[opKey])
 	case IsNotOp.String():
 		exp = lhs.IsNot(op[opKey])
+	case IsDistinctFromOp.String():
+		exp = lhs.IsDistinctFrom(op[opKey])
+	case IsNotDistinctFromOp.String():
+		exp = lhs.IsNotDistinctFrom(op[opKey])
 	case GtOp.String():
 		exp = lhs.Gt(op[opKey])
 	case GteOp.String():
@@ -147,6 +207,12 @@ func createExpressionFromOp(lhs IdentifierExpression, opKey string, op Op) (exp
 		exp = lhs.RegexpILike(op[opKey])
 	case RegexpNotILikeOp.String():
 		exp = lhs.RegexpNotILike(op[opKey])
+	case ArrayContainsOp.String():
+		exp = arrayContains(lhs, op[opKey])
+	case ArrayContainedByOp.String():
+		exp = arrayContainedBy(lhs, op[opKey])
+	case ArrayOverlapsOp.String():
+		exp = arrayOverlaps(lhs, op[opKey])
 	case betweenStr:
 		rangeVal, ok := op[opKey].(RangeVal)
 		if ok {
@@ -65,6 +65,14 @@ func (ets *exTestSuite) TestToExpression() {
 			ExMap: exp.Ex{"a": exp.Op{"isNot": nil}},
 			El:    exp.NewExpressionList(exp.AndType, exp.NewExpressionList(exp.OrType, ident.IsNot(nil))),
 		},
+		{
+			ExMap: exp.Ex{"a": exp.Op{"isDistinctFrom": "b"}},
+			El:    exp.NewExpressionList(exp.AndType, exp.NewExpressionList(exp.OrType, ident.IsDistinctFrom("b"))),
+		},
+		{
+			ExMap: exp.Ex{"a": exp.Op{"isNotDistinctFrom": nil}},
+			El:    exp.NewExpressionList(exp.AndType, exp.NewExpressionList(exp.OrType, ident.IsNotDistinctFrom(nil))),
+		},
 		{
 			ExMap: exp.Ex{"a": exp.Op{"gt": "b"}},
 			El:    exp.NewExpressionList(exp.AndType, exp.NewExpressionList(exp.OrType, ident.Gt("b"))),
@@ -129,10 +137,65 @@ func (ets *exTestSuite) TestToExpression() {
 			ExMap: exp.Ex{"a": exp.Op{"notBetween": exp.NewRangeVal("a", "z")}},
 			El:    exp.NewExpressionList(exp.AndType, exp.NewExpressionList(exp.OrType, ident.NotBetween(exp.NewRangeVal("a", "z")))),
 		},
+		{
+			ExMap: exp.Ex{"a": exp.Op{"arrayContains": []string{"b"}}},
+			El: exp.NewExpressionList(exp.AndType, exp.NewExpressionList(
+				exp.OrType,
+				exp.NewBooleanExpression(exp.ArrayContainsOp, ident, []string{"b"}),
+			)),
+		},
+		{
+			ExMap: exp.Ex{"a": exp.Op{"arrayContainedBy": []string{"b"}}},
+			El: exp.NewExpressionList(exp.AndType, exp.NewExpressionList(
+				exp.OrType,
+				exp.NewBooleanExpression(exp.ArrayContainedByOp, ident, []string{"b"}),
+			)),
+		},
+		{
+			ExMap: exp.Ex{"a": exp.Op{"arrayOverlaps": []string{"b"}}},
+			El: exp.NewExpressionList(exp.AndType, exp.NewExpressionList(
+				exp.OrType,
+				exp.NewBooleanExpression(exp.ArrayOverlapsOp, ident, []string{"b"}),
+			)),
+		},
 		{
 			ExMap: exp.Ex{"a": exp.Op{"foo": "z"}},
 			Err:   "depiq: unsupported expression type foo",
 		},
+		{
+			ExMap: exp.Ex{"a->b->0": "c"},
+			El: exp.NewExpressionList(
+				exp.AndType,
+				exp.NewJSONExpression(exp.JSONExtractOp, ident, []interface{}{"b", 0}).Eq("c"),
+			),
+		},
+		{
+			ExMap: exp.Ex{"t.a->b->>c": exp.Op{"like": "d%"}},
+			El: exp.NewExpressionList(exp.AndType, exp.NewExpressionList(
+				exp.OrType,
+				exp.NewJSONExpression(
+					exp.JSONExtractTextOp,
+					exp.NewIdentifierExpression("", "t", "a"),
+					[]interface{}{"b", "c"},
+				).Like("d%"),
+			)),
+		},
+		{
+			ExMap: exp.Ex{"a->>b->c": "d"},
+			Err:   `depiq: invalid JSON path in key "a->>b->c", ->> is only allowed before the last element`,
+		},
+		{
+			ExMap: exp.Ex{"a->b->": "c"},
+			Err:   `depiq: invalid JSON path in key "a->b->", path elements can not be empty or start with >`,
+		},
+		{
+			ExMap: exp.Ex{"a->>>b": "c"},
+			Err:   `depiq: invalid JSON path in key "a->>>b", path elements can not be empty or start with >`,
+		},
+		{
+			ExMap: exp.Ex{"->b": "c"},
+			Err:   `depiq: invalid JSON path in key "->b", a column is required before ->`,
+		},
 		{
 			ExMap: exp.Ex{"a": exp.Op{"eq": "b", "neq": "c", "gt": "m"}},
 			El:    exp.NewExpressionList(exp.AndType, exp.NewExpressionList(exp.OrType, ident.Eq("b"), ident.Gt("m"), ident.Neq("c"))),
@@ -2,8 +2,11 @@ package exp
 
 type (
 	sqlFunctionExpression struct {
-		name string
-		args []interface{}
+		name            string
+		args            []interface{}
+		filter          ExpressionList
+		orderCols       ColumnListExpression
+		withinGroupCols ColumnListExpression
 	}
 )
 
@@ -12,8 +15,22 @@ func NewSQLFunctionExpression(name string, args ...interface{}) SQLFunctionExpre
 	return sqlFunctionExpression{name: name, args: args}
 }
 
+func (sfe sqlFunctionExpression) clone() sqlFunctionExpression {
+	ret := sqlFunctionExpression{name: sfe.name, args: sfe.args}
+	if sfe.filter != nil {
+		ret.filter = sfe.filter.Clone().(ExpressionList)
+	}
+	if sfe.orderCols != nil {
+		ret.orderCols = sfe.orderCols.Clone().(ColumnListExpression)
+	}
+	if sfe.withinGroupCols != nil {
+		ret.withinGroupCols = sfe.withinGroupCols.Clone().(ColumnListExpression)
+	}
+	return ret
+}
+
 func (sfe sqlFunctionExpression) Clone() Expression {
-	return sqlFunctionExpression{name: sfe.name, args: sfe.args}
+	return sfe.clone()
 }
 
 func (sfe sqlFunctionExpression) Expression() Expression { return sfe }
@@ -22,6 +39,46 @@ func (sfe sqlFunctionExpression) Args() []interface{} { return sfe.args }
 
 func (sfe sqlFunctionExpression) Name() string { return sfe.name }
 
+func (sfe sqlFunctionExpression) Filter(conditions ...Expression) SQLFunctionExpression {
+	ret := sfe.clone()
+	if ret.filter == nil {
+		ret.filter = NewExpressionList(AndType, conditions...)
+	} else {
+		ret.filter = ret.filter.Append(conditions...)
+	}
+	return ret
+}
+
+func (sfe sqlFunctionExpression) FilterConditions() ExpressionList { return sfe.filter }
+
+func (sfe sqlFunctionExpression) HasFilter() bool {
+	return sfe.filter != nil && !sfe.filter.IsEmpty()
+}
+
+func (sfe sqlFunctionExpression) OrderBy(cols ...interface{}) SQLFunctionExpression {
+	ret := sfe.clone()
+	ret.orderCols = NewColumnListExpression(cols...)
+	return ret
+}
+
+func (sfe sqlFunctionExpression) OrderCols() ColumnListExpression { return sfe.orderCols }
+
+func (sfe sqlFunctionExpression) HasOrder() bool {
+	return sfe.orderCols != nil && !sfe.orderCols.IsEmpty()
+}
+
+func (sfe sqlFunctionExpression) WithinGroup(cols ...interface{}) SQLFunctionExpression {
+	ret := sfe.clone()
+	ret.withinGroupCols = NewColumnListExpression(cols...)
+	return ret
+}
+
+func (sfe sqlFunctionExpression) WithinGroupCols() ColumnListExpression { return sfe.withinGroupCols }
+
+func (sfe sqlFunctionExpression) HasWithinGroup() bool {
+	return sfe.withinGroupCols != nil && !sfe.withinGroupCols.IsEmpty()
+}
+
 func (sfe sqlFunctionExpression) As(val interface{}) AliasedExpression {
 	return NewAliasExpression(sfe, val)
 }
@@ -76,6 +133,12 @@ func (sfe sqlFunctionExpression) IsTrue() BooleanExpression               { retu
 func (sfe sqlFunctionExpression) IsNotTrue() BooleanExpression            { return isNot(sfe, true) }
 func (sfe sqlFunctionExpression) IsFalse() BooleanExpression              { return is(sfe, false) }
 func (sfe sqlFunctionExpression) IsNotFalse() BooleanExpression           { return isNot(sfe, false) }
+func (sfe sqlFunctionExpression) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(sfe, val)
+}
+func (sfe sqlFunctionExpression) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(sfe, val)
+}
 
 func (sfe sqlFunctionExpression) Over(we WindowExpression) SQLWindowFunctionExpression {
 	return NewSQLWindowFunctionExpression(sfe, nil, we)
@@ -87,3 +150,10 @@ func (sfe sqlFunctionExpression) OverName(windowName IdentifierExpression) SQLWi
 
 func (sfe sqlFunctionExpression) Asc() OrderedExpression  { return asc(sfe) }
 func (sfe sqlFunctionExpression) Desc() OrderedExpression { return desc(sfe) }
+
+func (sfe sqlFunctionExpression) Add(val interface{}) ArithmeticExpression { return add(sfe, val) }
+func (sfe sqlFunctionExpression) Sub(val interface{}) ArithmeticExpression { return sub(sfe, val) }
+func (sfe sqlFunctionExpression) Mul(val interface{}) ArithmeticExpression { return mul(sfe, val) }
+func (sfe sqlFunctionExpression) Div(val interface{}) ArithmeticExpression { return div(sfe, val) }
+func (sfe sqlFunctionExpression) Mod(val interface{}) ArithmeticExpression { return mod(sfe, val) }
+func (sfe sqlFunctionExpression) Neg() ArithmeticExpression                { return neg(sfe) }
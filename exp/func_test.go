@@ -34,6 +34,42 @@ func (sfes *sqlFunctionExpressionSuite) TestName() {
 	sfes.Equal("COUNT", sfes.fn.Name())
 }
 
+func (sfes *sqlFunctionExpressionSuite) TestFilter() {
+	a := exp.NewIdentifierExpression("", "", "a").Gt(1)
+	b := exp.NewIdentifierExpression("", "", "b").Lt(2)
+	sfes.False(sfes.fn.HasFilter())
+	sfes.Nil(sfes.fn.FilterConditions())
+
+	fn := sfes.fn.Filter(a)
+	sfes.True(fn.HasFilter())
+	sfes.Equal(exp.NewExpressionList(exp.AndType, a), fn.FilterConditions())
+	sfes.Equal(exp.NewExpressionList(exp.AndType, a, b), fn.Filter(b).FilterConditions())
+	sfes.Equal(fn, fn.Clone())
+	sfes.False(sfes.fn.HasFilter())
+}
+
+func (sfes *sqlFunctionExpressionSuite) TestOrderBy() {
+	sfes.False(sfes.fn.HasOrder())
+	sfes.Nil(sfes.fn.OrderCols())
+
+	fn := sfes.fn.OrderBy("a", "b")
+	sfes.True(fn.HasOrder())
+	sfes.Equal(exp.NewColumnListExpression("a", "b"), fn.OrderCols())
+	sfes.Equal(fn, fn.Clone())
+	sfes.False(sfes.fn.HasOrder())
+}
+
+func (sfes *sqlFunctionExpressionSuite) TestWithinGroup() {
+	sfes.False(sfes.fn.HasWithinGroup())
+	sfes.Nil(sfes.fn.WithinGroupCols())
+
+	fn := sfes.fn.WithinGroup("a")
+	sfes.True(fn.HasWithinGroup())
+	sfes.Equal(exp.NewColumnListExpression("a"), fn.WithinGroupCols())
+	sfes.Equal(fn, fn.Clone())
+	sfes.False(sfes.fn.HasWithinGroup())
+}
+
 func (sfes *sqlFunctionExpressionSuite) TestAllOthers() {
 	fn := sfes.fn
 
@@ -71,8 +107,16 @@ func (sfes *sqlFunctionExpressionSuite) TestAllOthers() {
 		{Ex: fn.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, fn, true)},
 		{Ex: fn.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, fn, false)},
 		{Ex: fn.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, fn, false)},
+		{Ex: fn.IsDistinctFrom(nil), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, fn, nil)},
+		{Ex: fn.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, fn, 1)},
 		{Ex: fn.Desc(), Expected: exp.NewOrderedExpression(fn, exp.DescSortDir, exp.NoNullsSortType)},
 		{Ex: fn.Asc(), Expected: exp.NewOrderedExpression(fn, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: fn.Add(2), Expected: exp.NewArithmeticExpression(exp.AddOp, fn, 2)},
+		{Ex: fn.Sub(2), Expected: exp.NewArithmeticExpression(exp.SubOp, fn, 2)},
+		{Ex: fn.Mul(2), Expected: exp.NewArithmeticExpression(exp.MulOp, fn, 2)},
+		{Ex: fn.Div(2), Expected: exp.NewArithmeticExpression(exp.DivOp, fn, 2)},
+		{Ex: fn.Mod(2), Expected: exp.NewArithmeticExpression(exp.ModOp, fn, 2)},
+		{Ex: fn.Neg(), Expected: exp.NewArithmeticExpression(exp.NegOp, nil, fn)},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,31 @@
+package exp
+
+type grouping struct {
+	groupingType GroupingType
+	sets         []ColumnListExpression
+}
+
+// Creates a new GroupingExpression with the given type and grouping elements
+func NewGroupingExpression(groupingType GroupingType, sets ...ColumnListExpression) GroupingExpression {
+	return grouping{groupingType: groupingType, sets: sets}
+}
+
+func (g grouping) Clone() Expression {
+	var sets []ColumnListExpression
+	for _, set := range g.sets {
+		sets = append(sets, set.Clone().(ColumnListExpression))
+	}
+	return grouping{groupingType: g.groupingType, sets: sets}
+}
+
+func (g grouping) Expression() Expression {
+	return g
+}
+
+func (g grouping) Type() GroupingType {
+	return g.groupingType
+}
+
+func (g grouping) Sets() []ColumnListExpression {
+	return g.sets
+}
@@ -0,0 +1,45 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type groupingExpressionSuite struct {
+	suite.Suite
+}
+
+func TestGroupingExpressionSuite(t *testing.T) {
+	suite.Run(t, new(groupingExpressionSuite))
+}
+
+func (ges *groupingExpressionSuite) TestClone() {
+	g := exp.NewGroupingExpression(exp.RollupGroupingType, exp.NewColumnListExpression("a"))
+	ges.Equal(g, g.Clone())
+
+	g = exp.NewGroupingExpression(exp.GroupingSetsGroupingType)
+	ges.Equal(g, g.Clone())
+}
+
+func (ges *groupingExpressionSuite) TestExpression() {
+	g := exp.NewGroupingExpression(exp.CubeGroupingType, exp.NewColumnListExpression("a"))
+	ges.Equal(g, g.Expression())
+}
+
+func (ges *groupingExpressionSuite) TestType() {
+	ges.Equal(exp.RollupGroupingType, exp.NewGroupingExpression(exp.RollupGroupingType).Type())
+	ges.Equal(exp.CubeGroupingType, exp.NewGroupingExpression(exp.CubeGroupingType).Type())
+	ges.Equal(exp.GroupingSetsGroupingType, exp.NewGroupingExpression(exp.GroupingSetsGroupingType).Type())
+}
+
+func (ges *groupingExpressionSuite) TestSets() {
+	sets := []exp.ColumnListExpression{
+		exp.NewColumnListExpression("a", "b"),
+		exp.NewColumnListExpression("a"),
+		exp.NewColumnListExpression(),
+	}
+	g := exp.NewGroupingExpression(exp.GroupingSetsGroupingType, sets...)
+	ges.Equal(sets, g.Sets())
+}
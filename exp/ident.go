@@ -182,6 +182,24 @@ func (i identifier) BitwiseRightShift(val interface{}) BitwiseExpression {
 	return bitwiseRightShift(i, val)
 }
 
+// Returns an ArithmeticExpression for addition (e.g "my_col" + 1)
+func (i identifier) Add(val interface{}) ArithmeticExpression { return add(i, val) }
+
+// Returns an ArithmeticExpression for subtraction (e.g "my_col" - 1)
+func (i identifier) Sub(val interface{}) ArithmeticExpression { return sub(i, val) }
+
+// Returns an ArithmeticExpression for multiplication (e.g "my_col" * 2)
+func (i identifier) Mul(val interface{}) ArithmeticExpression { return mul(i, val) }
+
+// Returns an ArithmeticExpression for division (e.g "my_col" / 2)
+func (i identifier) Div(val interface{}) ArithmeticExpression { return div(i, val) }
+
+// Returns an ArithmeticExpression for modulo (e.g "my_col" % 2)
+func (i identifier) Mod(val interface{}) ArithmeticExpression { return mod(i, val) }
+
+// Returns an ArithmeticExpression for unary minus (e.g - "my_col")
+func (i identifier) Neg() ArithmeticExpression { return neg(i) }
+
 // Returns a BooleanExpression for checking that a identifier is in a list of values or  (e.g "my_col" > 1)
 func (i identifier) In(vals ...interface{}) BooleanExpression         { return in(i, vals...) }
 func (i identifier) NotIn(vals ...interface{}) BooleanExpression      { return notIn(i, vals...) }
@@ -201,10 +219,17 @@ func (i identifier) IsTrue() BooleanExpression                        { return i
 func (i identifier) IsNotTrue() BooleanExpression                     { return isNot(i, true) }
 func (i identifier) IsFalse() BooleanExpression                       { return is(i, false) }
 func (i identifier) IsNotFalse() BooleanExpression                    { return isNot(i, false) }
-func (i identifier) Asc() OrderedExpression                           { return asc(i) }
-func (i identifier) Desc() OrderedExpression                          { return desc(i) }
-func (i identifier) Distinct() SQLFunctionExpression                  { return NewSQLFunctionExpression("DISTINCT", i) }
-func (i identifier) Cast(t string) CastExpression                     { return NewCastExpression(i, t) }
+func (i identifier) IsDistinctFrom(val interface{}) BooleanExpression { return isDistinctFrom(i, val) }
+func (i identifier) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(i, val)
+}
+func (i identifier) Asc() OrderedExpression          { return asc(i) }
+func (i identifier) Desc() OrderedExpression         { return desc(i) }
+func (i identifier) Distinct() SQLFunctionExpression { return NewSQLFunctionExpression("DISTINCT", i) }
+func (i identifier) Cast(t string) CastExpression    { return NewCastExpression(i, t) }
+
+// Returns a CollateExpression for comparing and ordering using the collation (e.g "my_col" COLLATE "C")
+func (i identifier) Collate(collation string) CollateExpression { return NewCollateExpression(i, collation) }
 
 // Returns a RangeExpression for checking that a identifier is between two values (e.g "my_col" BETWEEN 1 AND 10)
 func (i identifier) Between(val RangeVal) RangeExpression { return between(i, val) }
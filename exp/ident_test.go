@@ -232,6 +232,8 @@ func (ies *identifierExpressionSuite) TestAllOthers() {
 		{Ex: ident.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ident, true)},
 		{Ex: ident.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, ident, false)},
 		{Ex: ident.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ident, false)},
+		{Ex: ident.IsDistinctFrom(nil), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, ident, nil)},
+		{Ex: ident.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, ident, 1)},
 		{Ex: ident.Distinct(), Expected: exp.NewSQLFunctionExpression("DISTINCT", ident)},
 		{Ex: ident.BitwiseInversion(), Expected: exp.NewBitwiseExpression(exp.BitwiseInversionOp, nil, ident)},
 		{Ex: ident.BitwiseOr(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseOrOp, ident, bitwiseVals)},
@@ -239,6 +241,13 @@ func (ies *identifierExpressionSuite) TestAllOthers() {
 		{Ex: ident.BitwiseXor(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseXorOp, ident, bitwiseVals)},
 		{Ex: ident.BitwiseLeftShift(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseLeftShiftOp, ident, bitwiseVals)},
 		{Ex: ident.BitwiseRightShift(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseRightShiftOp, ident, bitwiseVals)},
+		{Ex: ident.Add(2), Expected: exp.NewArithmeticExpression(exp.AddOp, ident, 2)},
+		{Ex: ident.Sub(2), Expected: exp.NewArithmeticExpression(exp.SubOp, ident, 2)},
+		{Ex: ident.Mul(2), Expected: exp.NewArithmeticExpression(exp.MulOp, ident, 2)},
+		{Ex: ident.Div(2), Expected: exp.NewArithmeticExpression(exp.DivOp, ident, 2)},
+		{Ex: ident.Mod(2), Expected: exp.NewArithmeticExpression(exp.ModOp, ident, 2)},
+		{Ex: ident.Neg(), Expected: exp.NewArithmeticExpression(exp.NegOp, nil, ident)},
+		{Ex: ident.Collate("C"), Expected: exp.NewCollateExpression(ident, "C")},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,107 @@
+package exp
+
+type jsonExpression struct {
+	op  JSONOperation
+	lhs Expression
+	rhs interface{}
+}
+
+// Creates a new JSON expression, see JSONExpression for the value of rhs for each operation
+//
+//	NewJSONExpression(JSONExtractTextOp, I("data"), []interface{}{"a", "b"}) -> ("data"->'a'->>'b')
+func NewJSONExpression(op JSONOperation, lhs Expression, rhs interface{}) JSONExpression {
+	return jsonExpression{op: op, lhs: lhs, rhs: rhs}
+}
+
+func (j jsonExpression) Clone() Expression {
+	rhs := j.rhs
+	switch t := j.rhs.(type) {
+	case Expression:
+		rhs = t.Clone()
+	case []interface{}:
+		rhs = append(make([]interface{}, 0, len(t)), t...)
+	}
+	return NewJSONExpression(j.op, j.lhs.Clone(), rhs)
+}
+
+func (j jsonExpression) Op() JSONOperation { return j.op }
+func (j jsonExpression) LHS() Expression   { return j.lhs }
+func (j jsonExpression) RHS() interface{}  { return j.rhs }
+
+func (j jsonExpression) Get(path ...interface{}) JSONExpression {
+	return j.extract(JSONExtractOp, path)
+}
+func (j jsonExpression) GetText(path ...interface{}) JSONExpression {
+	return j.extract(JSONExtractTextOp, path)
+}
+func (j jsonExpression) Contains(candidate interface{}) JSONExpression {
+	return NewJSONExpression(JSONContainsOp, j, candidate)
+}
+func (j jsonExpression) HasKey(key string) JSONExpression {
+	return NewJSONExpression(JSONHasKeyOp, j, key)
+}
+func (j jsonExpression) ArrayLength() JSONExpression {
+	return NewJSONExpression(JSONArrayLengthOp, j, nil)
+}
+
+// extends the path when extracting from an extracted JSON value so the path is rendered as a single expression
+func (j jsonExpression) extract(op JSONOperation, path []interface{}) JSONExpression {
+	if j.op == JSONExtractOp {
+		current, _ := j.rhs.([]interface{})
+		fullPath := make([]interface{}, 0, len(current)+len(path))
+		fullPath = append(append(fullPath, current...), path...)
+		return NewJSONExpression(op, j.lhs, fullPath)
+	}
+	return NewJSONExpression(op, j, path)
+}
+
+func (j jsonExpression) Expression() Expression                       { return j }
+func (j jsonExpression) As(val interface{}) AliasedExpression         { return NewAliasExpression(j, val) }
+func (j jsonExpression) Eq(val interface{}) BooleanExpression         { return eq(j, val) }
+func (j jsonExpression) Neq(val interface{}) BooleanExpression        { return neq(j, val) }
+func (j jsonExpression) Gt(val interface{}) BooleanExpression         { return gt(j, val) }
+func (j jsonExpression) Gte(val interface{}) BooleanExpression        { return gte(j, val) }
+func (j jsonExpression) Lt(val interface{}) BooleanExpression         { return lt(j, val) }
+func (j jsonExpression) Lte(val interface{}) BooleanExpression        { return lte(j, val) }
+func (j jsonExpression) Asc() OrderedExpression                       { return asc(j) }
+func (j jsonExpression) Desc() OrderedExpression                      { return desc(j) }
+func (j jsonExpression) Like(i interface{}) BooleanExpression         { return like(j, i) }
+func (j jsonExpression) NotLike(i interface{}) BooleanExpression      { return notLike(j, i) }
+func (j jsonExpression) ILike(i interface{}) BooleanExpression        { return iLike(j, i) }
+func (j jsonExpression) NotILike(i interface{}) BooleanExpression     { return notILike(j, i) }
+func (j jsonExpression) RegexpLike(val interface{}) BooleanExpression { return regexpLike(j, val) }
+func (j jsonExpression) RegexpNotLike(val interface{}) BooleanExpression {
+	return regexpNotLike(j, val)
+}
+func (j jsonExpression) RegexpILike(val interface{}) BooleanExpression { return regexpILike(j, val) }
+func (j jsonExpression) RegexpNotILike(val interface{}) BooleanExpression {
+	return regexpNotILike(j, val)
+}
+func (j jsonExpression) In(i ...interface{}) BooleanExpression    { return in(j, i...) }
+func (j jsonExpression) NotIn(i ...interface{}) BooleanExpression { return notIn(j, i...) }
+func (j jsonExpression) Is(i interface{}) BooleanExpression       { return is(j, i) }
+func (j jsonExpression) IsNot(i interface{}) BooleanExpression    { return isNot(j, i) }
+func (j jsonExpression) IsNull() BooleanExpression                { return is(j, nil) }
+func (j jsonExpression) IsNotNull() BooleanExpression             { return isNot(j, nil) }
+func (j jsonExpression) IsTrue() BooleanExpression                { return is(j, true) }
+func (j jsonExpression) IsNotTrue() BooleanExpression             { return isNot(j, true) }
+func (j jsonExpression) IsFalse() BooleanExpression               { return is(j, false) }
+func (j jsonExpression) IsNotFalse() BooleanExpression            { return isNot(j, false) }
+func (j jsonExpression) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(j, val)
+}
+func (j jsonExpression) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(j, val)
+}
+func (j jsonExpression) Distinct() SQLFunctionExpression {
+	return NewSQLFunctionExpression("DISTINCT", j)
+}
+func (j jsonExpression) Cast(t string) CastExpression             { return NewCastExpression(j, t) }
+func (j jsonExpression) Between(val RangeVal) RangeExpression     { return between(j, val) }
+func (j jsonExpression) NotBetween(val RangeVal) RangeExpression  { return notBetween(j, val) }
+func (j jsonExpression) Add(val interface{}) ArithmeticExpression { return add(j, val) }
+func (j jsonExpression) Sub(val interface{}) ArithmeticExpression { return sub(j, val) }
+func (j jsonExpression) Mul(val interface{}) ArithmeticExpression { return mul(j, val) }
+func (j jsonExpression) Div(val interface{}) ArithmeticExpression { return div(j, val) }
+func (j jsonExpression) Mod(val interface{}) ArithmeticExpression { return mod(j, val) }
+func (j jsonExpression) Neg() ArithmeticExpression                { return neg(j) }
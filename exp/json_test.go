@@ -0,0 +1,149 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type jsonExpressionSuite struct {
+	suite.Suite
+}
+
+func TestJSONExpressionSuite(t *testing.T) {
+	suite.Run(t, &jsonExpressionSuite{})
+}
+
+func (jes *jsonExpressionSuite) TestClone() {
+	je := exp.NewJSONExpression(exp.JSONExtractOp, exp.NewIdentifierExpression("", "", "data"), []interface{}{"a", 0})
+	jes.Equal(je, je.Clone())
+
+	je = exp.NewJSONExpression(exp.JSONContainsOp, exp.NewIdentifierExpression("", "", "data"), `{"a":1}`)
+	jes.Equal(je, je.Clone())
+
+	je = exp.NewJSONExpression(exp.JSONArrayLengthOp, exp.NewIdentifierExpression("", "", "data"), nil)
+	jes.Equal(je, je.Clone())
+}
+
+func (jes *jsonExpressionSuite) TestExpression() {
+	je := exp.NewJSONExpression(exp.JSONExtractOp, exp.NewIdentifierExpression("", "", "data"), []interface{}{"a"})
+	jes.Equal(je, je.Expression())
+}
+
+func (jes *jsonExpressionSuite) TestOp() {
+	ident := exp.NewIdentifierExpression("", "", "data")
+	jes.Equal(exp.JSONExtractOp, exp.NewJSONExpression(exp.JSONExtractOp, ident, []interface{}{"a"}).Op())
+	jes.Equal(exp.JSONHasKeyOp, exp.NewJSONExpression(exp.JSONHasKeyOp, ident, "a").Op())
+}
+
+func (jes *jsonExpressionSuite) TestLHS() {
+	ident := exp.NewIdentifierExpression("", "", "data")
+	jes.Equal(ident, exp.NewJSONExpression(exp.JSONExtractOp, ident, []interface{}{"a"}).LHS())
+}
+
+func (jes *jsonExpressionSuite) TestRHS() {
+	ident := exp.NewIdentifierExpression("", "", "data")
+	jes.Equal([]interface{}{"a", 1}, exp.NewJSONExpression(exp.JSONExtractOp, ident, []interface{}{"a", 1}).RHS())
+	jes.Equal("a", exp.NewJSONExpression(exp.JSONHasKeyOp, ident, "a").RHS())
+	jes.Nil(exp.NewJSONExpression(exp.JSONArrayLengthOp, ident, nil).RHS())
+}
+
+func (jes *jsonExpressionSuite) TestGet() {
+	ident := exp.NewIdentifierExpression("", "", "data")
+	je := exp.NewJSONExpression(exp.JSONExtractOp, ident, []interface{}{"a"})
+	jes.Equal(exp.NewJSONExpression(exp.JSONExtractOp, ident, []interface{}{"a", "b", 0}), je.Get("b", 0))
+	// the original path is not modified
+	jes.Equal([]interface{}{"a"}, je.RHS())
+
+	te := exp.NewJSONExpression(exp.JSONExtractTextOp, ident, []interface{}{"a"})
+	jes.Equal(exp.NewJSONExpression(exp.JSONExtractOp, te, []interface{}{"b"}), te.Get("b"))
+}
+
+func (jes *jsonExpressionSuite) TestGetText() {
+	ident := exp.NewIdentifierExpression("", "", "data")
+	je := exp.NewJSONExpression(exp.JSONExtractOp, ident, []interface{}{"a"})
+	jes.Equal(exp.NewJSONExpression(exp.JSONExtractTextOp, ident, []interface{}{"a", "b"}), je.GetText("b"))
+
+	he := exp.NewJSONExpression(exp.JSONHasKeyOp, ident, "a")
+	jes.Equal(exp.NewJSONExpression(exp.JSONExtractTextOp, he, []interface{}{"b"}), he.GetText("b"))
+}
+
+func (jes *jsonExpressionSuite) TestContains() {
+	je := exp.NewJSONExpression(exp.JSONExtractOp, exp.NewIdentifierExpression("", "", "data"), []interface{}{"a"})
+	jes.Equal(exp.NewJSONExpression(exp.JSONContainsOp, je, `{"b":1}`), je.Contains(`{"b":1}`))
+}
+
+func (jes *jsonExpressionSuite) TestHasKey() {
+	je := exp.NewJSONExpression(exp.JSONExtractOp, exp.NewIdentifierExpression("", "", "data"), []interface{}{"a"})
+	jes.Equal(exp.NewJSONExpression(exp.JSONHasKeyOp, je, "b"), je.HasKey("b"))
+}
+
+func (jes *jsonExpressionSuite) TestArrayLength() {
+	je := exp.NewJSONExpression(exp.JSONExtractOp, exp.NewIdentifierExpression("", "", "data"), []interface{}{"a"})
+	jes.Equal(exp.NewJSONExpression(exp.JSONArrayLengthOp, je, nil), je.ArrayLength())
+}
+
+func (jes *jsonExpressionSuite) TestAllOthers() {
+	je := exp.NewJSONExpression(exp.JSONExtractTextOp, exp.NewIdentifierExpression("", "", "data"), []interface{}{"a"})
+	rv := exp.NewRangeVal(1, 2)
+	pattern := "a%"
+	inVals := []interface{}{1, 2}
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: je.As("a"), Expected: exp.NewAliasExpression(je, "a")},
+		{Ex: je.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, je, 1)},
+		{Ex: je.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, je, 1)},
+		{Ex: je.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, je, 1)},
+		{Ex: je.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, je, 1)},
+		{Ex: je.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, je, 1)},
+		{Ex: je.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, je, 1)},
+		{Ex: je.Asc(), Expected: exp.NewOrderedExpression(je, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: je.Desc(), Expected: exp.NewOrderedExpression(je, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: je.Between(rv), Expected: exp.NewRangeExpression(exp.BetweenOp, je, rv)},
+		{Ex: je.NotBetween(rv), Expected: exp.NewRangeExpression(exp.NotBetweenOp, je, rv)},
+		{Ex: je.Like(pattern), Expected: exp.NewBooleanExpression(exp.LikeOp, je, pattern)},
+		{Ex: je.NotLike(pattern), Expected: exp.NewBooleanExpression(exp.NotLikeOp, je, pattern)},
+		{Ex: je.ILike(pattern), Expected: exp.NewBooleanExpression(exp.ILikeOp, je, pattern)},
+		{Ex: je.NotILike(pattern), Expected: exp.NewBooleanExpression(exp.NotILikeOp, je, pattern)},
+		{Ex: je.RegexpLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpLikeOp, je, pattern)},
+		{Ex: je.RegexpNotLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotLikeOp, je, pattern)},
+		{Ex: je.RegexpILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpILikeOp, je, pattern)},
+		{Ex: je.RegexpNotILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotILikeOp, je, pattern)},
+		{Ex: je.In(inVals), Expected: exp.NewBooleanExpression(exp.InOp, je, inVals)},
+		{Ex: je.NotIn(inVals), Expected: exp.NewBooleanExpression(exp.NotInOp, je, inVals)},
+		{Ex: je.Is(true), Expected: exp.NewBooleanExpression(exp.IsOp, je, true)},
+		{Ex: je.IsNot(true), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, true)},
+		{Ex: je.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, je, nil)},
+		{Ex: je.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, nil)},
+		{Ex: je.IsTrue(), Expected: exp.NewBooleanExpression(exp.IsOp, je, true)},
+		{Ex: je.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, true)},
+		{Ex: je.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, je, false)},
+		{Ex: je.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, false)},
+		{Ex: je.IsDistinctFrom(nil), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, je, nil)},
+		{Ex: je.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, je, 1)},
+		{Ex: je.Distinct(), Expected: exp.NewSQLFunctionExpression("DISTINCT", je)},
+		{Ex: je.Cast("INT"), Expected: exp.NewCastExpression(je, "INT")},
+		{Ex: je.Add(1), Expected: exp.NewArithmeticExpression(exp.AddOp, je, 1)},
+		{Ex: je.Sub(1), Expected: exp.NewArithmeticExpression(exp.SubOp, je, 1)},
+		{Ex: je.Mul(1), Expected: exp.NewArithmeticExpression(exp.MulOp, je, 1)},
+		{Ex: je.Div(1), Expected: exp.NewArithmeticExpression(exp.DivOp, je, 1)},
+		{Ex: je.Mod(1), Expected: exp.NewArithmeticExpression(exp.ModOp, je, 1)},
+		{Ex: je.Neg(), Expected: exp.NewArithmeticExpression(exp.NegOp, nil, je)},
+	}
+
+	for _, tc := range testCases {
+		jes.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+func (jes *jsonExpressionSuite) TestJSONOperation_String() {
+	jes.Equal("extract", exp.JSONExtractOp.String())
+	jes.Equal("extract text", exp.JSONExtractTextOp.String())
+	jes.Equal("contains", exp.JSONContainsOp.String())
+	jes.Equal("has key", exp.JSONHasKeyOp.String())
+	jes.Equal("array length", exp.JSONArrayLengthOp.String())
+	jes.Equal("-1", exp.JSONOperation(-1).String())
+}
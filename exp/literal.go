@@ -69,6 +69,10 @@ func (l literal) IsTrue() BooleanExpression                        { return is(l
 func (l literal) IsNotTrue() BooleanExpression                     { return isNot(l, true) }
 func (l literal) IsFalse() BooleanExpression                       { return is(l, false) }
 func (l literal) IsNotFalse() BooleanExpression                    { return isNot(l, false) }
+func (l literal) IsDistinctFrom(val interface{}) BooleanExpression { return isDistinctFrom(l, val) }
+func (l literal) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(l, val)
+}
 
 func (l literal) BitwiseInversion() BitwiseExpression                { return bitwiseInversion(l) }
 func (l literal) BitwiseOr(val interface{}) BitwiseExpression        { return bitwiseOr(l, val) }
@@ -78,3 +82,10 @@ func (l literal) BitwiseLeftShift(val interface{}) BitwiseExpression { return bi
 func (l literal) BitwiseRightShift(val interface{}) BitwiseExpression {
 	return bitwiseRightShift(l, val)
 }
+
+func (l literal) Add(val interface{}) ArithmeticExpression { return add(l, val) }
+func (l literal) Sub(val interface{}) ArithmeticExpression { return sub(l, val) }
+func (l literal) Mul(val interface{}) ArithmeticExpression { return mul(l, val) }
+func (l literal) Div(val interface{}) ArithmeticExpression { return div(l, val) }
+func (l literal) Mod(val interface{}) ArithmeticExpression { return mod(l, val) }
+func (l literal) Neg() ArithmeticExpression                { return neg(l) }
@@ -73,12 +73,20 @@ func (les *literalExpressionSuite) TestAllOthers() {
 		{Ex: le.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, le, true)},
 		{Ex: le.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, le, false)},
 		{Ex: le.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, le, false)},
+		{Ex: le.IsDistinctFrom(nil), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, le, nil)},
+		{Ex: le.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, le, 1)},
 		{Ex: le.BitwiseInversion(), Expected: exp.NewBitwiseExpression(exp.BitwiseInversionOp, nil, le)},
 		{Ex: le.BitwiseOr(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseOrOp, le, bitwiseVals)},
 		{Ex: le.BitwiseAnd(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseAndOp, le, bitwiseVals)},
 		{Ex: le.BitwiseXor(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseXorOp, le, bitwiseVals)},
 		{Ex: le.BitwiseLeftShift(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseLeftShiftOp, le, bitwiseVals)},
 		{Ex: le.BitwiseRightShift(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseRightShiftOp, le, bitwiseVals)},
+		{Ex: le.Add(2), Expected: exp.NewArithmeticExpression(exp.AddOp, le, 2)},
+		{Ex: le.Sub(2), Expected: exp.NewArithmeticExpression(exp.SubOp, le, 2)},
+		{Ex: le.Mul(2), Expected: exp.NewArithmeticExpression(exp.MulOp, le, 2)},
+		{Ex: le.Div(2), Expected: exp.NewArithmeticExpression(exp.DivOp, le, 2)},
+		{Ex: le.Mod(2), Expected: exp.NewArithmeticExpression(exp.ModOp, le, 2)},
+		{Ex: le.Neg(), Expected: exp.NewArithmeticExpression(exp.NegOp, nil, le)},
 	}
 
 	for _, tc := range testCases {
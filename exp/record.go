@@ -7,8 +7,20 @@ import (
 	"github.com/orn-id/depiq/internal/util"
 )
 
-// Alternative to writing map[string]interface{}. Can be used for Inserts, Updates or Deletes
-type Record map[string]interface{}
+type (
+	// Alternative to writing map[string]interface{}. Can be used for Inserts, Updates or Deletes
+	Record map[string]interface{}
+
+	// A Record that keeps the order of its columns, e.g. the columns of a row in the order of the SELECT. See
+	// exec.MapScanner#ScanOrderedMap
+	OrderedRecord []RecordColumn
+
+	// A column of an OrderedRecord
+	RecordColumn struct {
+		Name  string
+		Value interface{}
+	}
+)
 
 func (r Record) Cols() []string {
 	cols := make([]string, 0, len(r))
@@ -19,6 +31,43 @@ func (r Record) Cols() []string {
 	return cols
 }
 
+// Returns the names of the columns in order
+func (r OrderedRecord) Cols() []string {
+	cols := make([]string, 0, len(r))
+	for _, c := range r {
+		cols = append(cols, c.Name)
+	}
+	return cols
+}
+
+// Returns the values of the columns in order
+func (r OrderedRecord) Values() []interface{} {
+	vals := make([]interface{}, 0, len(r))
+	for _, c := range r {
+		vals = append(vals, c.Value)
+	}
+	return vals
+}
+
+// Returns the value of the first column with the name, false if there is no column with the name
+func (r OrderedRecord) Get(col string) (interface{}, bool) {
+	for _, c := range r {
+		if c.Name == col {
+			return c.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Converts the OrderedRecord into a Record, the value of the last column is used for duplicate column names
+func (r OrderedRecord) Record() Record {
+	rec := make(Record, len(r))
+	for _, c := range r {
+		rec[c.Name] = c.Value
+	}
+	return rec
+}
+
 func NewRecordFromStruct(i interface{}, forInsert, forUpdate bool) (r Record, err error) {
 	value := reflect.ValueOf(i)
 	if value.IsValid() {
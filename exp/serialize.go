@@ -0,0 +1,1275 @@
+package exp
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/orn-id/depiq/internal/errors"
+)
+
+// The version of the JSON representation written by MarshalSelectClausesJSON and MarshalExpressionJSON. Documents
+// with a different version are rejected when decoding.
+const JSONVersion = 1
+
+type (
+	// Creates the subquery for SelectClauses decoded from JSON (e.g. depiq.SelectDataset)
+	SelectFactory func(clauses SelectClauses) AppendableExpression
+
+	jsonDocument struct {
+		Version    int                `json:"version"`
+		Select     *jsonSelectClauses `json:"select,omitempty"`
+		Expression *jsonNode          `json:"expression,omitempty"`
+	}
+	jsonSelectClauses struct {
+		With      []*jsonNode `json:"with,omitempty"`
+		Select    *jsonNode   `json:"select,omitempty"`
+		Distinct  *jsonNode   `json:"distinct,omitempty"`
+		From      *jsonNode   `json:"from,omitempty"`
+		Joins     []*jsonNode `json:"joins,omitempty"`
+		Where     *jsonNode   `json:"where,omitempty"`
+		Alias     *jsonNode   `json:"alias,omitempty"`
+		GroupBy   *jsonNode   `json:"group_by,omitempty"`
+		Having    *jsonNode   `json:"having,omitempty"`
+		Windows   []*jsonNode `json:"windows,omitempty"`
+		Compounds []*jsonNode `json:"compounds,omitempty"`
+		Order     *jsonNode   `json:"order,omitempty"`
+		Limit     *jsonValue  `json:"limit,omitempty"`
+		Offset    uint        `json:"offset,omitempty"`
+		Lock      *jsonLock   `json:"lock,omitempty"`
+	}
+	jsonLock struct {
+		Strength string      `json:"strength,omitempty"`
+		Wait     string      `json:"wait,omitempty"`
+		Of       []*jsonNode `json:"of,omitempty"`
+	}
+	// a single expression, the fields used depend on the type
+	jsonNode struct {
+		Type        string             `json:"type"`
+		Op          string             `json:"op,omitempty"`
+		Schema      string             `json:"schema,omitempty"`
+		Table       string             `json:"table,omitempty"`
+		Col         *jsonValue         `json:"col,omitempty"`
+		SQL         string             `json:"sql,omitempty"`
+		Name        string             `json:"name,omitempty"`
+		Recursive   bool               `json:"recursive,omitempty"`
+		Expression  *jsonNode          `json:"expression,omitempty"`
+		Alias       *jsonNode          `json:"alias,omitempty"`
+		LHS         *jsonNode          `json:"lhs,omitempty"`
+		RHS         *jsonValue         `json:"rhs,omitempty"`
+		Value       *jsonValue         `json:"value,omitempty"`
+		Args        []*jsonValue       `json:"args,omitempty"`
+		Expressions []*jsonNode        `json:"expressions,omitempty"`
+		Whens       []*jsonCaseWhen    `json:"whens,omitempty"`
+		Else        *jsonValue         `json:"else,omitempty"`
+		Filter      *jsonNode          `json:"filter,omitempty"`
+		Order       *jsonNode          `json:"order,omitempty"`
+		WithinGroup *jsonNode          `json:"within_group,omitempty"`
+		Direction   string             `json:"direction,omitempty"`
+		Nulls       string             `json:"nulls,omitempty"`
+		Table2      *jsonNode          `json:"join_table,omitempty"`
+		On          *jsonNode          `json:"on,omitempty"`
+		Using       *jsonNode          `json:"using,omitempty"`
+		Window      *jsonNode          `json:"window,omitempty"`
+		WindowName  *jsonNode          `json:"window_name,omitempty"`
+		Parent      *jsonNode          `json:"parent,omitempty"`
+		Partition   *jsonNode          `json:"partition,omitempty"`
+		Frame       *jsonNode          `json:"frame,omitempty"`
+		Unit        string             `json:"unit,omitempty"`
+		Start       *jsonNode          `json:"start,omitempty"`
+		End         *jsonNode          `json:"end,omitempty"`
+		Exclusion   string             `json:"exclusion,omitempty"`
+		Mode        string             `json:"mode,omitempty"`
+		Amount      int64              `json:"amount,omitempty"`
+		Interval    *jsonNode          `json:"interval,omitempty"`
+		Columns     *jsonNode          `json:"columns,omitempty"`
+		Rows        [][]*jsonValue     `json:"rows,omitempty"`
+		Where       *jsonNode          `json:"where,omitempty"`
+		Clauses     *jsonSelectClauses `json:"clauses,omitempty"`
+		Statement   interface{}        `json:"statement,omitempty"`
+	}
+	jsonCaseWhen struct {
+		Condition *jsonValue `json:"condition"`
+		Result    *jsonValue `json:"result"`
+	}
+	// a value used in an expression, scalars keep their kind so they are decoded to the same go type
+	jsonValue struct {
+		Kind       string                `json:"kind"`
+		Value      json.RawMessage       `json:"value,omitempty"`
+		Elem       string                `json:"elem,omitempty"`
+		Items      []*jsonValue          `json:"items,omitempty"`
+		Fields     map[string]*jsonValue `json:"fields,omitempty"`
+		Start      *jsonValue            `json:"start,omitempty"`
+		End        *jsonValue            `json:"end,omitempty"`
+		Expression *jsonNode             `json:"expression,omitempty"`
+	}
+	// the names used for an enum in the JSON representation
+	jsonEnum map[int]string
+
+	jsonEncoder struct {
+		// encode insert, update, delete and truncate clauses, used by Equal and Fingerprint as they cannot be decoded
+		statements bool
+		// encode the kind of values without the value, used by Fingerprint
+		omitValues bool
+		err        error
+	}
+	jsonDecoder struct {
+		newSelect SelectFactory
+		err       error
+	}
+)
+
+const (
+	// node types
+	identifierJSONType       = "identifier"
+	literalJSONType          = "literal"
+	aliasJSONType            = "alias"
+	booleanJSONType          = "boolean"
+	arithmeticJSONType       = "arithmetic"
+	bitwiseJSONType          = "bitwise"
+	jsonJSONType             = "json"
+	rangeJSONType            = "range"
+	arrayJSONType            = "array"
+	caseJSONType             = "case"
+	castJSONType             = "cast"
+	columnListJSONType       = "column_list"
+	expressionListJSONType   = "expression_list"
+	compoundJSONType         = "compound"
+	cteJSONType              = "cte"
+	doNothingJSONType        = "do_nothing"
+	doUpdateJSONType         = "do_update"
+	existsJSONType           = "exists"
+	quantifiedJSONType       = "quantified"
+	functionJSONType         = "function"
+	groupingJSONType         = "grouping"
+	insertJSONType           = "insert"
+	joinJSONType             = "join"
+	lateralJSONType          = "lateral"
+	orderedJSONType          = "ordered"
+	tupleJSONType            = "tuple"
+	windowJSONType           = "window"
+	windowFrameJSONType      = "window_frame"
+	windowFrameBoundJSONType = "window_frame_bound"
+	windowFunctionJSONType   = "window_function"
+	textSearchJSONType       = "text_search"
+	stringJSONType           = "string"
+	collateJSONType          = "collate"
+	intervalJSONType         = "interval"
+	dateArithmeticJSONType   = "date_arithmetic"
+	extractJSONType          = "extract"
+	dateTruncJSONType        = "date_trunc"
+	currentTimeJSONType      = "current_time"
+	selectJSONType           = "select"
+	statementJSONType        = "statement"
+
+	// value kinds that are not a reflect.Kind
+	nullJSONKind       = "null"
+	bytesJSONKind      = "bytes"
+	timeJSONKind       = "time"
+	recordJSONKind     = "record"
+	rangeJSONKind      = "range"
+	expressionJSONKind = "expression"
+)
+
+var (
+	booleanOperationJSONNames = jsonEnum{
+		int(EqOp): "eq", int(NeqOp): "neq", int(IsOp): "is", int(IsNotOp): "is_not", int(GtOp): "gt", int(GteOp): "gte",
+		int(LtOp): "lt", int(LteOp): "lte", int(InOp): "in", int(NotInOp): "not_in", int(LikeOp): "like",
+		int(NotLikeOp): "not_like", int(ILikeOp): "ilike", int(NotILikeOp): "not_ilike", int(RegexpLikeOp): "regexp_like",
+		int(RegexpNotLikeOp): "regexp_not_like", int(RegexpILikeOp): "regexp_ilike",
+		int(RegexpNotILikeOp): "regexp_not_ilike", int(IsDistinctFromOp): "is_distinct_from",
+		int(IsNotDistinctFromOp): "is_not_distinct_from", int(ArrayContainsOp): "array_contains",
+		int(ArrayContainedByOp): "array_contained_by", int(ArrayOverlapsOp): "array_overlaps",
+	}
+	bitwiseOperationJSONNames = jsonEnum{
+		int(BitwiseInversionOp): "inversion", int(BitwiseOrOp): "or", int(BitwiseAndOp): "and",
+		int(BitwiseXorOp): "xor", int(BitwiseLeftShiftOp): "left_shift", int(BitwiseRightShiftOp): "right_shift",
+	}
+	arithmeticOperationJSONNames = jsonEnum{
+		int(AddOp): "add", int(SubOp): "sub", int(MulOp): "mul", int(DivOp): "div", int(ModOp): "mod", int(NegOp): "neg",
+	}
+	jsonOperationJSONNames = jsonEnum{
+		int(JSONExtractOp): "extract", int(JSONExtractTextOp): "extract_text", int(JSONContainsOp): "contains",
+		int(JSONHasKeyOp): "has_key", int(JSONArrayLengthOp): "array_length",
+	}
+	rangeOperationJSONNames     = jsonEnum{int(BetweenOp): "between", int(NotBetweenOp): "not_between"}
+	expressionListTypeJSONNames = jsonEnum{int(AndType): "and", int(OrType): "or"}
+	compoundTypeJSONNames       = jsonEnum{
+		int(UnionCompoundType): "union", int(UnionAllCompoundType): "union_all",
+		int(IntersectCompoundType): "intersect", int(IntersectAllCompoundType): "intersect_all",
+		int(ExceptCompoundType): "except", int(ExceptAllCompoundType): "except_all",
+	}
+	joinTypeJSONNames = jsonEnum{
+		int(InnerJoinType): "inner", int(FullOuterJoinType): "full_outer", int(RightOuterJoinType): "right_outer",
+		int(LeftOuterJoinType): "left_outer", int(FullJoinType): "full", int(RightJoinType): "right",
+		int(LeftJoinType): "left", int(NaturalJoinType): "natural", int(NaturalLeftJoinType): "natural_left",
+		int(NaturalRightJoinType): "natural_right", int(NaturalFullJoinType): "natural_full",
+		int(CrossJoinType): "cross",
+	}
+	sortDirectionJSONNames = jsonEnum{int(AscDir): "asc", int(DescSortDir): "desc"}
+	nullSortTypeJSONNames  = jsonEnum{
+		int(NoNullsSortType): "", int(NullsFirstSortType): "first", int(NullsLastSortType): "last",
+	}
+	existsTypeJSONNames   = jsonEnum{int(ExistsSubqueryType): "exists", int(NotExistsSubqueryType): "not_exists"}
+	quantifierJSONNames   = jsonEnum{int(AnyQuantifierType): "any", int(AllQuantifierType): "all"}
+	groupingTypeJSONNames = jsonEnum{
+		int(RollupGroupingType): "rollup", int(CubeGroupingType): "cube", int(GroupingSetsGroupingType): "grouping_sets",
+	}
+	windowFrameUnitJSONNames = jsonEnum{
+		int(RowsFrameUnit): "rows", int(RangeFrameUnit): "range", int(GroupsFrameUnit): "groups",
+	}
+	windowFrameBoundJSONNames = jsonEnum{
+		int(UnboundedPrecedingFrameBound): "unbounded_preceding", int(PrecedingFrameBound): "preceding",
+		int(CurrentRowFrameBound): "current_row", int(FollowingFrameBound): "following",
+		int(UnboundedFollowingFrameBound): "unbounded_following",
+	}
+	windowFrameExclusionJSONNames = jsonEnum{
+		int(NoFrameExclusion): "", int(CurrentRowFrameExclusion): "current_row", int(GroupFrameExclusion): "group",
+		int(TiesFrameExclusion): "ties",
+	}
+	textSearchTypeJSONNames = jsonEnum{int(TextSearchMatchType): "match", int(TextSearchRankType): "rank"}
+	textSearchModeJSONNames = jsonEnum{
+		int(NaturalLanguageTextSearchMode): "natural_language", int(BooleanTextSearchMode): "boolean",
+	}
+	dateTimeUnitJSONNames = jsonEnum{
+		int(MicrosecondUnit): "microsecond", int(MillisecondUnit): "millisecond", int(SecondUnit): "second",
+		int(MinuteUnit): "minute", int(HourUnit): "hour", int(DayUnit): "day", int(WeekUnit): "week",
+		int(MonthUnit): "month", int(QuarterUnit): "quarter", int(YearUnit): "year", int(DayOfWeekUnit): "day_of_week",
+		int(DayOfYearUnit): "day_of_year", int(EpochUnit): "epoch",
+	}
+	dateArithmeticOperationJSONNames = jsonEnum{int(DateAddOp): "add", int(DateSubOp): "sub"}
+	currentTimeTypeJSONNames         = jsonEnum{
+		int(NowCurrentTimeType): "now", int(TimestampCurrentTimeType): "current_timestamp",
+		int(DateCurrentTimeType): "current_date", int(TimeCurrentTimeType): "current_time",
+	}
+	stringOperationJSONNames = jsonEnum{
+		int(ConcatOp): "concat", int(LowerOp): "lower", int(UpperOp): "upper", int(TrimOp): "trim",
+		int(SubstringOp): "substring", int(LengthOp): "length", int(PositionOp): "position", int(ReplaceOp): "replace",
+	}
+	lockStrengthJSONNames = jsonEnum{
+		int(ForNolock): "", int(ForUpdate): "update", int(ForNoKeyUpdate): "no_key_update", int(ForShare): "share",
+		int(ForKeyShare): "key_share",
+	}
+	waitOptionJSONNames = jsonEnum{int(Wait): "", int(NoWait): "nowait", int(SkipLocked): "skip_locked"}
+
+	// the scalar kinds that can be stored in a jsonValue
+	jsonScalarKinds = map[string]reflect.Type{
+		reflect.Bool.String():    reflect.TypeOf(false),
+		reflect.Int.String():     reflect.TypeOf(int(0)),
+		reflect.Int8.String():    reflect.TypeOf(int8(0)),
+		reflect.Int16.String():   reflect.TypeOf(int16(0)),
+		reflect.Int32.String():   reflect.TypeOf(int32(0)),
+		reflect.Int64.String():   reflect.TypeOf(int64(0)),
+		reflect.Uint.String():    reflect.TypeOf(uint(0)),
+		reflect.Uint8.String():   reflect.TypeOf(uint8(0)),
+		reflect.Uint16.String():  reflect.TypeOf(uint16(0)),
+		reflect.Uint32.String():  reflect.TypeOf(uint32(0)),
+		reflect.Uint64.String():  reflect.TypeOf(uint64(0)),
+		reflect.Float32.String(): reflect.TypeOf(float32(0)),
+		reflect.Float64.String(): reflect.TypeOf(float64(0)),
+		reflect.String.String():  reflect.TypeOf(""),
+		bytesJSONKind:            reflect.TypeOf([]byte{}),
+		timeJSONKind:             reflect.TypeOf(time.Time{}),
+	}
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// Encodes SelectClauses, including all subqueries, into a stable and versioned JSON representation that can be decoded
+// with UnmarshalSelectClausesJSON.
+//
+// Only subqueries built from SelectClauses (see SelectClausesExpression) and values of a basic kind, time.Time,
+// []byte, slices and Records can be encoded. Values of a named type are decoded as their underlying kind.
+func MarshalSelectClausesJSON(c SelectClauses) ([]byte, error) {
+	enc := &jsonEncoder{}
+	doc := jsonDocument{Version: JSONVersion, Select: enc.selectClauses(c)}
+	if enc.err != nil {
+		return nil, enc.err
+	}
+	return json.Marshal(doc)
+}
+
+// Decodes and validates SelectClauses encoded with MarshalSelectClausesJSON. The SelectFactory is used to create the
+// subqueries of the clauses, if it is nil the clauses cannot contain subqueries.
+func UnmarshalSelectClausesJSON(data []byte, newSelect SelectFactory) (SelectClauses, error) {
+	dec := &jsonDecoder{newSelect: newSelect}
+	doc := dec.document(data)
+	if dec.err == nil && doc.Select == nil {
+		dec.fail("$", "missing select clauses")
+	}
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	c := dec.selectClauses("$.select", doc.Select)
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	return c, nil
+}
+
+// Encodes an Expression into a stable and versioned JSON representation that can be decoded with
+// UnmarshalExpressionJSON. See MarshalSelectClausesJSON
+func MarshalExpressionJSON(e Expression) ([]byte, error) {
+	enc := &jsonEncoder{}
+	doc := jsonDocument{Version: JSONVersion, Expression: enc.node(e)}
+	if enc.err != nil {
+		return nil, enc.err
+	}
+	return json.Marshal(doc)
+}
+
+// Decodes and validates an Expression encoded with MarshalExpressionJSON. See UnmarshalSelectClausesJSON
+func UnmarshalExpressionJSON(data []byte, newSelect SelectFactory) (Expression, error) {
+	dec := &jsonDecoder{newSelect: newSelect}
+	doc := dec.document(data)
+	if dec.err == nil && doc.Expression == nil {
+		dec.fail("$", "missing expression")
+	}
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	e := dec.node("$.expression", doc.Expression)
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	return e, nil
+}
+
+func (je jsonEnum) value(name string) (int, bool) {
+	for v, n := range je {
+		if n == name {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+func (enc *jsonEncoder) fail(message string, args ...interface{}) {
+	if enc.err == nil {
+		enc.err = errors.New("unable to encode JSON: "+message, args...)
+	}
+}
+
+// sets an error returned while encoding if one has not already been set
+func (enc *jsonEncoder) setError(err error) {
+	if enc.err == nil {
+		enc.err = err
+	}
+}
+
+func (enc *jsonEncoder) enum(names jsonEnum, v int, enumType string) string {
+	name, ok := names[v]
+	if !ok {
+		enc.fail("unknown %s %d", enumType, v)
+	}
+	return name
+}
+
+func (enc *jsonEncoder) selectClauses(c SelectClauses) *jsonSelectClauses {
+	if c == nil {
+		return nil
+	}
+	jc := &jsonSelectClauses{
+		Select:   enc.node(c.Select()),
+		Distinct: enc.node(c.Distinct()),
+		From:     enc.node(c.From()),
+		Where:    enc.node(c.Where()),
+		Alias:    enc.node(c.Alias()),
+		GroupBy:  enc.node(c.GroupBy()),
+		Having:   enc.node(c.Having()),
+		Order:    enc.node(c.Order()),
+		Limit:    enc.value(c.Limit()),
+		Offset:   c.Offset(),
+	}
+	for _, cte := range c.CommonTables() {
+		jc.With = append(jc.With, enc.node(cte))
+	}
+	for _, j := range c.Joins() {
+		jc.Joins = append(jc.Joins, enc.node(j))
+	}
+	for _, w := range c.Windows() {
+		jc.Windows = append(jc.Windows, enc.node(w))
+	}
+	for _, ce := range c.Compounds() {
+		jc.Compounds = append(jc.Compounds, enc.node(ce))
+	}
+	if c.Limit() == nil {
+		jc.Limit = nil
+	}
+	if enc.omitValues && jc.Offset > 0 {
+		// the value is omitted but an OFFSET is still part of the structure
+		jc.Offset = 1
+	}
+	if l := c.Lock(); l != nil {
+		jc.Lock = &jsonLock{
+			Strength: enc.enum(lockStrengthJSONNames, int(l.Strength()), "lock strength"),
+			Wait:     enc.enum(waitOptionJSONNames, int(l.WaitOption()), "wait option"),
+		}
+		for _, of := range l.Of() {
+			jc.Lock.Of = append(jc.Lock.Of, enc.node(of))
+		}
+	}
+	return jc
+}
+
+// nolint:gocyclo // one case per expression type
+func (enc *jsonEncoder) node(e Expression) *jsonNode {
+	if e == nil || isNilExpression(e) || enc.err != nil {
+		return nil
+	}
+	switch t := e.(type) {
+	case SelectClausesExpression:
+		return &jsonNode{Type: selectJSONType, Clauses: enc.selectClauses(t.GetClauses())}
+	case InsertClausesExpression, UpdateClausesExpression, DeleteClausesExpression, TruncateClausesExpression:
+		if enc.statements {
+			return &jsonNode{Type: statementJSONType, Statement: enc.statement(clausesOf(t))}
+		}
+	case Ex, ExOr:
+		el, err := t.(expressionListable).ToExpressions()
+		if err != nil {
+			enc.setError(err)
+			return nil
+		}
+		return enc.node(el)
+	case identifier:
+		return &jsonNode{Type: identifierJSONType, Schema: t.schema, Table: t.table, Col: enc.structuralValue(t.col)}
+	case literal:
+		return &jsonNode{Type: literalJSONType, SQL: t.literal, Args: enc.values(t.args)}
+	case aliasExpression:
+		return &jsonNode{Type: aliasJSONType, Expression: enc.node(t.aliased), Alias: enc.node(t.alias)}
+	case boolean:
+		op := enc.enum(booleanOperationJSONNames, int(t.op), "boolean operation")
+		return &jsonNode{Type: booleanJSONType, Op: op, LHS: enc.node(t.lhs), RHS: enc.value(t.rhs)}
+	case arithmetic:
+		op := enc.enum(arithmeticOperationJSONNames, int(t.op), "arithmetic operation")
+		return &jsonNode{Type: arithmeticJSONType, Op: op, LHS: enc.node(t.lhs), RHS: enc.value(t.rhs)}
+	case bitwise:
+		op := enc.enum(bitwiseOperationJSONNames, int(t.op), "bitwise operation")
+		return &jsonNode{Type: bitwiseJSONType, Op: op, LHS: enc.node(t.lhs), RHS: enc.value(t.rhs)}
+	case jsonExpression:
+		op := enc.enum(jsonOperationJSONNames, int(t.op), "JSON operation")
+		return &jsonNode{Type: jsonJSONType, Op: op, LHS: enc.node(t.lhs), RHS: enc.value(t.rhs)}
+	case ranged:
+		op := enc.enum(rangeOperationJSONNames, int(t.op), "range operation")
+		return &jsonNode{Type: rangeJSONType, Op: op, LHS: enc.node(t.lhs), RHS: enc.value(t.rhs)}
+	case array:
+		return &jsonNode{Type: arrayJSONType, Value: enc.value(t.elements)}
+	case caseExpression:
+		jn := &jsonNode{Type: caseJSONType, Value: enc.value(t.value)}
+		if t.value == nil {
+			jn.Value = nil
+		}
+		for _, w := range t.whens {
+			jn.Whens = append(jn.Whens, &jsonCaseWhen{Condition: enc.value(w.Condition()), Result: enc.value(w.Result())})
+		}
+		if t.elseCondition != nil {
+			jn.Else = enc.value(t.elseCondition.Result())
+		}
+		return jn
+	case cast:
+		return &jsonNode{Type: castJSONType, Expression: enc.node(t.casted), Name: t.t.Literal()}
+	case columnList:
+		return &jsonNode{Type: columnListJSONType, Expressions: enc.nodes(t.columns)}
+	case expressionList:
+		op := enc.enum(expressionListTypeJSONNames, int(t.operator), "expression list type")
+		return &jsonNode{Type: expressionListJSONType, Op: op, Expressions: enc.nodes(t.expressions)}
+	case compound:
+		op := enc.enum(compoundTypeJSONNames, int(t.t), "compound type")
+		return &jsonNode{Type: compoundJSONType, Op: op, Expression: enc.node(t.rhs)}
+	case commonExpr:
+		return &jsonNode{
+			Type: cteJSONType, Recursive: t.recursive, Name: t.name.Literal(), Expression: enc.node(t.subQuery),
+		}
+	case *doNothingConflict:
+		return &jsonNode{Type: doNothingJSONType}
+	case *conflictUpdate:
+		return &jsonNode{Type: doUpdateJSONType, Name: t.target, Value: enc.value(t.update), Where: enc.node(t.whereClause)}
+	case exists:
+		op := enc.enum(existsTypeJSONNames, int(t.existsType), "exists type")
+		return &jsonNode{Type: existsJSONType, Op: op, Expression: enc.node(t.subquery)}
+	case quantified:
+		op := enc.enum(quantifierJSONNames, int(t.quantifier), "quantifier")
+		return &jsonNode{Type: quantifiedJSONType, Op: op, Value: enc.value(t.value)}
+	case sqlFunctionExpression:
+		return &jsonNode{
+			Type:        functionJSONType,
+			Name:        t.name,
+			Args:        enc.values(t.args),
+			Filter:      enc.node(t.filter),
+			Order:       enc.node(t.orderCols),
+			WithinGroup: enc.node(t.withinGroupCols),
+		}
+	case grouping:
+		jn := &jsonNode{Type: groupingJSONType, Op: enc.enum(groupingTypeJSONNames, int(t.groupingType), "grouping type")}
+		for _, s := range t.sets {
+			jn.Expressions = append(jn.Expressions, enc.node(s))
+		}
+		return jn
+	case *insert:
+		jn := &jsonNode{Type: insertJSONType, Expression: enc.node(t.from), Columns: enc.node(t.cols)}
+		for _, row := range t.vals {
+			jn.Rows = append(jn.Rows, enc.values(row))
+		}
+		return jn
+	case joinExpression:
+		op := enc.enum(joinTypeJSONNames, int(t.joinType), "join type")
+		return &jsonNode{Type: joinJSONType, Op: op, Table2: enc.node(t.table)}
+	case conditionedJoin:
+		op := enc.enum(joinTypeJSONNames, int(t.joinType), "join type")
+		jn := &jsonNode{Type: joinJSONType, Op: op, Table2: enc.node(t.table)}
+		switch c := t.condition.(type) {
+		case joinOnCondition:
+			jn.On = enc.node(c.on)
+		case joinUsingCondition:
+			jn.Using = enc.node(c.using)
+		default:
+			enc.fail("unsupported join condition %T", t.condition)
+		}
+		return jn
+	case lateral:
+		return &jsonNode{Type: lateralJSONType, Expression: enc.node(t.table)}
+	case orderedExpression:
+		return &jsonNode{
+			Type:       orderedJSONType,
+			Expression: enc.node(t.sortExpression),
+			Direction:  enc.enum(sortDirectionJSONNames, int(t.direction), "sort direction"),
+			Nulls:      enc.enum(nullSortTypeJSONNames, int(t.nullSortType), "null sort type"),
+		}
+	case tuple:
+		return &jsonNode{Type: tupleJSONType, Args: enc.values(t.values)}
+	case sqlWindowExpression:
+		return &jsonNode{
+			Type:      windowJSONType,
+			Window:    enc.node(t.name),
+			Parent:    enc.node(t.parent),
+			Partition: enc.node(t.partitionCols),
+			Order:     enc.node(t.orderCols),
+			Frame:     enc.node(t.frame),
+		}
+	case windowFrame:
+		return &jsonNode{
+			Type:      windowFrameJSONType,
+			Unit:      enc.enum(windowFrameUnitJSONNames, int(t.unit), "window frame unit"),
+			Start:     enc.node(t.start),
+			End:       enc.node(t.end),
+			Exclusion: enc.enum(windowFrameExclusionJSONNames, int(t.exclusion), "window frame exclusion"),
+		}
+	case windowFrameBound:
+		op := enc.enum(windowFrameBoundJSONNames, int(t.boundType), "window frame bound")
+		jn := &jsonNode{Type: windowFrameBoundJSONType, Op: op}
+		if t.offset != nil {
+			jn.Value = enc.value(t.offset)
+		}
+		return jn
+	case sqlWindowFunctionExpression:
+		return &jsonNode{
+			Type:       windowFunctionJSONType,
+			Expression: enc.node(t.fn),
+			WindowName: enc.node(t.windowName),
+			Window:     enc.node(t.window),
+		}
+	case textSearch:
+		return &jsonNode{
+			Type:        textSearchJSONType,
+			Op:          enc.enum(textSearchTypeJSONNames, int(t.searchType), "text search type"),
+			Expressions: enc.nodes(t.documents),
+			Value:       enc.value(t.query),
+			Mode:        enc.enum(textSearchModeJSONNames, int(t.mode), "text search mode"),
+			Name:        t.config,
+		}
+	case stringExpression:
+		op := enc.enum(stringOperationJSONNames, int(t.op), "string operation")
+		return &jsonNode{Type: stringJSONType, Op: op, Args: enc.values(t.args)}
+	case collate:
+		return &jsonNode{Type: collateJSONType, Expression: enc.node(t.collated), Name: t.collation}
+	case interval:
+		unit := enc.enum(dateTimeUnitJSONNames, int(t.unit), "date time unit")
+		if enc.omitValues {
+			return &jsonNode{Type: intervalJSONType, Unit: unit}
+		}
+		return &jsonNode{Type: intervalJSONType, Amount: t.amount, Unit: unit}
+	case dateArithmetic:
+		op := enc.enum(dateArithmeticOperationJSONNames, int(t.op), "date arithmetic operation")
+		return &jsonNode{Type: dateArithmeticJSONType, Op: op, LHS: enc.node(t.lhs), Interval: enc.node(t.interval)}
+	case extract:
+		unit := enc.enum(dateTimeUnitJSONNames, int(t.field), "date time unit")
+		return &jsonNode{Type: extractJSONType, Unit: unit, Expression: enc.node(t.source)}
+	case dateTrunc:
+		unit := enc.enum(dateTimeUnitJSONNames, int(t.unit), "date time unit")
+		return &jsonNode{Type: dateTruncJSONType, Unit: unit, Expression: enc.node(t.source)}
+	case currentTime:
+		return &jsonNode{
+			Type: currentTimeJSONType, Op: enc.enum(currentTimeTypeJSONNames, int(t.timeType), "current time type"),
+		}
+	}
+	enc.fail("unsupported expression type %T", e)
+	return nil
+}
+
+func (enc *jsonEncoder) nodes(exps []Expression) []*jsonNode {
+	jns := make([]*jsonNode, 0, len(exps))
+	for _, e := range exps {
+		jns = append(jns, enc.node(e))
+	}
+	return jns
+}
+
+func (enc *jsonEncoder) values(vals []interface{}) []*jsonValue {
+	if vals == nil {
+		return nil
+	}
+	jvs := make([]*jsonValue, 0, len(vals))
+	for _, val := range vals {
+		jvs = append(jvs, enc.value(val))
+	}
+	return jvs
+}
+
+// encodes a value that is part of the structure of an expression (e.g. the column of an identifier) so it is never
+// omitted
+func (enc *jsonEncoder) structuralValue(val interface{}) *jsonValue {
+	omitValues := enc.omitValues
+	enc.omitValues = false
+	defer func() { enc.omitValues = omitValues }()
+	return enc.value(val)
+}
+
+func (enc *jsonEncoder) scalar(kind string, val interface{}) *jsonValue {
+	if enc.omitValues {
+		return &jsonValue{Kind: kind}
+	}
+	raw, err := json.Marshal(val)
+	if err != nil {
+		enc.fail("%s", err.Error())
+		return nil
+	}
+	return &jsonValue{Kind: kind, Value: raw}
+}
+
+func (enc *jsonEncoder) value(val interface{}) *jsonValue {
+	if enc.err != nil {
+		return nil
+	}
+	switch t := val.(type) {
+	case nil:
+		return &jsonValue{Kind: nullJSONKind}
+	case Expression:
+		if isNilExpression(t) {
+			return &jsonValue{Kind: nullJSONKind}
+		}
+		return &jsonValue{Kind: expressionJSONKind, Expression: enc.node(t)}
+	case RangeVal:
+		return &jsonValue{Kind: rangeJSONKind, Start: enc.value(t.Start()), End: enc.value(t.End())}
+	case []byte:
+		return enc.scalar(bytesJSONKind, t)
+	case time.Time:
+		return enc.scalar(timeJSONKind, t.Format(time.RFC3339Nano))
+	case Record:
+		jv := &jsonValue{Kind: recordJSONKind, Fields: make(map[string]*jsonValue, len(t))}
+		for k, v := range t {
+			jv.Fields[k] = enc.value(v)
+		}
+		return jv
+	}
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return &jsonValue{Kind: nullJSONKind}
+		}
+		return enc.value(v.Elem().Interface())
+	case reflect.Slice:
+		jv := &jsonValue{Kind: reflect.Slice.String(), Items: make([]*jsonValue, 0, v.Len())}
+		if elem := v.Type().Elem(); elem.Kind() != reflect.Interface {
+			jv.Elem = jsonKind(elem)
+			if _, ok := jsonScalarKinds[jv.Elem]; !ok {
+				enc.fail("unsupported slice type %T", val)
+				return nil
+			}
+		}
+		for i := 0; i < v.Len(); i++ {
+			jv.Items = append(jv.Items, enc.value(v.Index(i).Interface()))
+		}
+		return jv
+	case reflect.Struct:
+		if v.Type().ConvertibleTo(timeType) {
+			return enc.value(v.Convert(timeType).Interface())
+		}
+	default:
+		if kind := v.Kind().String(); jsonScalarKinds[kind] != nil {
+			return enc.scalar(kind, v.Convert(jsonScalarKinds[kind]).Interface())
+		}
+	}
+	enc.fail("unsupported value type %T", val)
+	return nil
+}
+
+// the value kind of a type, []byte and time.Time are stored as their own kind
+func jsonKind(t reflect.Type) string {
+	switch {
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return bytesJSONKind
+	case t.Kind() == reflect.Struct && t.ConvertibleTo(timeType):
+		return timeJSONKind
+	}
+	return t.Kind().String()
+}
+
+func (dec *jsonDecoder) fail(path, message string, args ...interface{}) {
+	if dec.err == nil {
+		dec.err = errors.New("invalid JSON at %s: "+message, append([]interface{}{path}, args...)...)
+	}
+}
+
+func (dec *jsonDecoder) document(data []byte) jsonDocument {
+	var doc jsonDocument
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&doc); err != nil {
+		dec.fail("$", "%s", err.Error())
+		return doc
+	}
+	if doc.Version != JSONVersion {
+		dec.fail("$.version", "unsupported version %d expected %d", doc.Version, JSONVersion)
+	}
+	return doc
+}
+
+func (dec *jsonDecoder) enum(path string, names jsonEnum, name, enumType string) int {
+	v, ok := names.value(name)
+	if !ok {
+		dec.fail(path, "unknown %s %q", enumType, name)
+	}
+	return v
+}
+
+func (dec *jsonDecoder) selectClauses(path string, jc *jsonSelectClauses) SelectClauses {
+	c := NewSelectClauses().clone()
+	if jc.Select != nil {
+		c.selectColumns = dec.columnList(path+".select", jc.Select)
+	}
+	c.distinct = dec.columnList(path+".distinct", jc.Distinct)
+	c.from = dec.columnList(path+".from", jc.From)
+	c.where = dec.expressionList(path+".where", jc.Where)
+	c.alias = dec.identifier(path+".alias", jc.Alias)
+	c.groupBy = dec.columnList(path+".group_by", jc.GroupBy)
+	c.having = dec.expressionList(path+".having", jc.Having)
+	c.order = dec.columnList(path+".order", jc.Order)
+	if jc.Limit != nil {
+		c.limit = dec.value(path+".limit", jc.Limit)
+	}
+	c.offset = jc.Offset
+	for i, jn := range jc.With {
+		p := path + ".with" + index(i)
+		if cte, ok := dec.required(p, jn).(CommonTableExpression); ok {
+			c.commonTables = append(c.commonTables, cte)
+		} else {
+			dec.fail(p, "expected a cte")
+		}
+	}
+	for i, jn := range jc.Joins {
+		p := path + ".joins" + index(i)
+		if j, ok := dec.required(p, jn).(JoinExpression); ok {
+			c.joins = append(c.joins, j)
+		} else {
+			dec.fail(p, "expected a join")
+		}
+	}
+	for i, jn := range jc.Windows {
+		p := path + ".windows" + index(i)
+		if w, ok := dec.required(p, jn).(WindowExpression); ok {
+			c.windows = append(c.windows, w)
+		} else {
+			dec.fail(p, "expected a window")
+		}
+	}
+	for i, jn := range jc.Compounds {
+		p := path + ".compounds" + index(i)
+		if ce, ok := dec.required(p, jn).(CompoundExpression); ok {
+			c.compounds = append(c.compounds, ce)
+		} else {
+			dec.fail(p, "expected a compound")
+		}
+	}
+	if jl := jc.Lock; jl != nil {
+		strength := dec.enum(path+".lock.strength", lockStrengthJSONNames, jl.Strength, "lock strength")
+		wait := dec.enum(path+".lock.wait", waitOptionJSONNames, jl.Wait, "wait option")
+		of := make([]IdentifierExpression, 0, len(jl.Of))
+		for i, jn := range jl.Of {
+			of = append(of, dec.identifier(path+".lock.of"+index(i), jn))
+		}
+		if len(jl.Of) == 0 {
+			of = nil
+		}
+		c.lock = NewLock(LockStrength(strength), WaitOption(wait), of...)
+	}
+	return c
+}
+
+// decodes an optional node, nil is returned when the node is missing
+func (dec *jsonDecoder) optional(path string, jn *jsonNode) Expression {
+	if jn == nil || dec.err != nil {
+		return nil
+	}
+	return dec.node(path, jn)
+}
+
+// decodes a node that must be present
+func (dec *jsonDecoder) required(path string, jn *jsonNode) Expression {
+	if jn == nil {
+		dec.fail(path, "missing expression")
+		return nil
+	}
+	return dec.optional(path, jn)
+}
+
+func (dec *jsonDecoder) identifier(path string, jn *jsonNode) IdentifierExpression {
+	e := dec.optional(path, jn)
+	if e == nil {
+		return nil
+	}
+	i, ok := e.(IdentifierExpression)
+	if !ok {
+		dec.fail(path, "expected an identifier got %s", jn.Type)
+	}
+	return i
+}
+
+func (dec *jsonDecoder) columnList(path string, jn *jsonNode) ColumnListExpression {
+	e := dec.optional(path, jn)
+	if e == nil {
+		return nil
+	}
+	cl, ok := e.(ColumnListExpression)
+	if !ok {
+		dec.fail(path, "expected a column_list got %s", jn.Type)
+	}
+	return cl
+}
+
+func (dec *jsonDecoder) expressionList(path string, jn *jsonNode) ExpressionList {
+	e := dec.optional(path, jn)
+	if e == nil {
+		return nil
+	}
+	el, ok := e.(ExpressionList)
+	if !ok {
+		dec.fail(path, "expected an expression_list got %s", jn.Type)
+	}
+	return el
+}
+
+func (dec *jsonDecoder) appendable(path string, jn *jsonNode) AppendableExpression {
+	e := dec.required(path, jn)
+	if e == nil {
+		return nil
+	}
+	ae, ok := e.(AppendableExpression)
+	if !ok {
+		dec.fail(path, "expected a select got %s", jn.Type)
+	}
+	return ae
+}
+
+func (dec *jsonDecoder) nodes(path string, jns []*jsonNode) []Expression {
+	exps := make([]Expression, 0, len(jns))
+	for i, jn := range jns {
+		exps = append(exps, dec.required(path+index(i), jn))
+	}
+	return exps
+}
+
+// nolint:gocyclo // one case per expression type
+func (dec *jsonDecoder) node(path string, jn *jsonNode) Expression {
+	switch jn.Type {
+	case selectJSONType:
+		if jn.Clauses == nil {
+			dec.fail(path, "missing clauses")
+			return nil
+		}
+		if dec.newSelect == nil {
+			dec.fail(path, "subqueries are not supported")
+			return nil
+		}
+		c := dec.selectClauses(path+".clauses", jn.Clauses)
+		if dec.err != nil {
+			return nil
+		}
+		return dec.newSelect(c)
+	case identifierJSONType:
+		i := identifier{schema: jn.Schema, table: jn.Table}
+		if jn.Col != nil {
+			i.col = dec.value(path+".col", jn.Col)
+		}
+		if i.schema == "" && i.table == "" && i.col == nil {
+			dec.fail(path, "empty identifier")
+		}
+		return i
+	case literalJSONType:
+		return literal{literal: jn.SQL, args: dec.values(path+".args", jn.Args)}
+	case aliasJSONType:
+		alias := dec.identifier(path+".alias", jn.Alias)
+		if alias == nil {
+			dec.fail(path+".alias", "missing expression")
+		}
+		return aliasExpression{aliased: dec.required(path+".expression", jn.Expression), alias: alias}
+	case booleanJSONType:
+		return boolean{
+			op:  BooleanOperation(dec.enum(path+".op", booleanOperationJSONNames, jn.Op, "boolean operation")),
+			lhs: dec.required(path+".lhs", jn.LHS),
+			rhs: dec.value(path+".rhs", jn.RHS),
+		}
+	case arithmeticJSONType:
+		return arithmetic{
+			op:  ArithmeticOperation(dec.enum(path+".op", arithmeticOperationJSONNames, jn.Op, "arithmetic operation")),
+			lhs: dec.optional(path+".lhs", jn.LHS),
+			rhs: dec.value(path+".rhs", jn.RHS),
+		}
+	case bitwiseJSONType:
+		return bitwise{
+			op:  BitwiseOperation(dec.enum(path+".op", bitwiseOperationJSONNames, jn.Op, "bitwise operation")),
+			lhs: dec.optional(path+".lhs", jn.LHS),
+			rhs: dec.value(path+".rhs", jn.RHS),
+		}
+	case jsonJSONType:
+		return jsonExpression{
+			op:  JSONOperation(dec.enum(path+".op", jsonOperationJSONNames, jn.Op, "JSON operation")),
+			lhs: dec.required(path+".lhs", jn.LHS),
+			rhs: dec.value(path+".rhs", jn.RHS),
+		}
+	case rangeJSONType:
+		rv, ok := dec.value(path+".rhs", jn.RHS).(RangeVal)
+		if !ok {
+			dec.fail(path+".rhs", "expected a range")
+		}
+		return ranged{
+			op:  RangeOperation(dec.enum(path+".op", rangeOperationJSONNames, jn.Op, "range operation")),
+			lhs: dec.required(path+".lhs", jn.LHS),
+			rhs: rv,
+		}
+	case arrayJSONType:
+		return array{elements: dec.value(path+".value", jn.Value)}
+	case caseJSONType:
+		ce := caseExpression{whens: make([]CaseWhen, 0, len(jn.Whens))}
+		if jn.Value != nil {
+			ce.value = dec.value(path+".value", jn.Value)
+		}
+		for i, w := range jn.Whens {
+			p := path + ".whens" + index(i)
+			if w == nil {
+				dec.fail(p, "missing when")
+				return nil
+			}
+			ce.whens = append(ce.whens, NewCaseWhen(dec.value(p+".condition", w.Condition), dec.value(p+".result", w.Result)))
+		}
+		if jn.Else != nil {
+			ce.elseCondition = NewCaseElse(dec.value(path+".else", jn.Else))
+		}
+		return ce
+	case castJSONType:
+		if jn.Name == "" {
+			dec.fail(path+".name", "missing cast type")
+		}
+		return cast{casted: dec.required(path+".expression", jn.Expression), t: NewLiteralExpression(jn.Name)}
+	case columnListJSONType:
+		return columnList{columns: dec.nodes(path+".expressions", jn.Expressions)}
+	case expressionListJSONType:
+		return expressionList{
+			operator:    ExpressionListType(dec.enum(path+".op", expressionListTypeJSONNames, jn.Op, "expression list type")),
+			expressions: dec.nodes(path+".expressions", jn.Expressions),
+		}
+	case compoundJSONType:
+		return compound{
+			t:   CompoundType(dec.enum(path+".op", compoundTypeJSONNames, jn.Op, "compound type")),
+			rhs: dec.appendable(path+".expression", jn.Expression),
+		}
+	case cteJSONType:
+		if jn.Name == "" {
+			dec.fail(path+".name", "missing cte name")
+		}
+		return commonExpr{
+			recursive: jn.Recursive,
+			name:      NewLiteralExpression(jn.Name),
+			subQuery:  dec.appendable(path+".expression", jn.Expression),
+		}
+	case doNothingJSONType:
+		return &doNothingConflict{}
+	case doUpdateJSONType:
+		return &conflictUpdate{
+			target:      jn.Name,
+			update:      dec.value(path+".value", jn.Value),
+			whereClause: dec.expressionList(path+".where", jn.Where),
+		}
+	case existsJSONType:
+		return exists{
+			existsType: ExistsType(dec.enum(path+".op", existsTypeJSONNames, jn.Op, "exists type")),
+			subquery:   dec.appendable(path+".expression", jn.Expression),
+		}
+	case quantifiedJSONType:
+		return quantified{
+			quantifier: QuantifierType(dec.enum(path+".op", quantifierJSONNames, jn.Op, "quantifier")),
+			value:      dec.value(path+".value", jn.Value),
+		}
+	case functionJSONType:
+		if jn.Name == "" {
+			dec.fail(path+".name", "missing function name")
+		}
+		return sqlFunctionExpression{
+			name:            jn.Name,
+			args:            dec.values(path+".args", jn.Args),
+			filter:          dec.expressionList(path+".filter", jn.Filter),
+			orderCols:       dec.columnList(path+".order", jn.Order),
+			withinGroupCols: dec.columnList(path+".within_group", jn.WithinGroup),
+		}
+	case groupingJSONType:
+		g := grouping{
+			groupingType: GroupingType(dec.enum(path+".op", groupingTypeJSONNames, jn.Op, "grouping type")),
+			sets:         make([]ColumnListExpression, 0, len(jn.Expressions)),
+		}
+		for i, s := range jn.Expressions {
+			g.sets = append(g.sets, dec.columnList(path+".expressions"+index(i), s))
+		}
+		return g
+	case insertJSONType:
+		i := &insert{cols: dec.columnList(path+".columns", jn.Columns)}
+		if jn.Expression != nil {
+			i.from = dec.appendable(path+".expression", jn.Expression)
+		}
+		for r, row := range jn.Rows {
+			i.vals = append(i.vals, dec.values(path+".rows"+index(r), row))
+		}
+		return i
+	case joinJSONType:
+		j := joinExpression{
+			joinType: JoinType(dec.enum(path+".op", joinTypeJSONNames, jn.Op, "join type")),
+			table:    dec.required(path+".join_table", jn.Table2),
+		}
+		switch {
+		case jn.On != nil:
+			j.isConditioned = true
+			return conditionedJoin{joinExpression: j, condition: joinOnCondition{on: dec.expressionList(path+".on", jn.On)}}
+		case jn.Using != nil:
+			j.isConditioned = true
+			using := dec.columnList(path+".using", jn.Using)
+			return conditionedJoin{joinExpression: j, condition: joinUsingCondition{using: using}}
+		}
+		return j
+	case lateralJSONType:
+		return lateral{table: dec.appendable(path+".expression", jn.Expression)}
+	case orderedJSONType:
+		return orderedExpression{
+			sortExpression: dec.required(path+".expression", jn.Expression),
+			direction:      SortDirection(dec.enum(path+".direction", sortDirectionJSONNames, jn.Direction, "sort direction")),
+			nullSortType:   NullSortType(dec.enum(path+".nulls", nullSortTypeJSONNames, jn.Nulls, "null sort type")),
+		}
+	case tupleJSONType:
+		return tuple{values: dec.values(path+".args", jn.Args)}
+	case windowJSONType:
+		w := sqlWindowExpression{
+			name:          dec.identifier(path+".window", jn.Window),
+			parent:        dec.identifier(path+".parent", jn.Parent),
+			partitionCols: dec.columnList(path+".partition", jn.Partition),
+			orderCols:     dec.columnList(path+".order", jn.Order),
+		}
+		if jn.Frame != nil {
+			if f, ok := dec.optional(path+".frame", jn.Frame).(WindowFrameExpression); ok {
+				w.frame = f
+			} else {
+				dec.fail(path+".frame", "expected a window_frame got %s", jn.Frame.Type)
+			}
+		}
+		return w
+	case windowFrameJSONType:
+		return windowFrame{
+			unit:  WindowFrameUnit(dec.enum(path+".unit", windowFrameUnitJSONNames, jn.Unit, "window frame unit")),
+			start: dec.windowFrameBound(path+".start", jn.Start),
+			end:   dec.windowFrameBound(path+".end", jn.End),
+			exclusion: WindowFrameExclusion(dec.enum(
+				path+".exclusion", windowFrameExclusionJSONNames, jn.Exclusion, "window frame exclusion",
+			)),
+		}
+	case windowFrameBoundJSONType:
+		b := windowFrameBound{
+			boundType: WindowFrameBoundType(dec.enum(path+".op", windowFrameBoundJSONNames, jn.Op, "window frame bound")),
+		}
+		if jn.Value != nil {
+			b.offset = dec.value(path+".value", jn.Value)
+		}
+		return b
+	case windowFunctionJSONType:
+		wf := sqlWindowFunctionExpression{windowName: dec.identifier(path+".window_name", jn.WindowName)}
+		if fn, ok := dec.required(path+".expression", jn.Expression).(SQLFunctionExpression); ok {
+			wf.fn = fn
+		} else {
+			dec.fail(path+".expression", "expected a function")
+		}
+		if jn.Window != nil {
+			if w, ok := dec.optional(path+".window", jn.Window).(WindowExpression); ok {
+				wf.window = w
+			} else {
+				dec.fail(path+".window", "expected a window got %s", jn.Window.Type)
+			}
+		}
+		return wf
+	case textSearchJSONType:
+		return textSearch{
+			searchType: TextSearchType(dec.enum(path+".op", textSearchTypeJSONNames, jn.Op, "text search type")),
+			documents:  dec.nodes(path+".expressions", jn.Expressions),
+			query:      dec.value(path+".value", jn.Value),
+			mode:       TextSearchMode(dec.enum(path+".mode", textSearchModeJSONNames, jn.Mode, "text search mode")),
+			config:     jn.Name,
+		}
+	case stringJSONType:
+		return stringExpression{
+			op:   StringOperation(dec.enum(path+".op", stringOperationJSONNames, jn.Op, "string operation")),
+			args: dec.values(path+".args", jn.Args),
+		}
+	case collateJSONType:
+		if jn.Name == "" {
+			dec.fail(path+".name", "missing collation")
+		}
+		return collate{collated: dec.required(path+".expression", jn.Expression), collation: jn.Name}
+	case intervalJSONType:
+		return interval{
+			amount: jn.Amount,
+			unit:   DateTimeUnit(dec.enum(path+".unit", dateTimeUnitJSONNames, jn.Unit, "date time unit")),
+		}
+	case dateArithmeticJSONType:
+		da := dateArithmetic{
+			op: DateArithmeticOperation(dec.enum(
+				path+".op", dateArithmeticOperationJSONNames, jn.Op, "date arithmetic operation",
+			)),
+			lhs: dec.required(path+".lhs", jn.LHS),
+		}
+		if i, ok := dec.required(path+".interval", jn.Interval).(IntervalExpression); ok {
+			da.interval = i
+		} else {
+			dec.fail(path+".interval", "expected an interval")
+		}
+		return da
+	case extractJSONType:
+		return extract{
+			field:  DateTimeUnit(dec.enum(path+".unit", dateTimeUnitJSONNames, jn.Unit, "date time unit")),
+			source: dec.required(path+".expression", jn.Expression),
+		}
+	case dateTruncJSONType:
+		return dateTrunc{
+			unit:   DateTimeUnit(dec.enum(path+".unit", dateTimeUnitJSONNames, jn.Unit, "date time unit")),
+			source: dec.required(path+".expression", jn.Expression),
+		}
+	case currentTimeJSONType:
+		return currentTime{
+			timeType: CurrentTimeType(dec.enum(path+".op", currentTimeTypeJSONNames, jn.Op, "current time type")),
+		}
+	}
+	dec.fail(path+".type", "unknown expression type %q", jn.Type)
+	return nil
+}
+
+func (dec *jsonDecoder) windowFrameBound(path string, jn *jsonNode) WindowFrameBound {
+	e := dec.optional(path, jn)
+	if e == nil {
+		return nil
+	}
+	b, ok := e.(WindowFrameBound)
+	if !ok {
+		dec.fail(path, "expected a window_frame_bound got %s", jn.Type)
+	}
+	return b
+}
+
+func (dec *jsonDecoder) values(path string, jvs []*jsonValue) []interface{} {
+	if jvs == nil {
+		return nil
+	}
+	vals := make([]interface{}, 0, len(jvs))
+	for i, jv := range jvs {
+		vals = append(vals, dec.value(path+index(i), jv))
+	}
+	return vals
+}
+
+func (dec *jsonDecoder) value(path string, jv *jsonValue) interface{} {
+	if dec.err != nil {
+		return nil
+	}
+	if jv == nil {
+		dec.fail(path, "missing value")
+		return nil
+	}
+	switch jv.Kind {
+	case nullJSONKind:
+		return nil
+	case expressionJSONKind:
+		return dec.required(path+".expression", jv.Expression)
+	case rangeJSONKind:
+		return NewRangeVal(dec.value(path+".start", jv.Start), dec.value(path+".end", jv.End))
+	case recordJSONKind:
+		r := make(Record, len(jv.Fields))
+		keys := make([]string, 0, len(jv.Fields))
+		for k := range jv.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			r[k] = dec.value(path+".fields."+k, jv.Fields[k])
+		}
+		return r
+	case reflect.Slice.String():
+		if jv.Elem == "" {
+			vals := dec.values(path+".items", jv.Items)
+			if vals == nil {
+				vals = []interface{}{}
+			}
+			return vals
+		}
+		elem, ok := jsonScalarKinds[jv.Elem]
+		if !ok {
+			dec.fail(path+".elem", "unknown slice element kind %q", jv.Elem)
+			return nil
+		}
+		s := reflect.MakeSlice(reflect.SliceOf(elem), 0, len(jv.Items))
+		for i, item := range jv.Items {
+			p := path + ".items" + index(i)
+			if item == nil || item.Kind != jv.Elem {
+				dec.fail(p, "expected a %s value", jv.Elem)
+				return nil
+			}
+			s = reflect.Append(s, reflect.ValueOf(dec.value(p, item)))
+		}
+		return s.Interface()
+	case timeJSONKind:
+		var s string
+		if err := json.Unmarshal(jv.Value, &s); err != nil {
+			dec.fail(path+".value", "%s", err.Error())
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			dec.fail(path+".value", "%s", err.Error())
+			return nil
+		}
+		return t
+	}
+	t, ok := jsonScalarKinds[jv.Kind]
+	if !ok {
+		dec.fail(path+".kind", "unknown value kind %q", jv.Kind)
+		return nil
+	}
+	if len(jv.Value) == 0 {
+		dec.fail(path+".value", "missing %s value", jv.Kind)
+		return nil
+	}
+	v := reflect.New(t)
+	if err := json.Unmarshal(jv.Value, v.Interface()); err != nil {
+		dec.fail(path+".value", "%s", err.Error())
+		return nil
+	}
+	return v.Elem().Interface()
+}
+
+func index(i int) string {
+	return "[" + strconv.Itoa(i) + "]"
+}
@@ -0,0 +1,250 @@
+package exp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/orn-id/depiq/internal/sb"
+	"github.com/stretchr/testify/suite"
+)
+
+type serializeSuite struct {
+	suite.Suite
+}
+
+func TestSerializeSuite(t *testing.T) {
+	suite.Run(t, &serializeSuite{})
+}
+
+// a subquery used to test expressions that require an AppendableExpression
+type jsonSubquery struct {
+	clauses exp.SelectClauses
+}
+
+func newJSONSubquery(clauses exp.SelectClauses) exp.AppendableExpression {
+	return jsonSubquery{clauses: clauses}
+}
+
+func (js jsonSubquery) Expression() exp.Expression      { return js }
+func (js jsonSubquery) Clone() exp.Expression           { return js }
+func (js jsonSubquery) AppendSQL(b sb.SQLBuilder)       {}
+func (js jsonSubquery) GetAs() exp.IdentifierExpression { return js.clauses.Alias() }
+func (js jsonSubquery) ReturnsColumns() bool            { return true }
+func (js jsonSubquery) GetClauses() exp.SelectClauses   { return js.clauses }
+func (js jsonSubquery) WithClauses(clauses exp.SelectClauses) exp.Expression {
+	return jsonSubquery{clauses}
+}
+
+func (ss *serializeSuite) assertRoundTrip(e exp.Expression) {
+	data, err := exp.MarshalExpressionJSON(e)
+	ss.Require().NoError(err)
+	decoded, err := exp.UnmarshalExpressionJSON(data, newJSONSubquery)
+	ss.Require().NoError(err)
+	ss.Equal(e, decoded, string(data))
+
+	reencoded, err := exp.MarshalExpressionJSON(decoded)
+	ss.Require().NoError(err)
+	ss.JSONEq(string(data), string(reencoded))
+}
+
+func (ss *serializeSuite) TestExpressions() {
+	a := col("a")
+	sub := newJSONSubquery(exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("b")))
+	fn := exp.NewSQLFunctionExpression("SUM", a)
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	cases := []exp.Expression{
+		exp.NewIdentifierExpression("s", "t", "c"),
+		exp.NewIdentifierExpression("", "t", exp.Star()),
+		exp.NewLiteralExpression("? + ?", 1, "a"),
+		exp.NewAliasExpression(a, "b"),
+		a.Eq(nil),
+		a.Eq(int8(1)),
+		a.Eq(uint32(1)),
+		a.Eq(1.5),
+		a.Eq(float32(1.5)),
+		a.Eq([]byte("bytes")),
+		a.Eq(ts),
+		a.Eq(col("b")),
+		a.In([]string{"a", "b"}),
+		a.In([]int64{1, 2}),
+		a.In([]interface{}{1, "a", nil}),
+		a.In(sub),
+		a.NotLike("a%"),
+		a.IsNotDistinctFrom(1),
+		exp.NewArrayExpression("a", "b").Overlaps([]string{"b"}),
+		a.Eq(exp.NewQuantifiedExpression(exp.AnyQuantifierType, sub)),
+		exp.NewArithmeticExpression(exp.NegOp, nil, a),
+		a.Add(1),
+		a.BitwiseXor(2),
+		exp.NewJSONExpression(exp.JSONExtractOp, a, []interface{}{"key", 0}),
+		a.Between(exp.NewRangeVal(1, 10)),
+		a.NotBetween(exp.NewRangeVal(ts, ts.Add(time.Hour))),
+		exp.NewCaseExpression().When(a.Eq(1), "one").Else("other"),
+		exp.NewCaseExpression().Value(a).When(1, "one"),
+		exp.NewCastExpression(a, "TEXT"),
+		exp.NewColumnListExpression("a", col("b")),
+		exp.NewExpressionList(exp.OrType, a.Eq(1), a.IsNull()),
+		exp.NewCompoundExpression(exp.UnionAllCompoundType, sub),
+		exp.NewCommonTableExpression(true, "t(a)", sub),
+		exp.NewExistsExpression(exp.NotExistsSubqueryType, sub),
+		fn,
+		fn.Filter(a.Gt(1)).OrderBy(a.Desc()),
+		exp.NewSQLFunctionExpression("PERCENTILE_CONT", 0.5).WithinGroup(a.Asc()),
+		exp.NewGroupingExpression(exp.GroupingSetsGroupingType,
+			exp.NewColumnListExpression("a"), exp.NewColumnListExpression()),
+		exp.NewUnConditionedJoinExpression(exp.CrossJoinType, exp.NewIdentifierExpression("", "t", "")),
+		exp.NewConditionedJoinExpression(exp.LeftJoinType, sub, exp.NewJoinOnCondition(a.Eq(col("b")))),
+		exp.NewConditionedJoinExpression(exp.InnerJoinType, exp.NewIdentifierExpression("", "t", ""),
+			exp.NewJoinUsingCondition("a")),
+		exp.NewLateralExpression(sub),
+		a.Desc().NullsFirst(),
+		exp.NewTupleExpression(a, 1),
+		exp.NewWindowExpression(col("w"), col("p"), exp.NewColumnListExpression("a"), exp.NewOrderedColumnList(a.Asc())),
+		exp.NewWindowExpression(nil, nil, nil, nil).RowsBetween(
+			exp.NewWindowFrameBound(exp.PrecedingFrameBound, 2), exp.NewWindowFrameBound(exp.CurrentRowFrameBound, nil),
+		).Exclude(exp.TiesFrameExclusion),
+		fn.Over(exp.NewWindowExpression(nil, nil, nil, nil)),
+		fn.OverName(col("w")),
+		exp.NewTextSearchExpression(exp.TextSearchRankType, []exp.Expression{a}, "term"),
+		exp.NewStringExpression(exp.ConcatOp, a, "-", col("b")),
+		exp.NewCollateExpression(a, "C"),
+		exp.NewDateArithmeticExpression(exp.DateSubOp, a, exp.NewIntervalExpression(2, exp.DayUnit)),
+		exp.NewExtractExpression(exp.EpochUnit, a),
+		exp.NewDateTruncExpression(exp.MonthUnit, a),
+		exp.NewCurrentTimeExpression(exp.DateCurrentTimeType),
+		exp.NewDoUpdateConflictExpression("a", exp.Record{"a": exp.NewLiteralExpression("EXCLUDED.a")}).
+			Where(a.Gt(1)),
+		exp.NewDoNothingConflictExpression(),
+		sub,
+	}
+	for _, c := range cases {
+		ss.assertRoundTrip(c)
+	}
+}
+
+func (ss *serializeSuite) TestExpressions_ex() {
+	e := exp.Ex{"a": 1, "b": exp.Op{"in": []string{"x"}}}
+	data, err := exp.MarshalExpressionJSON(e)
+	ss.Require().NoError(err)
+	decoded, err := exp.UnmarshalExpressionJSON(data, nil)
+	ss.Require().NoError(err)
+
+	expected, err := e.ToExpressions()
+	ss.Require().NoError(err)
+	ss.Equal(expected, decoded)
+}
+
+func (ss *serializeSuite) TestSelectClauses() {
+	a := col("a")
+	sub := newJSONSubquery(exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("b")).SetAlias(col("s")))
+	c := exp.NewSelectClauses().
+		CommonTablesAppend(exp.NewCommonTableExpression(false, "cte", sub)).
+		SetSelect(exp.NewColumnListExpression(a, exp.NewSQLFunctionExpression("COUNT", exp.Star()))).
+		SetDistinct(exp.NewColumnListExpression(a)).
+		SetFrom(exp.NewColumnListExpression("t", sub)).
+		JoinsAppend(exp.NewConditionedJoinExpression(exp.LeftJoinType, sub, exp.NewJoinOnCondition(a.Eq(col("b"))))).
+		WhereAppend(a.Gt(1), a.In(sub)).
+		GroupByAppend(exp.NewColumnListExpression(a)).
+		HavingAppend(a.Gt(1)).
+		WindowsAppend(exp.NewWindowExpression(col("w"), nil, nil, exp.NewOrderedColumnList(a.Asc()))).
+		CompoundsAppend(exp.NewCompoundExpression(exp.IntersectCompoundType, sub)).
+		SetOrder(a.Desc()).
+		SetLimit(uint(10)).
+		SetOffset(5).
+		SetLock(exp.NewLock(exp.ForShare, exp.NoWait, exp.NewIdentifierExpression("", "t", "")))
+
+	data, err := exp.MarshalSelectClausesJSON(c)
+	ss.Require().NoError(err)
+	decoded, err := exp.UnmarshalSelectClausesJSON(data, newJSONSubquery)
+	ss.Require().NoError(err)
+	ss.Equal(c, decoded)
+
+	reencoded, err := exp.MarshalSelectClausesJSON(decoded)
+	ss.Require().NoError(err)
+	ss.JSONEq(string(data), string(reencoded))
+}
+
+func (ss *serializeSuite) TestSelectClauses_defaults() {
+	c := exp.NewSelectClauses()
+	data, err := exp.MarshalSelectClausesJSON(c)
+	ss.Require().NoError(err)
+	ss.JSONEq(`{"version":1,"select":{"select":{"type":"column_list","expressions":[{"type":"literal","sql":"*"}]}}}`,
+		string(data))
+
+	decoded, err := exp.UnmarshalSelectClausesJSON([]byte(`{"version":1,"select":{}}`), nil)
+	ss.Require().NoError(err)
+	ss.Equal(c, decoded)
+}
+
+func (ss *serializeSuite) TestMarshal_unsupported() {
+	_, err := exp.MarshalExpressionJSON(col("a").Eq(struct{}{}))
+	ss.EqualError(err, "depiq: unable to encode JSON: unsupported value type struct {}")
+
+	_, err = exp.MarshalExpressionJSON(col("a").In([]struct{}{}))
+	ss.EqualError(err, "depiq: unable to encode JSON: unsupported slice type []struct {}")
+
+	_, err = exp.MarshalExpressionJSON(exp.NewLiteralExpression("?", exp.NewInsertClauses()))
+	ss.Error(err)
+}
+
+func (ss *serializeSuite) TestUnmarshal_invalid() {
+	cases := []struct {
+		json string
+		err  string
+	}{
+		{json: `{`, err: "depiq: invalid JSON at $: unexpected EOF"},
+		{json: `{"version":2,"select":{}}`, err: "depiq: invalid JSON at $.version: unsupported version 2 expected 1"},
+		{json: `{"version":1}`, err: "depiq: invalid JSON at $: missing select clauses"},
+		{
+			json: `{"version":1,"select":{"unknown":true}}`,
+			err:  `depiq: invalid JSON at $: json: unknown field "unknown"`,
+		},
+		{
+			json: `{"version":1,"select":{"where":{"type":"nope"}}}`,
+			err:  `depiq: invalid JSON at $.select.where.type: unknown expression type "nope"`,
+		},
+		{
+			json: `{"version":1,"select":{"where":{"type":"column_list"}}}`,
+			err:  `depiq: invalid JSON at $.select.where: expected an expression_list got column_list`,
+		},
+		{
+			json: `{"version":1,"select":{"where":{"type":"expression_list","op":"xor"}}}`,
+			err:  `depiq: invalid JSON at $.select.where.op: unknown expression list type "xor"`,
+		},
+		{
+			json: `{"version":1,"select":{"where":{"type":"expression_list","op":"and","expressions":[` +
+				`{"type":"boolean","op":"eq","rhs":{"kind":"int","value":1}}]}}}`,
+			err: `depiq: invalid JSON at $.select.where.expressions[0].lhs: missing expression`,
+		},
+		{
+			json: `{"version":1,"select":{"from":{"type":"column_list","expressions":[{"type":"identifier"}]}}}`,
+			err:  `depiq: invalid JSON at $.select.from.expressions[0]: empty identifier`,
+		},
+		{
+			json: `{"version":1,"select":{"from":{"type":"column_list","expressions":[` +
+				`{"type":"identifier","col":{"kind":"complex128","value":1}}]}}}`,
+			err: `depiq: invalid JSON at $.select.from.expressions[0].col.kind: unknown value kind "complex128"`,
+		},
+		{
+			json: `{"version":1,"select":{"limit":{"kind":"int","value":"a"}}}`,
+			err: "depiq: invalid JSON at $.select.limit.value: json: cannot unmarshal string into Go value " +
+				"of type int",
+		},
+		{
+			json: `{"version":1,"select":{"from":{"type":"column_list","expressions":[{"type":"select","clauses":{}}]}}}`,
+			err:  `depiq: invalid JSON at $.select.from.expressions[0]: subqueries are not supported`,
+		},
+		{
+			json: `{"version":1,"select":{"joins":[{"type":"identifier","table":"t"}]}}`,
+			err:  `depiq: invalid JSON at $.select.joins[0]: expected a join`,
+		},
+	}
+	for _, c := range cases {
+		_, err := exp.UnmarshalSelectClausesJSON([]byte(c.json), nil)
+		ss.EqualError(err, c.err, c.json)
+	}
+
+	_, err := exp.UnmarshalExpressionJSON([]byte(`{"version":1,"select":{}}`), nil)
+	ss.EqualError(err, "depiq: invalid JSON at $: missing expression")
+}
@@ -0,0 +1,131 @@
+package exp
+
+type (
+	stringExpression struct {
+		op   StringOperation
+		args []interface{}
+	}
+	collate struct {
+		collated  Expression
+		collation string
+	}
+)
+
+// Creates a new string expression, see StringExpression for the arguments of each operation
+//
+//	NewStringExpression(ConcatOp, I("first"), " ", I("last")) -> ("first" || ' ' || "last")
+//	NewStringExpression(ReplaceOp, I("name"), "a", "b") -> REPLACE("name", 'a', 'b')
+func NewStringExpression(op StringOperation, args ...interface{}) StringExpression {
+	return stringExpression{op: op, args: args}
+}
+
+func (s stringExpression) Clone() Expression {
+	args := make([]interface{}, 0, len(s.args))
+	for _, arg := range s.args {
+		if e, ok := arg.(Expression); ok {
+			args = append(args, e.Clone())
+			continue
+		}
+		args = append(args, arg)
+	}
+	return NewStringExpression(s.op, args...)
+}
+
+func (s stringExpression) Expression() Expression { return s }
+func (s stringExpression) Op() StringOperation    { return s.op }
+func (s stringExpression) Args() []interface{}    { return s.args }
+func (s stringExpression) Collate(collation string) CollateExpression {
+	return NewCollateExpression(s, collation)
+}
+func (s stringExpression) As(val interface{}) AliasedExpression         { return NewAliasExpression(s, val) }
+func (s stringExpression) Eq(val interface{}) BooleanExpression         { return eq(s, val) }
+func (s stringExpression) Neq(val interface{}) BooleanExpression        { return neq(s, val) }
+func (s stringExpression) Gt(val interface{}) BooleanExpression         { return gt(s, val) }
+func (s stringExpression) Gte(val interface{}) BooleanExpression        { return gte(s, val) }
+func (s stringExpression) Lt(val interface{}) BooleanExpression         { return lt(s, val) }
+func (s stringExpression) Lte(val interface{}) BooleanExpression        { return lte(s, val) }
+func (s stringExpression) In(i ...interface{}) BooleanExpression        { return in(s, i...) }
+func (s stringExpression) NotIn(i ...interface{}) BooleanExpression     { return notIn(s, i...) }
+func (s stringExpression) Like(val interface{}) BooleanExpression       { return like(s, val) }
+func (s stringExpression) NotLike(val interface{}) BooleanExpression    { return notLike(s, val) }
+func (s stringExpression) ILike(val interface{}) BooleanExpression      { return iLike(s, val) }
+func (s stringExpression) NotILike(val interface{}) BooleanExpression   { return notILike(s, val) }
+func (s stringExpression) RegexpLike(val interface{}) BooleanExpression { return regexpLike(s, val) }
+func (s stringExpression) RegexpNotLike(val interface{}) BooleanExpression {
+	return regexpNotLike(s, val)
+}
+func (s stringExpression) RegexpILike(val interface{}) BooleanExpression { return regexpILike(s, val) }
+func (s stringExpression) RegexpNotILike(val interface{}) BooleanExpression {
+	return regexpNotILike(s, val)
+}
+func (s stringExpression) Is(i interface{}) BooleanExpression    { return is(s, i) }
+func (s stringExpression) IsNot(i interface{}) BooleanExpression { return isNot(s, i) }
+func (s stringExpression) IsNull() BooleanExpression             { return is(s, nil) }
+func (s stringExpression) IsNotNull() BooleanExpression          { return isNot(s, nil) }
+func (s stringExpression) IsTrue() BooleanExpression             { return is(s, true) }
+func (s stringExpression) IsNotTrue() BooleanExpression          { return isNot(s, true) }
+func (s stringExpression) IsFalse() BooleanExpression            { return is(s, false) }
+func (s stringExpression) IsNotFalse() BooleanExpression         { return isNot(s, false) }
+func (s stringExpression) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(s, val)
+}
+func (s stringExpression) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(s, val)
+}
+func (s stringExpression) Asc() OrderedExpression                  { return asc(s) }
+func (s stringExpression) Desc() OrderedExpression                 { return desc(s) }
+func (s stringExpression) Between(val RangeVal) RangeExpression    { return between(s, val) }
+func (s stringExpression) NotBetween(val RangeVal) RangeExpression { return notBetween(s, val) }
+func (s stringExpression) Cast(t string) CastExpression            { return NewCastExpression(s, t) }
+
+// Creates a new expression applying the collation to the expression
+//
+//	NewCollateExpression(I("name"), "C") -> "name" COLLATE "C"
+func NewCollateExpression(collated Expression, collation string) CollateExpression {
+	return collate{collated: collated, collation: collation}
+}
+
+func (c collate) Clone() Expression {
+	return NewCollateExpression(c.collated.Clone(), c.collation)
+}
+
+func (c collate) Expression() Expression                       { return c }
+func (c collate) Collated() Expression                         { return c.collated }
+func (c collate) Collation() string                            { return c.collation }
+func (c collate) As(val interface{}) AliasedExpression         { return NewAliasExpression(c, val) }
+func (c collate) Eq(val interface{}) BooleanExpression         { return eq(c, val) }
+func (c collate) Neq(val interface{}) BooleanExpression        { return neq(c, val) }
+func (c collate) Gt(val interface{}) BooleanExpression         { return gt(c, val) }
+func (c collate) Gte(val interface{}) BooleanExpression        { return gte(c, val) }
+func (c collate) Lt(val interface{}) BooleanExpression         { return lt(c, val) }
+func (c collate) Lte(val interface{}) BooleanExpression        { return lte(c, val) }
+func (c collate) In(i ...interface{}) BooleanExpression        { return in(c, i...) }
+func (c collate) NotIn(i ...interface{}) BooleanExpression     { return notIn(c, i...) }
+func (c collate) Like(val interface{}) BooleanExpression       { return like(c, val) }
+func (c collate) NotLike(val interface{}) BooleanExpression    { return notLike(c, val) }
+func (c collate) ILike(val interface{}) BooleanExpression      { return iLike(c, val) }
+func (c collate) NotILike(val interface{}) BooleanExpression   { return notILike(c, val) }
+func (c collate) RegexpLike(val interface{}) BooleanExpression { return regexpLike(c, val) }
+func (c collate) RegexpNotLike(val interface{}) BooleanExpression {
+	return regexpNotLike(c, val)
+}
+func (c collate) RegexpILike(val interface{}) BooleanExpression { return regexpILike(c, val) }
+func (c collate) RegexpNotILike(val interface{}) BooleanExpression {
+	return regexpNotILike(c, val)
+}
+func (c collate) Is(i interface{}) BooleanExpression               { return is(c, i) }
+func (c collate) IsNot(i interface{}) BooleanExpression            { return isNot(c, i) }
+func (c collate) IsNull() BooleanExpression                        { return is(c, nil) }
+func (c collate) IsNotNull() BooleanExpression                     { return isNot(c, nil) }
+func (c collate) IsTrue() BooleanExpression                        { return is(c, true) }
+func (c collate) IsNotTrue() BooleanExpression                     { return isNot(c, true) }
+func (c collate) IsFalse() BooleanExpression                       { return is(c, false) }
+func (c collate) IsNotFalse() BooleanExpression                    { return isNot(c, false) }
+func (c collate) IsDistinctFrom(val interface{}) BooleanExpression { return isDistinctFrom(c, val) }
+func (c collate) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(c, val)
+}
+func (c collate) Asc() OrderedExpression                  { return asc(c) }
+func (c collate) Desc() OrderedExpression                 { return desc(c) }
+func (c collate) Between(val RangeVal) RangeExpression    { return between(c, val) }
+func (c collate) NotBetween(val RangeVal) RangeExpression { return notBetween(c, val) }
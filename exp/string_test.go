@@ -0,0 +1,146 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type stringExpressionSuite struct {
+	suite.Suite
+}
+
+func TestStringExpressionSuite(t *testing.T) {
+	suite.Run(t, &stringExpressionSuite{})
+}
+
+func (ses *stringExpressionSuite) TestClone() {
+	s := exp.NewStringExpression(exp.ConcatOp, exp.NewIdentifierExpression("", "", "a"), " ", 1)
+	ses.Equal(s, s.Clone())
+}
+
+func (ses *stringExpressionSuite) TestExpression() {
+	s := exp.NewStringExpression(exp.LowerOp, exp.NewIdentifierExpression("", "", "a"))
+	ses.Equal(s, s.Expression())
+}
+
+func (ses *stringExpressionSuite) TestOpAndArgs() {
+	ident := exp.NewIdentifierExpression("", "", "a")
+	s := exp.NewStringExpression(exp.SubstringOp, ident, 2, 3)
+	ses.Equal(exp.SubstringOp, s.Op())
+	ses.Equal([]interface{}{ident, 2, 3}, s.Args())
+}
+
+func (ses *stringExpressionSuite) TestAllOthers() {
+	s := exp.NewStringExpression(exp.LowerOp, exp.NewIdentifierExpression("", "", "a"))
+	rv := exp.NewRangeVal("a", "b")
+	pattern := "a%"
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: s.As("a"), Expected: exp.NewAliasExpression(s, "a")},
+		{Ex: s.Collate("C"), Expected: exp.NewCollateExpression(s, "C")},
+		{Ex: s.Eq("a"), Expected: exp.NewBooleanExpression(exp.EqOp, s, "a")},
+		{Ex: s.Neq("a"), Expected: exp.NewBooleanExpression(exp.NeqOp, s, "a")},
+		{Ex: s.Gt("a"), Expected: exp.NewBooleanExpression(exp.GtOp, s, "a")},
+		{Ex: s.Gte("a"), Expected: exp.NewBooleanExpression(exp.GteOp, s, "a")},
+		{Ex: s.Lt("a"), Expected: exp.NewBooleanExpression(exp.LtOp, s, "a")},
+		{Ex: s.Lte("a"), Expected: exp.NewBooleanExpression(exp.LteOp, s, "a")},
+		{Ex: s.In("a", "b"), Expected: exp.NewBooleanExpression(exp.InOp, s, []interface{}{"a", "b"})},
+		{Ex: s.NotIn("a", "b"), Expected: exp.NewBooleanExpression(exp.NotInOp, s, []interface{}{"a", "b"})},
+		{Ex: s.Like(pattern), Expected: exp.NewBooleanExpression(exp.LikeOp, s, pattern)},
+		{Ex: s.NotLike(pattern), Expected: exp.NewBooleanExpression(exp.NotLikeOp, s, pattern)},
+		{Ex: s.ILike(pattern), Expected: exp.NewBooleanExpression(exp.ILikeOp, s, pattern)},
+		{Ex: s.NotILike(pattern), Expected: exp.NewBooleanExpression(exp.NotILikeOp, s, pattern)},
+		{Ex: s.RegexpLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpLikeOp, s, pattern)},
+		{Ex: s.RegexpNotLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotLikeOp, s, pattern)},
+		{Ex: s.RegexpILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpILikeOp, s, pattern)},
+		{Ex: s.RegexpNotILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotILikeOp, s, pattern)},
+		{Ex: s.Is(nil), Expected: exp.NewBooleanExpression(exp.IsOp, s, nil)},
+		{Ex: s.IsNot(nil), Expected: exp.NewBooleanExpression(exp.IsNotOp, s, nil)},
+		{Ex: s.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, s, nil)},
+		{Ex: s.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, s, nil)},
+		{Ex: s.IsTrue(), Expected: exp.NewBooleanExpression(exp.IsOp, s, true)},
+		{Ex: s.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, s, true)},
+		{Ex: s.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, s, false)},
+		{Ex: s.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, s, false)},
+		{Ex: s.IsDistinctFrom("a"), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, s, "a")},
+		{Ex: s.IsNotDistinctFrom("a"), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, s, "a")},
+		{Ex: s.Asc(), Expected: exp.NewOrderedExpression(s, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: s.Desc(), Expected: exp.NewOrderedExpression(s, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: s.Between(rv), Expected: exp.NewRangeExpression(exp.BetweenOp, s, rv)},
+		{Ex: s.NotBetween(rv), Expected: exp.NewRangeExpression(exp.NotBetweenOp, s, rv)},
+		{Ex: s.Cast("TEXT"), Expected: exp.NewCastExpression(s, "TEXT")},
+	}
+
+	for _, tc := range testCases {
+		ses.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+type collateExpressionSuite struct {
+	suite.Suite
+}
+
+func TestCollateExpressionSuite(t *testing.T) {
+	suite.Run(t, &collateExpressionSuite{})
+}
+
+func (ces *collateExpressionSuite) TestClone() {
+	c := exp.NewCollateExpression(exp.NewIdentifierExpression("", "", "a"), "C")
+	ces.Equal(c, c.Clone())
+}
+
+func (ces *collateExpressionSuite) TestExpression() {
+	c := exp.NewCollateExpression(exp.NewIdentifierExpression("", "", "a"), "C")
+	ces.Equal(c, c.Expression())
+}
+
+func (ces *collateExpressionSuite) TestCollatedAndCollation() {
+	ident := exp.NewIdentifierExpression("", "", "a")
+	c := exp.NewCollateExpression(ident, "C")
+	ces.Equal(ident, c.Collated())
+	ces.Equal("C", c.Collation())
+}
+
+func (ces *collateExpressionSuite) TestAllOthers() {
+	c := exp.NewCollateExpression(exp.NewIdentifierExpression("", "", "a"), "C")
+	rv := exp.NewRangeVal("a", "b")
+	pattern := "a%"
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: c.As("a"), Expected: exp.NewAliasExpression(c, "a")},
+		{Ex: c.Eq("a"), Expected: exp.NewBooleanExpression(exp.EqOp, c, "a")},
+		{Ex: c.Neq("a"), Expected: exp.NewBooleanExpression(exp.NeqOp, c, "a")},
+		{Ex: c.Gt("a"), Expected: exp.NewBooleanExpression(exp.GtOp, c, "a")},
+		{Ex: c.Gte("a"), Expected: exp.NewBooleanExpression(exp.GteOp, c, "a")},
+		{Ex: c.Lt("a"), Expected: exp.NewBooleanExpression(exp.LtOp, c, "a")},
+		{Ex: c.Lte("a"), Expected: exp.NewBooleanExpression(exp.LteOp, c, "a")},
+		{Ex: c.In("a", "b"), Expected: exp.NewBooleanExpression(exp.InOp, c, []interface{}{"a", "b"})},
+		{Ex: c.NotIn("a", "b"), Expected: exp.NewBooleanExpression(exp.NotInOp, c, []interface{}{"a", "b"})},
+		{Ex: c.Like(pattern), Expected: exp.NewBooleanExpression(exp.LikeOp, c, pattern)},
+		{Ex: c.NotLike(pattern), Expected: exp.NewBooleanExpression(exp.NotLikeOp, c, pattern)},
+		{Ex: c.ILike(pattern), Expected: exp.NewBooleanExpression(exp.ILikeOp, c, pattern)},
+		{Ex: c.NotILike(pattern), Expected: exp.NewBooleanExpression(exp.NotILikeOp, c, pattern)},
+		{Ex: c.RegexpLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpLikeOp, c, pattern)},
+		{Ex: c.RegexpNotLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotLikeOp, c, pattern)},
+		{Ex: c.RegexpILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpILikeOp, c, pattern)},
+		{Ex: c.RegexpNotILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotILikeOp, c, pattern)},
+		{Ex: c.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, c, nil)},
+		{Ex: c.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, c, nil)},
+		{Ex: c.IsDistinctFrom("a"), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, c, "a")},
+		{Ex: c.IsNotDistinctFrom("a"), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, c, "a")},
+		{Ex: c.Asc(), Expected: exp.NewOrderedExpression(c, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: c.Desc(), Expected: exp.NewOrderedExpression(c, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: c.Between(rv), Expected: exp.NewRangeExpression(exp.BetweenOp, c, rv)},
+		{Ex: c.NotBetween(rv), Expected: exp.NewRangeExpression(exp.NotBetweenOp, c, rv)},
+	}
+
+	for _, tc := range testCases {
+		ces.Equal(tc.Expected, tc.Ex)
+	}
+}
@@ -0,0 +1,64 @@
+package exp
+
+type textSearch struct {
+	searchType TextSearchType
+	documents  []Expression
+	query      interface{}
+	mode       TextSearchMode
+	config     string
+}
+
+// Creates a new full-text search expression using the NaturalLanguageTextSearchMode and the default configuration
+//
+//	NewTextSearchExpression(TextSearchMatchType, []Expression{I("title"), I("body")}, "cats")
+func NewTextSearchExpression(
+	searchType TextSearchType,
+	documents []Expression,
+	query interface{},
+) TextSearchExpression {
+	return textSearch{searchType: searchType, documents: documents, query: query, mode: NaturalLanguageTextSearchMode}
+}
+
+func (ts textSearch) Clone() Expression {
+	documents := make([]Expression, 0, len(ts.documents))
+	for _, d := range ts.documents {
+		documents = append(documents, d.Clone())
+	}
+	query := ts.query
+	if e, ok := query.(Expression); ok {
+		query = e.Clone()
+	}
+	return textSearch{searchType: ts.searchType, documents: documents, query: query, mode: ts.mode, config: ts.config}
+}
+
+func (ts textSearch) Expression() Expression  { return ts }
+func (ts textSearch) Type() TextSearchType    { return ts.searchType }
+func (ts textSearch) Documents() []Expression { return ts.documents }
+func (ts textSearch) Query() interface{}      { return ts.query }
+func (ts textSearch) Mode() TextSearchMode    { return ts.mode }
+func (ts textSearch) Config() string          { return ts.config }
+
+func (ts textSearch) WithConfig(config string) TextSearchExpression {
+	ts.config = config
+	return ts
+}
+
+func (ts textSearch) WithMode(mode TextSearchMode) TextSearchExpression {
+	ts.mode = mode
+	return ts
+}
+
+func (ts textSearch) Rank() TextSearchExpression {
+	ts.searchType = TextSearchRankType
+	return ts
+}
+
+func (ts textSearch) As(val interface{}) AliasedExpression  { return NewAliasExpression(ts, val) }
+func (ts textSearch) Eq(val interface{}) BooleanExpression  { return eq(ts, val) }
+func (ts textSearch) Neq(val interface{}) BooleanExpression { return neq(ts, val) }
+func (ts textSearch) Gt(val interface{}) BooleanExpression  { return gt(ts, val) }
+func (ts textSearch) Gte(val interface{}) BooleanExpression { return gte(ts, val) }
+func (ts textSearch) Lt(val interface{}) BooleanExpression  { return lt(ts, val) }
+func (ts textSearch) Lte(val interface{}) BooleanExpression { return lte(ts, val) }
+func (ts textSearch) Asc() OrderedExpression                { return asc(ts) }
+func (ts textSearch) Desc() OrderedExpression               { return desc(ts) }
@@ -0,0 +1,81 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+var textSearchDocs = []exp.Expression{exp.NewIdentifierExpression("", "", "body")}
+
+type textSearchExpressionSuite struct {
+	suite.Suite
+}
+
+func TestTextSearchExpressionSuite(t *testing.T) {
+	suite.Run(t, &textSearchExpressionSuite{})
+}
+
+func (tses *textSearchExpressionSuite) TestClone() {
+	ts := exp.NewTextSearchExpression(exp.TextSearchMatchType, textSearchDocs, "cats").WithConfig("english")
+	tses.Equal(ts, ts.Clone())
+}
+
+func (tses *textSearchExpressionSuite) TestExpression() {
+	ts := exp.NewTextSearchExpression(exp.TextSearchMatchType, textSearchDocs, "a")
+	tses.Equal(ts, ts.Expression())
+}
+
+func (tses *textSearchExpressionSuite) TestDefaults() {
+	docs := []exp.Expression{exp.NewIdentifierExpression("", "", "title"), exp.NewIdentifierExpression("", "", "body")}
+	ts := exp.NewTextSearchExpression(exp.TextSearchMatchType, docs, "cats")
+	tses.Equal(exp.TextSearchMatchType, ts.Type())
+	tses.Equal(docs, ts.Documents())
+	tses.Equal("cats", ts.Query())
+	tses.Equal(exp.NaturalLanguageTextSearchMode, ts.Mode())
+	tses.Equal("", ts.Config())
+}
+
+func (tses *textSearchExpressionSuite) TestWithConfig() {
+	ts := exp.NewTextSearchExpression(exp.TextSearchMatchType, textSearchDocs, "a")
+	tses.Equal("english", ts.WithConfig("english").Config())
+	tses.Equal("", ts.Config())
+}
+
+func (tses *textSearchExpressionSuite) TestWithMode() {
+	ts := exp.NewTextSearchExpression(exp.TextSearchMatchType, textSearchDocs, "a")
+	tses.Equal(exp.BooleanTextSearchMode, ts.WithMode(exp.BooleanTextSearchMode).Mode())
+	tses.Equal(exp.NaturalLanguageTextSearchMode, ts.Mode())
+}
+
+func (tses *textSearchExpressionSuite) TestRank() {
+	ts := exp.NewTextSearchExpression(exp.TextSearchMatchType, textSearchDocs, "a").WithConfig("english")
+	tses.Equal(
+		exp.NewTextSearchExpression(exp.TextSearchRankType, textSearchDocs, "a").WithConfig("english"),
+		ts.Rank(),
+	)
+	tses.Equal(exp.TextSearchMatchType, ts.Type())
+}
+
+func (tses *textSearchExpressionSuite) TestAllOthers() {
+	ts := exp.NewTextSearchExpression(exp.TextSearchRankType, textSearchDocs, "a")
+	cases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: ts.As("rank"), Expected: exp.NewAliasExpression(ts, "rank")},
+		{Ex: ts.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, ts, 1)},
+		{Ex: ts.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, ts, 1)},
+		{Ex: ts.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, ts, 1)},
+		{Ex: ts.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, ts, 1)},
+		{Ex: ts.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, ts, 1)},
+		{Ex: ts.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, ts, 1)},
+		{Ex: ts.Asc(), Expected: exp.NewOrderedExpression(ts, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: ts.Desc(), Expected: exp.NewOrderedExpression(ts, exp.DescSortDir, exp.NoNullsSortType)},
+	}
+
+	for _, tc := range cases {
+		tses.Equal(tc.Expected, tc.Ex)
+	}
+}
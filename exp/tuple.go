@@ -0,0 +1,38 @@
+package exp
+
+type tuple struct {
+	values []interface{}
+}
+
+// Creates a new row value expression
+//
+//	NewTupleExpression(I("a"), I("b")) -> ("a", "b")
+func NewTupleExpression(vals ...interface{}) TupleExpression {
+	return tuple{values: vals}
+}
+
+func (t tuple) Clone() Expression {
+	vals := make([]interface{}, 0, len(t.values))
+	for _, v := range t.values {
+		if e, ok := v.(Expression); ok {
+			vals = append(vals, e.Clone())
+		} else {
+			vals = append(vals, v)
+		}
+	}
+	return NewTupleExpression(vals...)
+}
+
+func (t tuple) Expression() Expression { return t }
+func (t tuple) Values() []interface{}  { return t.values }
+
+// The equality operators do not go through checkBoolExpType because a slice on the right hand side is the row value
+// being compared against and not an IN list.
+func (t tuple) Eq(val interface{}) BooleanExpression        { return NewBooleanExpression(EqOp, t, val) }
+func (t tuple) Neq(val interface{}) BooleanExpression       { return NewBooleanExpression(NeqOp, t, val) }
+func (t tuple) Gt(val interface{}) BooleanExpression        { return gt(t, val) }
+func (t tuple) Gte(val interface{}) BooleanExpression       { return gte(t, val) }
+func (t tuple) Lt(val interface{}) BooleanExpression        { return lt(t, val) }
+func (t tuple) Lte(val interface{}) BooleanExpression       { return lte(t, val) }
+func (t tuple) In(vals ...interface{}) BooleanExpression    { return in(t, vals...) }
+func (t tuple) NotIn(vals ...interface{}) BooleanExpression { return notIn(t, vals...) }
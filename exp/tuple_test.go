@@ -0,0 +1,53 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type tupleExpressionSuite struct {
+	suite.Suite
+}
+
+func TestTupleExpressionSuite(t *testing.T) {
+	suite.Run(t, &tupleExpressionSuite{})
+}
+
+func (tes *tupleExpressionSuite) TestClone() {
+	t := exp.NewTupleExpression(exp.NewIdentifierExpression("", "", "a"), 1)
+	tes.Equal(exp.NewTupleExpression(exp.NewIdentifierExpression("", "", "a"), 1), t.Clone())
+}
+
+func (tes *tupleExpressionSuite) TestExpression() {
+	t := exp.NewTupleExpression(exp.NewIdentifierExpression("", "", "a"), 1)
+	tes.Equal(t, t.Expression())
+}
+
+func (tes *tupleExpressionSuite) TestValues() {
+	t := exp.NewTupleExpression(exp.NewIdentifierExpression("", "", "a"), 1)
+	tes.Equal([]interface{}{exp.NewIdentifierExpression("", "", "a"), 1}, t.Values())
+}
+
+func (tes *tupleExpressionSuite) TestAllOthers() {
+	t := exp.NewTupleExpression(exp.NewIdentifierExpression("", "", "a"), exp.NewIdentifierExpression("", "", "b"))
+	rv := []interface{}{1, 2}
+	cases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: t.Eq(rv), Expected: exp.NewBooleanExpression(exp.EqOp, t, rv)},
+		{Ex: t.Neq(rv), Expected: exp.NewBooleanExpression(exp.NeqOp, t, rv)},
+		{Ex: t.Gt(rv), Expected: exp.NewBooleanExpression(exp.GtOp, t, rv)},
+		{Ex: t.Gte(rv), Expected: exp.NewBooleanExpression(exp.GteOp, t, rv)},
+		{Ex: t.Lt(rv), Expected: exp.NewBooleanExpression(exp.LtOp, t, rv)},
+		{Ex: t.Lte(rv), Expected: exp.NewBooleanExpression(exp.LteOp, t, rv)},
+		{Ex: t.In([][]int{{1, 2}}), Expected: exp.NewBooleanExpression(exp.InOp, t, [][]int{{1, 2}})},
+		{Ex: t.NotIn([][]int{{1, 2}}), Expected: exp.NewBooleanExpression(exp.NotInOp, t, [][]int{{1, 2}})},
+	}
+
+	for _, tc := range cases {
+		tes.Equal(tc.Expected, tc.Ex)
+	}
+}
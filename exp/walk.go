@@ -0,0 +1,838 @@
+package exp
+
+import (
+	"sort"
+	"strings"
+)
+
+type (
+	// Called by Walk for every Expression in a tree. When false is returned the children of the Expression are not
+	// visited.
+	Visitor func(e Expression) bool
+	// Called by Rewrite for every Expression in a tree after the children of the Expression have been rewritten. The
+	// returned Expression replaces the original one.
+	Rewriter func(e Expression) Expression
+
+	// An Expression built from SelectClauses (e.g. depiq.SelectDataset) that can be walked and rewritten
+	SelectClausesExpression interface {
+		AppendableExpression
+		GetClauses() SelectClauses
+		// Returns a copy of the expression using the clauses
+		WithClauses(clauses SelectClauses) Expression
+	}
+	// An Expression built from InsertClauses (e.g. depiq.InsertDataset) that can be walked and rewritten
+	InsertClausesExpression interface {
+		AppendableExpression
+		GetClauses() InsertClauses
+		// Returns a copy of the expression using the clauses
+		WithClauses(clauses InsertClauses) Expression
+	}
+	// An Expression built from UpdateClauses (e.g. depiq.UpdateDataset) that can be walked and rewritten
+	UpdateClausesExpression interface {
+		AppendableExpression
+		GetClauses() UpdateClauses
+		// Returns a copy of the expression using the clauses
+		WithClauses(clauses UpdateClauses) Expression
+	}
+	// An Expression built from DeleteClauses (e.g. depiq.DeleteDataset) that can be walked and rewritten
+	DeleteClausesExpression interface {
+		AppendableExpression
+		GetClauses() DeleteClauses
+		// Returns a copy of the expression using the clauses
+		WithClauses(clauses DeleteClauses) Expression
+	}
+	// An Expression built from TruncateClauses (e.g. depiq.TruncateDataset) that can be walked and rewritten
+	TruncateClausesExpression interface {
+		Expression
+		GetClauses() TruncateClauses
+		// Returns a copy of the expression using the clauses
+		WithClauses(clauses TruncateClauses) Expression
+	}
+)
+
+// Walks an Expression tree depth first calling the visitor for every Expression before its children. Datasets are
+// walked through their clauses so subqueries used in CTEs, FROM, JOIN, LATERAL, EXISTS, IN and compound statements
+// are visited too.
+//
+// Names introduced by an expression (aliases, CTE names, window names and cast types) are not visited. Ex and ExOr
+// maps are visited as their ExpressionList equivalent, values stored in structs (e.g. Insert rows) are not visited.
+//
+//	exp.Walk(ds, func(e exp.Expression) bool {
+//		if i, ok := e.(exp.IdentifierExpression); ok {
+//			fmt.Println(i.GetCol())
+//		}
+//		return true
+//	})
+func Walk(e Expression, v Visitor) {
+	if e == nil || !v(e) {
+		return
+	}
+	switch t := e.(type) {
+	case SelectClausesExpression:
+		WalkSelectClauses(t.GetClauses(), v)
+	case InsertClausesExpression:
+		WalkInsertClauses(t.GetClauses(), v)
+	case UpdateClausesExpression:
+		WalkUpdateClauses(t.GetClauses(), v)
+	case DeleteClausesExpression:
+		WalkDeleteClauses(t.GetClauses(), v)
+	case TruncateClausesExpression:
+		WalkTruncateClauses(t.GetClauses(), v)
+	case Ex, ExOr:
+		if el, err := t.(expressionListable).ToExpressions(); err == nil {
+			Walk(el, v)
+		}
+	case aliasExpression:
+		Walk(t.aliased, v)
+	case arithmetic:
+		walkExpression(t.lhs, v)
+		walkValue(t.rhs, v)
+	case bitwise:
+		walkExpression(t.lhs, v)
+		walkValue(t.rhs, v)
+	case boolean:
+		walkExpression(t.lhs, v)
+		walkValue(t.rhs, v)
+	case jsonExpression:
+		walkExpression(t.lhs, v)
+		walkValue(t.rhs, v)
+	case ranged:
+		walkExpression(t.lhs, v)
+		walkValue(t.rhs, v)
+	case array:
+		walkValue(t.elements, v)
+	case caseExpression:
+		walkValue(t.value, v)
+		for _, w := range t.whens {
+			walkValue(w.Condition(), v)
+			walkValue(w.Result(), v)
+		}
+		if t.elseCondition != nil {
+			walkValue(t.elseCondition.Result(), v)
+		}
+	case cast:
+		Walk(t.casted, v)
+	case columnList:
+		for _, c := range t.columns {
+			Walk(c, v)
+		}
+	case expressionList:
+		for _, c := range t.expressions {
+			Walk(c, v)
+		}
+	case compound:
+		walkExpression(t.rhs, v)
+	case commonExpr:
+		Walk(t.subQuery, v)
+	case *conflictUpdate:
+		walkValue(t.update, v)
+		walkExpression(t.whereClause, v)
+	case exists:
+		walkExpression(t.subquery, v)
+	case quantified:
+		walkValue(t.value, v)
+	case sqlFunctionExpression:
+		walkValue(t.args, v)
+		walkExpression(t.filter, v)
+		walkExpression(t.orderCols, v)
+		walkExpression(t.withinGroupCols, v)
+	case grouping:
+		for _, s := range t.sets {
+			walkExpression(s, v)
+		}
+	case *insert:
+		walkExpression(t.from, v)
+		walkExpression(t.cols, v)
+		walkValue(t.vals, v)
+	case joinExpression:
+		Walk(t.table, v)
+	case conditionedJoin:
+		Walk(t.table, v)
+		walkJoinCondition(t.condition, v)
+	case lateral:
+		walkExpression(t.table, v)
+	case literal:
+		walkValue(t.args, v)
+	case orderedExpression:
+		Walk(t.sortExpression, v)
+	case tuple:
+		walkValue(t.values, v)
+	case sqlWindowExpression:
+		walkExpression(t.partitionCols, v)
+		walkExpression(t.orderCols, v)
+		walkExpression(t.frame, v)
+	case windowFrame:
+		walkExpression(t.start, v)
+		walkExpression(t.end, v)
+	case windowFrameBound:
+		walkValue(t.offset, v)
+	case sqlWindowFunctionExpression:
+		walkExpression(t.fn, v)
+		walkExpression(t.window, v)
+	case dateArithmetic:
+		walkExpression(t.lhs, v)
+		walkExpression(t.interval, v)
+	case extract:
+		Walk(t.source, v)
+	case dateTrunc:
+		Walk(t.source, v)
+	case stringExpression:
+		walkValue(t.args, v)
+	case collate:
+		Walk(t.collated, v)
+	case textSearch:
+		for _, d := range t.documents {
+			Walk(d, v)
+		}
+		walkValue(t.query, v)
+	}
+}
+
+// Walks every Expression in the SelectClauses in the order they appear in a SELECT statement. See Walk
+func WalkSelectClauses(c SelectClauses, v Visitor) {
+	for _, cte := range c.CommonTables() {
+		walkExpression(cte, v)
+	}
+	walkExpression(c.Select(), v)
+	walkExpression(c.Distinct(), v)
+	walkExpression(c.From(), v)
+	for _, j := range c.Joins() {
+		walkExpression(j, v)
+	}
+	walkExpression(c.Where(), v)
+	walkExpression(c.GroupBy(), v)
+	walkExpression(c.Having(), v)
+	for _, w := range c.Windows() {
+		walkExpression(w, v)
+	}
+	for _, ce := range c.Compounds() {
+		walkExpression(ce, v)
+	}
+	walkExpression(c.Order(), v)
+	walkValue(c.Limit(), v)
+	if l := c.Lock(); l != nil {
+		for _, of := range l.Of() {
+			walkExpression(of, v)
+		}
+	}
+}
+
+// Walks every Expression in the InsertClauses in the order they appear in an INSERT statement. See Walk
+func WalkInsertClauses(c InsertClauses, v Visitor) {
+	for _, cte := range c.CommonTables() {
+		walkExpression(cte, v)
+	}
+	walkExpression(c.Into(), v)
+	walkExpression(c.Cols(), v)
+	walkValue(c.Rows(), v)
+	walkValue(c.Vals(), v)
+	walkExpression(c.From(), v)
+	walkExpression(c.OnConflict(), v)
+	walkExpression(c.Returning(), v)
+}
+
+// Walks every Expression in the UpdateClauses in the order they appear in an UPDATE statement. See Walk
+func WalkUpdateClauses(c UpdateClauses, v Visitor) {
+	for _, cte := range c.CommonTables() {
+		walkExpression(cte, v)
+	}
+	walkExpression(c.Table(), v)
+	walkValue(c.SetValues(), v)
+	walkExpression(c.From(), v)
+	walkExpression(c.Where(), v)
+	walkExpression(c.Order(), v)
+	walkValue(c.Limit(), v)
+	walkExpression(c.Returning(), v)
+}
+
+// Walks every Expression in the DeleteClauses in the order they appear in a DELETE statement. See Walk
+func WalkDeleteClauses(c DeleteClauses, v Visitor) {
+	for _, cte := range c.CommonTables() {
+		walkExpression(cte, v)
+	}
+	walkExpression(c.From(), v)
+	walkExpression(c.Where(), v)
+	walkExpression(c.Order(), v)
+	walkValue(c.Limit(), v)
+	walkExpression(c.Returning(), v)
+}
+
+// Walks every Expression in the TruncateClauses. See Walk
+func WalkTruncateClauses(c TruncateClauses, v Visitor) {
+	walkExpression(c.Table(), v)
+}
+
+// Rewrites an Expression tree bottom up. The Rewriter is called for every Expression after its children have been
+// rewritten and the returned Expression replaces the original one, return the Expression unchanged to keep it. The
+// original tree is never modified.
+//
+// Rewrite visits the same expressions as Walk. If the Rewriter returns an Expression that cannot be used in the
+// position of the original one (e.g. a BooleanExpression in place of a JOIN table list) the original Expression is
+// kept.
+//
+//	exp.Rewrite(ds, func(e exp.Expression) exp.Expression {
+//		if i, ok := e.(exp.IdentifierExpression); ok && i.GetCol() == "old_name" {
+//			return i.Col("new_name")
+//		}
+//		return e
+//	})
+func Rewrite(e Expression, fn Rewriter) Expression {
+	if e == nil {
+		return nil
+	}
+	return fn(rewriteChildren(e, fn))
+}
+
+func rewriteChildren(e Expression, fn Rewriter) Expression {
+	switch t := e.(type) {
+	case SelectClausesExpression:
+		return t.WithClauses(RewriteSelectClauses(t.GetClauses(), fn))
+	case InsertClausesExpression:
+		return t.WithClauses(RewriteInsertClauses(t.GetClauses(), fn))
+	case UpdateClausesExpression:
+		return t.WithClauses(RewriteUpdateClauses(t.GetClauses(), fn))
+	case DeleteClausesExpression:
+		return t.WithClauses(RewriteDeleteClauses(t.GetClauses(), fn))
+	case TruncateClausesExpression:
+		return t.WithClauses(RewriteTruncateClauses(t.GetClauses(), fn))
+	case Ex, ExOr:
+		if el, err := t.(expressionListable).ToExpressions(); err == nil {
+			return rewriteChildren(el, fn)
+		}
+	case aliasExpression:
+		t.aliased = Rewrite(t.aliased, fn)
+		return t
+	case arithmetic:
+		t.lhs = Rewrite(t.lhs, fn)
+		t.rhs = rewriteValue(t.rhs, fn)
+		return t
+	case bitwise:
+		t.lhs = Rewrite(t.lhs, fn)
+		t.rhs = rewriteValue(t.rhs, fn)
+		return t
+	case boolean:
+		t.lhs = Rewrite(t.lhs, fn)
+		t.rhs = rewriteValue(t.rhs, fn)
+		return t
+	case jsonExpression:
+		t.lhs = Rewrite(t.lhs, fn)
+		t.rhs = rewriteValue(t.rhs, fn)
+		return t
+	case ranged:
+		t.lhs = Rewrite(t.lhs, fn)
+		if rv, ok := rewriteValue(t.rhs, fn).(RangeVal); ok {
+			t.rhs = rv
+		}
+		return t
+	case array:
+		t.elements = rewriteValue(t.elements, fn)
+		return t
+	case caseExpression:
+		t.value = rewriteValue(t.value, fn)
+		whens := make([]CaseWhen, 0, len(t.whens))
+		for _, w := range t.whens {
+			whens = append(whens, NewCaseWhen(rewriteValue(w.Condition(), fn), rewriteValue(w.Result(), fn)))
+		}
+		t.whens = whens
+		if t.elseCondition != nil {
+			t.elseCondition = NewCaseElse(rewriteValue(t.elseCondition.Result(), fn))
+		}
+		return t
+	case cast:
+		t.casted = Rewrite(t.casted, fn)
+		return t
+	case columnList:
+		t.columns = rewriteExpressions(t.columns, fn)
+		return t
+	case expressionList:
+		t.expressions = rewriteExpressions(t.expressions, fn)
+		return t
+	case compound:
+		t.rhs = rewriteAppendable(t.rhs, fn)
+		return t
+	case commonExpr:
+		t.subQuery = Rewrite(t.subQuery, fn)
+		return t
+	case *conflictUpdate:
+		return &conflictUpdate{
+			target:      t.target,
+			update:      rewriteValue(t.update, fn),
+			whereClause: rewriteExpressionList(t.whereClause, fn),
+		}
+	case exists:
+		t.subquery = rewriteAppendable(t.subquery, fn)
+		return t
+	case quantified:
+		t.value = rewriteValue(t.value, fn)
+		return t
+	case sqlFunctionExpression:
+		t.args = rewriteValues(t.args, fn)
+		t.filter = rewriteExpressionList(t.filter, fn)
+		t.orderCols = rewriteColumnList(t.orderCols, fn)
+		t.withinGroupCols = rewriteColumnList(t.withinGroupCols, fn)
+		return t
+	case grouping:
+		sets := make([]ColumnListExpression, 0, len(t.sets))
+		for _, s := range t.sets {
+			sets = append(sets, rewriteColumnList(s, fn))
+		}
+		t.sets = sets
+		return t
+	case *insert:
+		vals := make([][]interface{}, 0, len(t.vals))
+		for _, row := range t.vals {
+			vals = append(vals, rewriteValues(row, fn))
+		}
+		return &insert{from: rewriteAppendable(t.from, fn), cols: rewriteColumnList(t.cols, fn), vals: vals}
+	case joinExpression:
+		t.table = Rewrite(t.table, fn)
+		return t
+	case conditionedJoin:
+		t.table = Rewrite(t.table, fn)
+		t.condition = rewriteJoinCondition(t.condition, fn)
+		return t
+	case lateral:
+		t.table = rewriteAppendable(t.table, fn)
+		return t
+	case literal:
+		t.args = rewriteValues(t.args, fn)
+		return t
+	case orderedExpression:
+		t.sortExpression = Rewrite(t.sortExpression, fn)
+		return t
+	case tuple:
+		t.values = rewriteValues(t.values, fn)
+		return t
+	case sqlWindowExpression:
+		t.partitionCols = rewriteColumnList(t.partitionCols, fn)
+		t.orderCols = rewriteColumnList(t.orderCols, fn)
+		if t.frame != nil {
+			if f, ok := Rewrite(t.frame, fn).(WindowFrameExpression); ok {
+				t.frame = f
+			}
+		}
+		return t
+	case windowFrame:
+		t.start = rewriteWindowFrameBound(t.start, fn)
+		t.end = rewriteWindowFrameBound(t.end, fn)
+		return t
+	case windowFrameBound:
+		t.offset = rewriteValue(t.offset, fn)
+		return t
+	case sqlWindowFunctionExpression:
+		if f, ok := Rewrite(t.fn, fn).(SQLFunctionExpression); ok {
+			t.fn = f
+		}
+		if t.window != nil {
+			if w, ok := Rewrite(t.window, fn).(WindowExpression); ok {
+				t.window = w
+			}
+		}
+		return t
+	case dateArithmetic:
+		t.lhs = Rewrite(t.lhs, fn)
+		if i, ok := Rewrite(t.interval, fn).(IntervalExpression); ok {
+			t.interval = i
+		}
+		return t
+	case extract:
+		t.source = Rewrite(t.source, fn)
+		return t
+	case dateTrunc:
+		t.source = Rewrite(t.source, fn)
+		return t
+	case stringExpression:
+		t.args = rewriteValues(t.args, fn)
+		return t
+	case collate:
+		t.collated = Rewrite(t.collated, fn)
+		return t
+	case textSearch:
+		t.documents = rewriteExpressions(t.documents, fn)
+		t.query = rewriteValue(t.query, fn)
+		return t
+	}
+	return e
+}
+
+// Rewrites every Expression in the SelectClauses and returns the rewritten clauses. See Rewrite
+func RewriteSelectClauses(c SelectClauses, fn Rewriter) SelectClauses {
+	ret := c.clone()
+	ret.commonTables = rewriteCommonTables(c.CommonTables(), fn)
+	ret.selectColumns = rewriteColumnList(c.Select(), fn)
+	ret.distinct = rewriteColumnList(c.Distinct(), fn)
+	ret.from = rewriteColumnList(c.From(), fn)
+	if joins := c.Joins(); joins != nil {
+		ret.joins = make(JoinExpressions, 0, len(joins))
+		for _, j := range joins {
+			if rj, ok := Rewrite(j, fn).(JoinExpression); ok {
+				j = rj
+			}
+			ret.joins = append(ret.joins, j)
+		}
+	}
+	ret.where = rewriteExpressionList(c.Where(), fn)
+	ret.groupBy = rewriteColumnList(c.GroupBy(), fn)
+	ret.having = rewriteExpressionList(c.Having(), fn)
+	if windows := c.Windows(); windows != nil {
+		ret.windows = make([]WindowExpression, 0, len(windows))
+		for _, w := range windows {
+			if rw, ok := Rewrite(w, fn).(WindowExpression); ok {
+				w = rw
+			}
+			ret.windows = append(ret.windows, w)
+		}
+	}
+	if compounds := c.Compounds(); compounds != nil {
+		ret.compounds = make([]CompoundExpression, 0, len(compounds))
+		for _, ce := range compounds {
+			if rce, ok := Rewrite(ce, fn).(CompoundExpression); ok {
+				ce = rce
+			}
+			ret.compounds = append(ret.compounds, ce)
+		}
+	}
+	ret.order = rewriteColumnList(c.Order(), fn)
+	ret.limit = rewriteValue(c.Limit(), fn)
+	if l := c.Lock(); l != nil && len(l.Of()) > 0 {
+		of := make([]IdentifierExpression, 0, len(l.Of()))
+		for _, i := range l.Of() {
+			of = append(of, rewriteIdentifier(i, fn))
+		}
+		ret.lock = NewLock(l.Strength(), l.WaitOption(), of...)
+	}
+	return ret
+}
+
+// Rewrites every Expression in the InsertClauses and returns the rewritten clauses. See Rewrite
+func RewriteInsertClauses(c InsertClauses, fn Rewriter) InsertClauses {
+	ret := c.clone()
+	ret.commonTables = rewriteCommonTables(c.CommonTables(), fn)
+	ret.into = Rewrite(c.Into(), fn)
+	ret.cols = rewriteColumnList(c.Cols(), fn)
+	if rows := c.Rows(); rows != nil {
+		ret.rows = rewriteValues(rows, fn)
+	}
+	if vals := c.Vals(); vals != nil {
+		ret.values = make([][]interface{}, 0, len(vals))
+		for _, row := range vals {
+			ret.values = append(ret.values, rewriteValues(row, fn))
+		}
+	}
+	ret.from = rewriteAppendable(c.From(), fn)
+	if conflict := c.OnConflict(); conflict != nil {
+		if rc, ok := Rewrite(conflict, fn).(ConflictExpression); ok {
+			ret.conflict = rc
+		}
+	}
+	ret.returning = rewriteColumnList(c.Returning(), fn)
+	return ret
+}
+
+// Rewrites every Expression in the UpdateClauses and returns the rewritten clauses. See Rewrite
+func RewriteUpdateClauses(c UpdateClauses, fn Rewriter) UpdateClauses {
+	ret := c.clone()
+	ret.commonTables = rewriteCommonTables(c.CommonTables(), fn)
+	ret.table = Rewrite(c.Table(), fn)
+	ret.setValues = rewriteValue(c.SetValues(), fn)
+	ret.from = rewriteColumnList(c.From(), fn)
+	ret.where = rewriteExpressionList(c.Where(), fn)
+	ret.order = rewriteColumnList(c.Order(), fn)
+	ret.limit = rewriteValue(c.Limit(), fn)
+	ret.returning = rewriteColumnList(c.Returning(), fn)
+	return ret
+}
+
+// Rewrites every Expression in the DeleteClauses and returns the rewritten clauses. See Rewrite
+func RewriteDeleteClauses(c DeleteClauses, fn Rewriter) DeleteClauses {
+	ret := c.clone()
+	ret.commonTables = rewriteCommonTables(c.CommonTables(), fn)
+	ret.from = rewriteIdentifier(c.From(), fn)
+	ret.where = rewriteExpressionList(c.Where(), fn)
+	ret.order = rewriteColumnList(c.Order(), fn)
+	ret.limit = rewriteValue(c.Limit(), fn)
+	ret.returning = rewriteColumnList(c.Returning(), fn)
+	return ret
+}
+
+// Rewrites every Expression in the TruncateClauses and returns the rewritten clauses. See Rewrite
+func RewriteTruncateClauses(c TruncateClauses, fn Rewriter) TruncateClauses {
+	ret := c.clone()
+	ret.tables = rewriteColumnList(c.Table(), fn)
+	return ret
+}
+
+// Returns the tables read or written by the Expression including the tables used in subqueries. Tables are returned
+// in the order they are first encountered as table identifiers (e.g. "schema"."table"), references to a CTE are not
+// included.
+//
+//	exp.ReferencedTables(ds) // []IdentifierExpression{T("users"), T("orders")}
+func ReferencedTables(e Expression) []IdentifierExpression {
+	cteNames := map[string]bool{}
+	Walk(e, func(e Expression) bool {
+		if cte, ok := e.(CommonTableExpression); ok {
+			name := cte.Name().Literal()
+			if i := strings.IndexRune(name, '('); i >= 0 {
+				name = name[:i]
+			}
+			cteNames[strings.TrimSpace(name)] = true
+		}
+		return true
+	})
+	var tables []IdentifierExpression
+	seen := map[string]bool{}
+	addTable := func(e Expression) {
+		if a, ok := e.(AliasedExpression); ok {
+			e = a.Aliased()
+		}
+		i, ok := e.(IdentifierExpression)
+		if !ok {
+			return
+		}
+		schema, table := i.GetSchema(), i.GetTable()
+		switch col := i.GetCol().(type) {
+		case nil:
+		case string:
+			// a parsed identifier such as "schema.table" is stored as a table and column
+			if col != "" && schema == "" {
+				schema, table = table, col
+			}
+		default:
+			return
+		}
+		if table == "" || (schema == "" && cteNames[table]) {
+			return
+		}
+		if key := schema + "." + table; !seen[key] {
+			seen[key] = true
+			tables = append(tables, NewIdentifierExpression(schema, table, ""))
+		}
+	}
+	addTables := func(cl ColumnListExpression) {
+		if cl != nil {
+			for _, c := range cl.Columns() {
+				addTable(c)
+			}
+		}
+	}
+	Walk(e, func(e Expression) bool {
+		switch t := e.(type) {
+		case SelectClausesExpression:
+			addTables(t.GetClauses().From())
+			for _, j := range t.GetClauses().Joins() {
+				addTable(j.Table())
+			}
+		case InsertClausesExpression:
+			addTable(t.GetClauses().Into())
+		case UpdateClausesExpression:
+			addTable(t.GetClauses().Table())
+			addTables(t.GetClauses().From())
+		case DeleteClausesExpression:
+			addTable(t.GetClauses().From())
+		case TruncateClausesExpression:
+			addTables(t.GetClauses().Table())
+		}
+		return true
+	})
+	return tables
+}
+
+type expressionListable interface {
+	ToExpressions() (ExpressionList, error)
+}
+
+// used internally to walk a possibly nil typed expression, such as a nil ColumnListExpression
+func walkExpression(e Expression, v Visitor) {
+	if e == nil || isNilExpression(e) {
+		return
+	}
+	Walk(e, v)
+}
+
+func walkJoinCondition(jc JoinCondition, v Visitor) {
+	switch t := jc.(type) {
+	case joinOnCondition:
+		walkExpression(t.on, v)
+	case joinUsingCondition:
+		walkExpression(t.using, v)
+	}
+}
+
+func walkValue(val interface{}, v Visitor) {
+	switch t := val.(type) {
+	case nil:
+	case Expression:
+		walkExpression(t, v)
+	case []interface{}:
+		for _, i := range t {
+			walkValue(i, v)
+		}
+	case [][]interface{}:
+		for _, i := range t {
+			walkValue(i, v)
+		}
+	case []Expression:
+		for _, i := range t {
+			walkExpression(i, v)
+		}
+	case RangeVal:
+		walkValue(t.Start(), v)
+		walkValue(t.End(), v)
+	case Record:
+		for _, k := range sortedKeys(t) {
+			walkValue(t[k], v)
+		}
+	case map[string]interface{}:
+		for _, k := range sortedKeys(t) {
+			walkValue(t[k], v)
+		}
+	}
+}
+
+func rewriteValue(val interface{}, fn Rewriter) interface{} {
+	switch t := val.(type) {
+	case Expression:
+		if isNilExpression(t) {
+			return val
+		}
+		return Rewrite(t, fn)
+	case []interface{}:
+		return rewriteValues(t, fn)
+	case []Expression:
+		return rewriteExpressions(t, fn)
+	case RangeVal:
+		return NewRangeVal(rewriteValue(t.Start(), fn), rewriteValue(t.End(), fn))
+	case Record:
+		ret := make(Record, len(t))
+		for k, i := range t {
+			ret[k] = rewriteValue(i, fn)
+		}
+		return ret
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(t))
+		for k, i := range t {
+			ret[k] = rewriteValue(i, fn)
+		}
+		return ret
+	}
+	return val
+}
+
+func rewriteValues(vals []interface{}, fn Rewriter) []interface{} {
+	if vals == nil {
+		return nil
+	}
+	ret := make([]interface{}, 0, len(vals))
+	for _, val := range vals {
+		ret = append(ret, rewriteValue(val, fn))
+	}
+	return ret
+}
+
+func rewriteExpressions(exps []Expression, fn Rewriter) []Expression {
+	if exps == nil {
+		return nil
+	}
+	ret := make([]Expression, 0, len(exps))
+	for _, e := range exps {
+		ret = append(ret, Rewrite(e, fn))
+	}
+	return ret
+}
+
+func rewriteColumnList(cl ColumnListExpression, fn Rewriter) ColumnListExpression {
+	if cl == nil {
+		return nil
+	}
+	if r, ok := Rewrite(cl, fn).(ColumnListExpression); ok {
+		return r
+	}
+	return cl
+}
+
+func rewriteExpressionList(el ExpressionList, fn Rewriter) ExpressionList {
+	if el == nil {
+		return nil
+	}
+	if r, ok := Rewrite(el, fn).(ExpressionList); ok {
+		return r
+	}
+	return el
+}
+
+func rewriteIdentifier(i IdentifierExpression, fn Rewriter) IdentifierExpression {
+	if i == nil {
+		return nil
+	}
+	if r, ok := Rewrite(i, fn).(IdentifierExpression); ok {
+		return r
+	}
+	return i
+}
+
+func rewriteAppendable(ae AppendableExpression, fn Rewriter) AppendableExpression {
+	if ae == nil {
+		return nil
+	}
+	if r, ok := Rewrite(ae, fn).(AppendableExpression); ok {
+		return r
+	}
+	return ae
+}
+
+func rewriteWindowFrameBound(b WindowFrameBound, fn Rewriter) WindowFrameBound {
+	if b == nil {
+		return nil
+	}
+	if r, ok := Rewrite(b, fn).(WindowFrameBound); ok {
+		return r
+	}
+	return b
+}
+
+func rewriteCommonTables(ctes []CommonTableExpression, fn Rewriter) []CommonTableExpression {
+	if ctes == nil {
+		return nil
+	}
+	ret := make([]CommonTableExpression, 0, len(ctes))
+	for _, cte := range ctes {
+		if r, ok := Rewrite(cte, fn).(CommonTableExpression); ok {
+			cte = r
+		}
+		ret = append(ret, cte)
+	}
+	return ret
+}
+
+func rewriteJoinCondition(jc JoinCondition, fn Rewriter) JoinCondition {
+	switch t := jc.(type) {
+	case joinOnCondition:
+		t.on = rewriteExpressionList(t.on, fn)
+		return t
+	case joinUsingCondition:
+		t.using = rewriteColumnList(t.using, fn)
+		return t
+	}
+	return jc
+}
+
+func isNilExpression(e Expression) bool {
+	switch t := e.(type) {
+	case *insert:
+		return t == nil
+	case *conflictUpdate:
+		return t == nil
+	case *doNothingConflict:
+		return t == nil
+	}
+	return false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
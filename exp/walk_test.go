@@ -0,0 +1,337 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type walkSuite struct {
+	suite.Suite
+}
+
+func TestWalkSuite(t *testing.T) {
+	suite.Run(t, &walkSuite{})
+}
+
+func col(name string) exp.IdentifierExpression {
+	return exp.NewIdentifierExpression("", "", name)
+}
+
+// collects the column names of every visited identifier
+func walkedCols(e exp.Expression) []string {
+	var cols []string
+	exp.Walk(e, func(e exp.Expression) bool {
+		if i, ok := e.(exp.IdentifierExpression); ok {
+			if c, ok := i.GetCol().(string); ok {
+				cols = append(cols, c)
+			}
+		}
+		return true
+	})
+	return cols
+}
+
+// renames every identifier column named old to new
+func renamer(old, new string) exp.Rewriter {
+	return func(e exp.Expression) exp.Expression {
+		if i, ok := e.(exp.IdentifierExpression); ok && i.GetCol() == old {
+			return i.Col(new)
+		}
+		return e
+	}
+}
+
+func (ws *walkSuite) TestWalk() {
+	window := exp.NewWindowExpression(col("w"), nil, exp.NewColumnListExpression(col("partition")), nil).
+		RowsBetween(
+			exp.NewWindowFrameBound(exp.PrecedingFrameBound, col("preceding")),
+			exp.NewWindowFrameBound(exp.CurrentRowFrameBound, nil),
+		)
+	testCases := []struct {
+		e    exp.Expression
+		cols []string
+	}{
+		{e: col("a").As("b"), cols: []string{"a"}},
+		{e: col("a").Add(col("b")), cols: []string{"a", "b"}},
+		{e: col("a").BitwiseAnd(col("b")), cols: []string{"a", "b"}},
+		{e: col("a").Eq(col("b")), cols: []string{"a", "b"}},
+		{e: col("a").In(col("b"), 1, col("c")), cols: []string{"a", "b", "c"}},
+		{e: col("a").Between(exp.NewRangeVal(col("b"), col("c"))), cols: []string{"a", "b", "c"}},
+		{e: exp.NewJSONExpression(exp.JSONExtractOp, col("a"), col("b")), cols: []string{"a", "b"}},
+		{e: exp.NewArrayExpression(col("a"), col("b")), cols: []string{"a", "b"}},
+		{
+			e:    exp.NewCaseExpression().Value(col("a")).When(col("b"), col("c")).Else(col("d")),
+			cols: []string{"a", "b", "c", "d"},
+		},
+		{e: col("a").Cast("TEXT"), cols: []string{"a"}},
+		{e: exp.NewColumnListExpression(col("a"), "b"), cols: []string{"a", "b"}},
+		{e: exp.NewExpressionList(exp.OrType, col("a").IsNull(), col("b").IsTrue()), cols: []string{"a", "b"}},
+		{e: exp.Ex{"b": 1, "a": col("c")}, cols: []string{"a", "c", "b"}},
+		{e: exp.ExOr{"a": 1}, cols: []string{"a"}},
+		{e: exp.NewQuantifiedExpression(exp.AnyQuantifierType, col("a")), cols: []string{"a"}},
+		{
+			e: exp.NewSQLFunctionExpression("COUNT", col("a")).
+				Filter(col("b").Gt(1)).
+				OrderBy(col("c").Asc()).
+				WithinGroup(col("d").Desc()),
+			cols: []string{"a", "b", "c", "d"},
+		},
+		{
+			e:    exp.NewGroupingExpression(exp.RollupGroupingType, exp.NewColumnListExpression(col("a"), col("b"))),
+			cols: []string{"a", "b"},
+		},
+		{e: exp.NewLiteralExpression("? + ?", col("a"), 1), cols: []string{"a"}},
+		{e: col("a").Desc().NullsLast(), cols: []string{"a"}},
+		{e: exp.NewTupleExpression(col("a"), col("b")), cols: []string{"a", "b"}},
+		{e: window, cols: []string{"partition", "preceding"}},
+		{
+			e:    exp.NewSQLWindowFunctionExpression(exp.NewSQLFunctionExpression("SUM", col("a")), nil, window),
+			cols: []string{"a", "partition", "preceding"},
+		},
+		{
+			e:    exp.NewDateArithmeticExpression(exp.DateAddOp, col("a"), exp.NewIntervalExpression(1, exp.DayUnit)),
+			cols: []string{"a"},
+		},
+		{e: exp.NewExtractExpression(exp.YearUnit, col("a")), cols: []string{"a"}},
+		{e: exp.NewDateTruncExpression(exp.MonthUnit, col("a")), cols: []string{"a"}},
+		{e: exp.NewStringExpression(exp.ConcatOp, col("a"), " ", col("b")), cols: []string{"a", "b"}},
+		{e: col("a").Collate("C"), cols: []string{"a"}},
+		{
+			e:    exp.NewTextSearchExpression(exp.TextSearchMatchType, []exp.Expression{col("a"), col("b")}, col("c")),
+			cols: []string{"a", "b", "c"},
+		},
+		{
+			e: exp.NewConditionedJoinExpression(
+				exp.InnerJoinType,
+				exp.NewIdentifierExpression("", "t", nil),
+				exp.NewJoinOnCondition(col("a").Eq(col("b"))),
+			),
+			cols: []string{"a", "b"},
+		},
+		{
+			e: exp.NewConditionedJoinExpression(
+				exp.InnerJoinType, exp.NewIdentifierExpression("", "t", nil), exp.NewJoinUsingCondition("a"),
+			),
+			cols: []string{"a"},
+		},
+		{
+			e:    exp.NewDoUpdateConflictExpression("a", exp.Record{"b": col("c")}).Where(col("d").IsNull()),
+			cols: []string{"c", "d"},
+		},
+	}
+	for _, tc := range testCases {
+		ws.Equal(tc.cols, walkedCols(tc.e), "%T", tc.e)
+	}
+}
+
+func (ws *walkSuite) TestWalk_skipChildren() {
+	e := exp.NewExpressionList(exp.AndType, col("a").Eq(col("b")), col("c").In(1, 2))
+	var visited []exp.Expression
+	exp.Walk(e, func(e exp.Expression) bool {
+		visited = append(visited, e)
+		_, isBool := e.(exp.BooleanExpression)
+		return !isBool
+	})
+	ws.Equal([]exp.Expression{e, col("a").Eq(col("b")), col("c").In(1, 2)}, visited)
+
+	visited = nil
+	exp.Walk(e, func(e exp.Expression) bool {
+		visited = append(visited, e)
+		return false
+	})
+	ws.Equal([]exp.Expression{e}, visited)
+
+	exp.Walk(nil, func(e exp.Expression) bool {
+		ws.Fail("should not visit a nil expression")
+		return true
+	})
+}
+
+func (ws *walkSuite) TestWalkSelectClauses() {
+	c := exp.NewSelectClauses().
+		SetSelect(exp.NewColumnListExpression(col("a"))).
+		SetFrom(exp.NewColumnListExpression(exp.NewIdentifierExpression("", "t", nil))).
+		JoinsAppend(exp.NewConditionedJoinExpression(
+			exp.InnerJoinType,
+			exp.NewIdentifierExpression("", "t2", nil),
+			exp.NewJoinOnCondition(col("b").Eq(col("c"))),
+		)).
+		WhereAppend(col("d").Gt(1)).
+		SetGroupBy(exp.NewColumnListExpression(col("e"))).
+		HavingAppend(col("f").Lt(1)).
+		WindowsAppend(exp.NewWindowExpression(col("w"), nil, exp.NewColumnListExpression(col("g")), nil)).
+		SetOrder(col("h").Asc()).
+		SetLimit(col("i")).
+		SetLock(exp.NewLock(exp.ForUpdate, exp.Wait, col("j")))
+	var cols []string
+	exp.WalkSelectClauses(c, func(e exp.Expression) bool {
+		if i, ok := e.(exp.IdentifierExpression); ok {
+			if c, ok := i.GetCol().(string); ok {
+				cols = append(cols, c)
+			}
+		}
+		return true
+	})
+	ws.Equal([]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}, cols)
+}
+
+func (ws *walkSuite) TestWalkOtherClauses() {
+	var cols []string
+	v := func(e exp.Expression) bool {
+		if i, ok := e.(exp.IdentifierExpression); ok {
+			if c, ok := i.GetCol().(string); ok {
+				cols = append(cols, c)
+			}
+		}
+		return true
+	}
+
+	exp.WalkInsertClauses(exp.NewInsertClauses().
+		SetInto(col("t")).
+		SetCols(exp.NewColumnListExpression(col("a"))).
+		SetVals([][]interface{}{{col("b")}}).
+		SetOnConflict(exp.NewDoUpdateConflictExpression("a", exp.Record{"a": col("c")})).
+		SetReturning(exp.NewColumnListExpression(col("d"))), v)
+	ws.Equal([]string{"t", "a", "b", "c", "d"}, cols)
+
+	cols = nil
+	exp.WalkUpdateClauses(exp.NewUpdateClauses().
+		SetTable(col("t")).
+		SetSetValues(map[string]interface{}{"b": col("b"), "a": col("a")}).
+		WhereAppend(col("c").IsNull()).
+		SetReturning(exp.NewColumnListExpression(col("d"))), v)
+	ws.Equal([]string{"t", "a", "b", "c", "d"}, cols)
+
+	cols = nil
+	exp.WalkDeleteClauses(exp.NewDeleteClauses().
+		SetFrom(col("t")).
+		WhereAppend(col("a").IsNull()).
+		SetOrder(col("b").Asc()), v)
+	ws.Equal([]string{"t", "a", "b"}, cols)
+
+	cols = nil
+	exp.WalkTruncateClauses(exp.NewTruncateClauses().
+		SetTable(exp.NewColumnListExpression(col("t"), col("t2"))), v)
+	ws.Equal([]string{"t", "t2"}, cols)
+}
+
+func (ws *walkSuite) TestRewrite() {
+	testCases := []struct {
+		e        exp.Expression
+		expected exp.Expression
+	}{
+		{e: col("old").As("b"), expected: col("new").As("b")},
+		{e: col("old").Add(col("old")), expected: col("new").Add(col("new"))},
+		{e: col("old").In(col("old"), 1), expected: col("new").In(col("new"), 1)},
+		{
+			e:        col("old").Between(exp.NewRangeVal(col("old"), 1)),
+			expected: col("new").Between(exp.NewRangeVal(col("new"), 1)),
+		},
+		{
+			e:        exp.NewCaseExpression().When(col("old").IsNull(), col("old")).Else(col("old")),
+			expected: exp.NewCaseExpression().When(col("new").IsNull(), col("new")).Else(col("new")),
+		},
+		{e: col("old").Cast("TEXT"), expected: col("new").Cast("TEXT")},
+		{
+			e:        exp.NewColumnListExpression(col("old"), col("a")),
+			expected: exp.NewColumnListExpression(col("new"), col("a")),
+		},
+		{e: exp.Ex{"old": 1}, expected: exp.NewExpressionList(exp.AndType, col("new").Eq(1))},
+		{
+			e:        exp.NewSQLFunctionExpression("MAX", col("old")).Filter(col("old").Gt(1)),
+			expected: exp.NewSQLFunctionExpression("MAX", col("new")).Filter(col("new").Gt(1)),
+		},
+		{e: col("old").Desc(), expected: col("new").Desc()},
+		{e: exp.NewLiteralExpression("? + 1", col("old")), expected: exp.NewLiteralExpression("? + 1", col("new"))},
+		{
+			e:        exp.NewStringExpression(exp.LowerOp, col("old")),
+			expected: exp.NewStringExpression(exp.LowerOp, col("new")),
+		},
+		{
+			e:        exp.NewExtractExpression(exp.YearUnit, col("old")),
+			expected: exp.NewExtractExpression(exp.YearUnit, col("new")),
+		},
+		{
+			e: exp.NewConditionedJoinExpression(
+				exp.InnerJoinType, exp.NewIdentifierExpression("", "t", nil), exp.NewJoinUsingCondition("old"),
+			),
+			expected: exp.NewConditionedJoinExpression(
+				exp.InnerJoinType, exp.NewIdentifierExpression("", "t", nil), exp.NewJoinUsingCondition("new"),
+			),
+		},
+	}
+	for _, tc := range testCases {
+		ws.Equal(tc.expected, exp.Rewrite(tc.e, renamer("old", "new")), "%T", tc.e)
+	}
+}
+
+func (ws *walkSuite) TestRewrite_bottomUp() {
+	e := exp.NewExpressionList(exp.AndType, col("a").Eq(1))
+	var order []exp.Expression
+	exp.Rewrite(e, func(e exp.Expression) exp.Expression {
+		order = append(order, e)
+		return e
+	})
+	ws.Equal([]exp.Expression{col("a"), col("a").Eq(1), e}, order)
+	ws.Nil(exp.Rewrite(nil, renamer("a", "b")))
+}
+
+func (ws *walkSuite) TestRewrite_doesNotModifyOriginal() {
+	e := exp.NewExpressionList(exp.AndType, col("old").Eq(1))
+	r := exp.Rewrite(e, renamer("old", "new"))
+	ws.Equal(exp.NewExpressionList(exp.AndType, col("old").Eq(1)), e)
+	ws.Equal(exp.NewExpressionList(exp.AndType, col("new").Eq(1)), r)
+}
+
+func (ws *walkSuite) TestRewrite_keepsOriginalForInvalidReplacement() {
+	c := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression(col("t")))
+	r := exp.RewriteSelectClauses(c, func(e exp.Expression) exp.Expression {
+		if _, ok := e.(exp.ColumnListExpression); ok {
+			return col("a").Eq(1)
+		}
+		return e
+	})
+	ws.Equal(c.From(), r.From())
+}
+
+func (ws *walkSuite) TestRewriteSelectClauses() {
+	c := exp.NewSelectClauses().
+		SetSelect(exp.NewColumnListExpression(col("old"))).
+		SetFrom(exp.NewColumnListExpression(exp.NewIdentifierExpression("", "t", nil))).
+		WhereAppend(col("old").Gt(1)).
+		SetOrder(col("old").Asc())
+	r := exp.RewriteSelectClauses(c, renamer("old", "new"))
+	ws.Equal(exp.NewSelectClauses().
+		SetSelect(exp.NewColumnListExpression(col("new"))).
+		SetFrom(exp.NewColumnListExpression(exp.NewIdentifierExpression("", "t", nil))).
+		WhereAppend(col("new").Gt(1)).
+		SetOrder(col("new").Asc()), r)
+	ws.Equal(exp.NewColumnListExpression(col("old")), c.Select())
+}
+
+func (ws *walkSuite) TestRewriteOtherClauses() {
+	ic := exp.RewriteInsertClauses(exp.NewInsertClauses().
+		SetInto(col("t")).
+		SetCols(exp.NewColumnListExpression(col("old"))).
+		SetVals([][]interface{}{{col("old")}}), renamer("old", "new"))
+	ws.Equal(exp.NewColumnListExpression(col("new")), ic.Cols())
+	ws.Equal([][]interface{}{{col("new")}}, ic.Vals())
+
+	uc := exp.RewriteUpdateClauses(exp.NewUpdateClauses().
+		SetTable(col("t")).
+		SetSetValues(exp.Record{"a": col("old")}).
+		WhereAppend(col("old").IsNull()), renamer("old", "new"))
+	ws.Equal(exp.Record{"a": col("new")}, uc.SetValues())
+	ws.Equal(exp.NewExpressionList(exp.AndType, col("new").IsNull()), uc.Where())
+
+	dc := exp.RewriteDeleteClauses(exp.NewDeleteClauses().
+		SetFrom(col("t")).
+		WhereAppend(col("old").IsNull()), renamer("t", "t2"))
+	ws.Equal(col("t2"), dc.From())
+
+	tc := exp.RewriteTruncateClauses(exp.NewTruncateClauses().
+		SetTable(exp.NewColumnListExpression(col("t"))), renamer("t", "t2"))
+	ws.Equal(exp.NewColumnListExpression(col("t2")), tc.Table())
+}
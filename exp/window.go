@@ -5,6 +5,7 @@ type sqlWindowExpression struct {
 	parent        IdentifierExpression
 	partitionCols ColumnListExpression
 	orderCols     ColumnListExpression
+	frame         WindowFrameExpression
 }
 
 func NewWindowExpression(window, parent IdentifierExpression, partitionCols, orderCols ColumnListExpression) WindowExpression {
@@ -28,6 +29,7 @@ func (we sqlWindowExpression) clone() sqlWindowExpression {
 		parent:        we.parent,
 		partitionCols: we.partitionCols.Clone().(ColumnListExpression),
 		orderCols:     we.orderCols.Clone().(ColumnListExpression),
+		frame:         we.frame,
 	}
 }
 
@@ -88,3 +90,56 @@ func (we sqlWindowExpression) Inherit(parent string) WindowExpression {
 	ret.parent = ParseIdentifier(parent)
 	return ret
 }
+
+func (we sqlWindowExpression) Frame() WindowFrameExpression {
+	return we.frame
+}
+
+func (we sqlWindowExpression) HasFrame() bool {
+	return we.frame != nil
+}
+
+func (we sqlWindowExpression) Rows(start WindowFrameBound) WindowExpression {
+	return we.withFrame(RowsFrameUnit, start, nil)
+}
+
+func (we sqlWindowExpression) RowsBetween(start, end WindowFrameBound) WindowExpression {
+	return we.withFrame(RowsFrameUnit, start, end)
+}
+
+func (we sqlWindowExpression) Range(start WindowFrameBound) WindowExpression {
+	return we.withFrame(RangeFrameUnit, start, nil)
+}
+
+func (we sqlWindowExpression) RangeBetween(start, end WindowFrameBound) WindowExpression {
+	return we.withFrame(RangeFrameUnit, start, end)
+}
+
+func (we sqlWindowExpression) Groups(start WindowFrameBound) WindowExpression {
+	return we.withFrame(GroupsFrameUnit, start, nil)
+}
+
+func (we sqlWindowExpression) GroupsBetween(start, end WindowFrameBound) WindowExpression {
+	return we.withFrame(GroupsFrameUnit, start, end)
+}
+
+// Sets the EXCLUDE option on the frame. If no frame has been set yet the exclusion is applied to a
+// ROWS frame without a start bound, which will fail when generating SQL until a frame is set.
+func (we sqlWindowExpression) Exclude(exclusion WindowFrameExclusion) WindowExpression {
+	ret := we.clone()
+	if ret.frame == nil {
+		ret.frame = NewWindowFrameExpression(RowsFrameUnit, nil, nil)
+	}
+	ret.frame = ret.frame.Exclude(exclusion)
+	return ret
+}
+
+func (we sqlWindowExpression) withFrame(unit WindowFrameUnit, start, end WindowFrameBound) WindowExpression {
+	ret := we.clone()
+	frame := NewWindowFrameExpression(unit, start, end)
+	if we.frame != nil {
+		frame = frame.Exclude(we.frame.Exclusion())
+	}
+	ret.frame = frame
+	return ret
+}
@@ -0,0 +1,83 @@
+package exp
+
+type (
+	windowFrame struct {
+		unit      WindowFrameUnit
+		start     WindowFrameBound
+		end       WindowFrameBound
+		exclusion WindowFrameExclusion
+	}
+	windowFrameBound struct {
+		boundType WindowFrameBoundType
+		offset    interface{}
+	}
+)
+
+// Creates a new window frame. The end bound may be nil to create a frame with only a start bound
+//
+//	NewWindowFrameExpression(RowsFrameUnit, NewWindowFrameBound(PrecedingFrameBound, 6), nil) -> ROWS 6 PRECEDING
+func NewWindowFrameExpression(unit WindowFrameUnit, start, end WindowFrameBound) WindowFrameExpression {
+	return windowFrame{unit: unit, start: start, end: end, exclusion: NoFrameExclusion}
+}
+
+func (wf windowFrame) Clone() Expression {
+	return wf
+}
+
+func (wf windowFrame) Expression() Expression {
+	return wf
+}
+
+func (wf windowFrame) Unit() WindowFrameUnit {
+	return wf.unit
+}
+
+func (wf windowFrame) Start() WindowFrameBound {
+	return wf.start
+}
+
+func (wf windowFrame) End() WindowFrameBound {
+	return wf.end
+}
+
+func (wf windowFrame) HasEnd() bool {
+	return wf.end != nil
+}
+
+func (wf windowFrame) Exclusion() WindowFrameExclusion {
+	return wf.exclusion
+}
+
+func (wf windowFrame) HasExclusion() bool {
+	return wf.exclusion != NoFrameExclusion
+}
+
+func (wf windowFrame) Exclude(exclusion WindowFrameExclusion) WindowFrameExpression {
+	ret := wf
+	ret.exclusion = exclusion
+	return ret
+}
+
+// Creates a new window frame bound. The offset is only used for PrecedingFrameBound and FollowingFrameBound
+//
+//	NewWindowFrameBound(CurrentRowFrameBound, nil) -> CURRENT ROW
+//	NewWindowFrameBound(PrecedingFrameBound, 6) -> 6 PRECEDING
+func NewWindowFrameBound(boundType WindowFrameBoundType, offset interface{}) WindowFrameBound {
+	return windowFrameBound{boundType: boundType, offset: offset}
+}
+
+func (wfb windowFrameBound) Clone() Expression {
+	return wfb
+}
+
+func (wfb windowFrameBound) Expression() Expression {
+	return wfb
+}
+
+func (wfb windowFrameBound) Type() WindowFrameBoundType {
+	return wfb.boundType
+}
+
+func (wfb windowFrameBound) Offset() interface{} {
+	return wfb.offset
+}
@@ -0,0 +1,62 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type windowFrameExpressionTest struct {
+	suite.Suite
+}
+
+func TestWindowFrameExpressionSuite(t *testing.T) {
+	suite.Run(t, new(windowFrameExpressionTest))
+}
+
+func (wfet *windowFrameExpressionTest) TestClone() {
+	start := exp.NewWindowFrameBound(exp.PrecedingFrameBound, 1)
+	wf := exp.NewWindowFrameExpression(exp.RowsFrameUnit, start, nil)
+	wfet.Equal(wf, wf.Clone())
+	wfet.Equal(start, start.Clone())
+}
+
+func (wfet *windowFrameExpressionTest) TestExpression() {
+	start := exp.NewWindowFrameBound(exp.PrecedingFrameBound, 1)
+	wf := exp.NewWindowFrameExpression(exp.RowsFrameUnit, start, nil)
+	wfet.Equal(wf, wf.Expression())
+	wfet.Equal(start, start.Expression())
+}
+
+func (wfet *windowFrameExpressionTest) TestBounds() {
+	start := exp.NewWindowFrameBound(exp.PrecedingFrameBound, 1)
+	end := exp.NewWindowFrameBound(exp.FollowingFrameBound, 2)
+
+	wf := exp.NewWindowFrameExpression(exp.RangeFrameUnit, start, nil)
+	wfet.Equal(exp.RangeFrameUnit, wf.Unit())
+	wfet.Equal(start, wf.Start())
+	wfet.Nil(wf.End())
+	wfet.False(wf.HasEnd())
+
+	wf = exp.NewWindowFrameExpression(exp.RangeFrameUnit, start, end)
+	wfet.Equal(end, wf.End())
+	wfet.True(wf.HasEnd())
+
+	wfet.Equal(exp.PrecedingFrameBound, start.Type())
+	wfet.Equal(1, start.Offset())
+	wfet.Equal(exp.FollowingFrameBound, end.Type())
+	wfet.Equal(2, end.Offset())
+}
+
+func (wfet *windowFrameExpressionTest) TestExclude() {
+	start := exp.NewWindowFrameBound(exp.CurrentRowFrameBound, nil)
+	wf := exp.NewWindowFrameExpression(exp.RowsFrameUnit, start, nil)
+	wfet.Equal(exp.NoFrameExclusion, wf.Exclusion())
+	wfet.False(wf.HasExclusion())
+
+	wf2 := wf.Exclude(exp.CurrentRowFrameExclusion)
+	wfet.Equal(exp.CurrentRowFrameExclusion, wf2.Exclusion())
+	wfet.True(wf2.HasExclusion())
+	wfet.False(wf.HasExclusion())
+}
@@ -99,6 +99,12 @@ func (swfe sqlWindowFunctionExpression) IsTrue() BooleanExpression     { return
 func (swfe sqlWindowFunctionExpression) IsNotTrue() BooleanExpression  { return isNot(swfe, true) }
 func (swfe sqlWindowFunctionExpression) IsFalse() BooleanExpression    { return is(swfe, false) }
 func (swfe sqlWindowFunctionExpression) IsNotFalse() BooleanExpression { return isNot(swfe, false) }
+func (swfe sqlWindowFunctionExpression) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(swfe, val)
+}
+func (swfe sqlWindowFunctionExpression) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(swfe, val)
+}
 
 func (swfe sqlWindowFunctionExpression) Asc() OrderedExpression  { return asc(swfe) }
 func (swfe sqlWindowFunctionExpression) Desc() OrderedExpression { return desc(swfe) }
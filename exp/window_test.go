@@ -82,3 +82,43 @@ func (wet *windowExpressionTest) TestInherit() {
 	w = w.Inherit("w2")
 	wet.Equal(exp.NewIdentifierExpression("", "", "w2"), w.Parent())
 }
+
+func (wet *windowExpressionTest) TestFrame() {
+	start := exp.NewWindowFrameBound(exp.PrecedingFrameBound, 6)
+	end := exp.NewWindowFrameBound(exp.CurrentRowFrameBound, nil)
+	w := exp.NewWindowExpression(nil, nil, nil, nil)
+	wet.False(w.HasFrame())
+	wet.Nil(w.Frame())
+
+	cases := []struct {
+		w    exp.WindowExpression
+		unit exp.WindowFrameUnit
+		end  exp.WindowFrameBound
+	}{
+		{w: w.Rows(start), unit: exp.RowsFrameUnit},
+		{w: w.RowsBetween(start, end), unit: exp.RowsFrameUnit, end: end},
+		{w: w.Range(start), unit: exp.RangeFrameUnit},
+		{w: w.RangeBetween(start, end), unit: exp.RangeFrameUnit, end: end},
+		{w: w.Groups(start), unit: exp.GroupsFrameUnit},
+		{w: w.GroupsBetween(start, end), unit: exp.GroupsFrameUnit, end: end},
+	}
+	for _, tc := range cases {
+		wet.True(tc.w.HasFrame())
+		wet.Equal(exp.NewWindowFrameExpression(tc.unit, start, tc.end), tc.w.Frame())
+		wet.Equal(tc.w.Frame(), tc.w.Clone().(exp.WindowExpression).Frame())
+	}
+	wet.False(w.HasFrame())
+}
+
+func (wet *windowExpressionTest) TestExclude() {
+	start := exp.NewWindowFrameBound(exp.UnboundedPrecedingFrameBound, nil)
+	w := exp.NewWindowExpression(nil, nil, nil, nil)
+
+	excluded := w.Rows(start).Exclude(exp.TiesFrameExclusion)
+	wet.Equal(exp.TiesFrameExclusion, excluded.Frame().Exclusion())
+	wet.True(excluded.Frame().HasExclusion())
+
+	// the exclusion is kept when the frame is set afterwards
+	excluded = w.Exclude(exp.GroupFrameExclusion).Range(start)
+	wet.Equal(exp.NewWindowFrameExpression(exp.RangeFrameUnit, start, nil).Exclude(exp.GroupFrameExclusion), excluded.Frame())
+}
@@ -1,6 +1,9 @@
 package depiq
 
 import (
+	"reflect"
+	"time"
+
 	"github.com/orn-id/depiq/exp"
 )
 
@@ -235,6 +238,92 @@ func W(ws ...string) exp.WindowExpression {
 	}
 }
 
+// Creates a ROLLUP grouping to be used in GROUP BY. Use GroupingSet to create a composite column.
+// Dialects that use WITH ROLLUP (e.g. mysql) only support a ROLLUP of plain columns as the only GROUP BY expression
+// 	ds.GroupBy(Rollup("a", "b")) -> GROUP BY ROLLUP("a", "b")
+// 	ds.GroupBy(Rollup("a", GroupingSet("b", "c"))) -> GROUP BY ROLLUP("a", ("b", "c"))
+// 	ds.GroupBy(Rollup("a", "b")) -> GROUP BY `a`, `b` WITH ROLLUP //mysql
+func Rollup(cols ...interface{}) exp.GroupingExpression {
+	return exp.NewGroupingExpression(exp.RollupGroupingType, groupingElements(cols)...)
+}
+
+// Creates a CUBE grouping to be used in GROUP BY. Use GroupingSet to create a composite column.
+// 	ds.GroupBy(Cube("a", "b")) -> GROUP BY CUBE("a", "b")
+func Cube(cols ...interface{}) exp.GroupingExpression {
+	return exp.NewGroupingExpression(exp.CubeGroupingType, groupingElements(cols)...)
+}
+
+// Creates a GROUPING SETS grouping to be used in GROUP BY.
+// 	ds.GroupBy(GroupingSets(GroupingSet("a", "b"), GroupingSet("a"), GroupingSet()))
+// 		-> GROUP BY GROUPING SETS(("a", "b"), ("a"), ())
+func GroupingSets(sets ...exp.ColumnListExpression) exp.GroupingExpression {
+	return exp.NewGroupingExpression(exp.GroupingSetsGroupingType, sets...)
+}
+
+// Creates a grouping set to be used with GroupingSets, or a composite column to be used with Rollup and Cube.
+// Calling GroupingSet without any columns creates the empty grouping set ().
+// 	GroupingSet("a", "b") -> ("a", "b")
+func GroupingSet(cols ...interface{}) exp.ColumnListExpression {
+	return exp.NewColumnListExpression(cols...)
+}
+
+func groupingElements(cols []interface{}) []exp.ColumnListExpression {
+	sets := make([]exp.ColumnListExpression, 0, len(cols))
+	for _, col := range cols {
+		if set, ok := col.(exp.ColumnListExpression); ok {
+			sets = append(sets, set)
+		} else {
+			sets = append(sets, exp.NewColumnListExpression(col))
+		}
+	}
+	return sets
+}
+
+// Creates a new GROUPING sql function used to tell subtotal rows apart from regular rows
+// 	GROUPING("a") -> GROUPING("a")
+// 	GROUPING("a", "b") -> GROUPING("a", "b")
+//nolint:stylecheck,golint // sql function name
+func GROUPING(cols ...interface{}) exp.SQLFunctionExpression {
+	args := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if s, ok := col.(string); ok {
+			col = I(s)
+		}
+		args = append(args, col)
+	}
+	return Func("GROUPING", args...)
+}
+
+// Creates an UNBOUNDED PRECEDING window frame bound
+// 	W().OrderBy("ts").Rows(UnboundedPreceding()) -> (ORDER BY "ts" ROWS UNBOUNDED PRECEDING)
+func UnboundedPreceding() exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.UnboundedPrecedingFrameBound, nil)
+}
+
+// Creates an n PRECEDING window frame bound
+// 	W().OrderBy("ts").RowsBetween(Preceding(6), CurrentRow()) -> (ORDER BY "ts" ROWS BETWEEN 6 PRECEDING AND CURRENT ROW)
+func Preceding(n interface{}) exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.PrecedingFrameBound, n)
+}
+
+// Creates a CURRENT ROW window frame bound
+// 	W().OrderBy("ts").RangeBetween(CurrentRow(), UnboundedFollowing()) -> (ORDER BY "ts" RANGE BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)
+func CurrentRow() exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.CurrentRowFrameBound, nil)
+}
+
+// Creates an n FOLLOWING window frame bound
+// 	W().OrderBy("ts").RowsBetween(CurrentRow(), Following(2)) -> (ORDER BY "ts" ROWS BETWEEN CURRENT ROW AND 2 FOLLOWING)
+func Following(n interface{}) exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.FollowingFrameBound, n)
+}
+
+// Creates an UNBOUNDED FOLLOWING window frame bound
+// 	W().OrderBy("ts").RowsBetween(Preceding(1), UnboundedFollowing()) -> (ORDER BY "ts" ROWS BETWEEN 1 PRECEDING AND UNBOUNDED FOLLOWING)
+func UnboundedFollowing() exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.UnboundedFollowingFrameBound, nil)
+}
+
 // Creates a new ON clause to be used within a join
 //    ds.Join(depiq.T("my_table"), depiq.On(
 //       depiq.I("my_table.fkey").Eq(depiq.I("other_table.id")),
@@ -298,6 +387,341 @@ func All(val interface{}) exp.SQLFunctionExpression {
 	return Func("ALL ", val)
 }
 
+// Create a new quantified ANY comparison, the value is typically a subquery. Unlike Any the quantifier is looked up in
+// the dialect so it can be reported as unsupported, slices are rendered as arrays
+//   C("price").Gt(AnyOf(From("prices").Select("price"))) -> ("price" > ANY(SELECT "price" FROM "prices"))
+func AnyOf(val interface{}) exp.QuantifiedExpression {
+	return exp.NewQuantifiedExpression(exp.AnyQuantifierType, val)
+}
+
+// Create a new quantified ALL comparison, the value is typically a subquery. Unlike All the quantifier is looked up in
+// the dialect so it can be reported as unsupported, slices are rendered as arrays
+//   C("price").Gt(AllOf(From("prices").Select("price"))) -> ("price" > ALL(SELECT "price" FROM "prices"))
+func AllOf(val interface{}) exp.QuantifiedExpression {
+	return exp.NewQuantifiedExpression(exp.AllQuantifierType, val)
+}
+
+// Creates a new EXISTS expression
+//   Exists(From("orders").Where(I("orders.user_id").Eq(I("users.id"))))
+//     -> EXISTS (SELECT * FROM "orders" WHERE ("orders"."user_id" = "users"."id"))
+func Exists(subquery exp.AppendableExpression) exp.ExistsExpression {
+	return exp.NewExistsExpression(exp.ExistsSubqueryType, subquery)
+}
+
+// Creates a new NOT EXISTS expression
+//   NotExists(From("orders").Where(I("orders.user_id").Eq(I("users.id"))))
+//     -> NOT EXISTS (SELECT * FROM "orders" WHERE ("orders"."user_id" = "users"."id"))
+func NotExists(subquery exp.AppendableExpression) exp.ExistsExpression {
+	return exp.NewExistsExpression(exp.NotExistsSubqueryType, subquery)
+}
+
+func jsonTarget(target interface{}) exp.Expression {
+	switch t := target.(type) {
+	case string:
+		return I(t)
+	case exp.Expression:
+		return t
+	}
+	return V(target)
+}
+
+// Creates a new JSON expression extracting the value at the path as JSON. String path elements are object keys,
+// int path elements are array indexes
+//   JSONPath("data", "a", 0) -> ("data"->'a'->0) //postgres
+//   JSONPath("data", "a", 0) -> JSON_EXTRACT(`data`, '$.a[0]') //mysql
+func JSONPath(target interface{}, path ...interface{}) exp.JSONExpression {
+	return exp.NewJSONExpression(exp.JSONExtractOp, jsonTarget(target), path)
+}
+
+// Creates a new JSON expression extracting the value at the path as text
+//   JSONPathText("data", "a", "b") -> ("data"->'a'->>'b') //postgres
+//   JSONPathText("data", "a", "b") -> (`data`->>'$.a.b') //mysql
+//   JSONPathText("data", "a", "b") -> json_extract(`data`, '$.a.b') //sqlite3
+//   JSONPathText("data", "a", "b") -> JSON_VALUE("data", '$.a.b') //sqlserver
+func JSONPathText(target interface{}, path ...interface{}) exp.JSONExpression {
+	return exp.NewJSONExpression(exp.JSONExtractTextOp, jsonTarget(target), path)
+}
+
+// Creates a new JSON containment expression, the candidate is encoded as JSON unless it is a string, []byte or
+// Expression
+//   JSONContains("data", map[string]interface{}{"a": 1}) -> ("data" @> '{"a":1}') //postgres
+//   JSONContains("data", map[string]interface{}{"a": 1}) -> JSON_CONTAINS(`data`, '{"a":1}') //mysql
+func JSONContains(target, candidate interface{}) exp.JSONExpression {
+	return exp.NewJSONExpression(exp.JSONContainsOp, jsonTarget(target), candidate)
+}
+
+// Creates a new JSON key existence expression
+//   JSONHasKey("data", "a") -> jsonb_exists("data", 'a') //postgres
+//   JSONHasKey("data", "a") -> (JSON_EXTRACT(`data`, '$.a') IS NOT NULL) //mysql
+func JSONHasKey(target interface{}, key string) exp.JSONExpression {
+	return exp.NewJSONExpression(exp.JSONHasKeyOp, jsonTarget(target), key)
+}
+
+// Creates a new JSON array length expression
+//   JSONArrayLength("data") -> jsonb_array_length("data") //postgres
+//   JSONArrayLength(JSONPath("data", "tags")) -> JSON_LENGTH(JSON_EXTRACT(`data`, '$.tags')) //mysql
+func JSONArrayLength(target interface{}) exp.JSONExpression {
+	return exp.NewJSONExpression(exp.JSONArrayLengthOp, jsonTarget(target), nil)
+}
+
+// used internally to normalize the target of an array expression, strings are identifiers and slices are arrays
+func arrayTarget(target interface{}) exp.Expression {
+	switch t := target.(type) {
+	case string:
+		return I(t)
+	case exp.Expression:
+		return t
+	}
+	if _, ok := target.([]byte); !ok && reflect.Indirect(reflect.ValueOf(target)).Kind() == reflect.Slice {
+		return Array(target)
+	}
+	return V(target)
+}
+
+// Creates a new ARRAY expression, a single slice argument is used as the elements of the array
+//   Array("a", "b") -> ARRAY['a', 'b']
+//   Array([]int64{1, 2}) -> ARRAY[1, 2]
+//   I("id").Eq(AnyOf(Array(ids))) -> ("id" = ANY(ARRAY[1, 2]))
+func Array(vals ...interface{}) exp.ArrayExpression {
+	return exp.NewArrayExpression(vals...)
+}
+
+// Creates a new array contains (@>) expression, slices are converted to arrays
+//   ArrayContains("tags", []string{"a", "b"}) -> ("tags" @> ARRAY['a', 'b'])
+func ArrayContains(target, val interface{}) exp.BooleanExpression {
+	return exp.NewBooleanExpression(exp.ArrayContainsOp, arrayTarget(target), val)
+}
+
+// Creates a new array contained by (<@) expression, slices are converted to arrays
+//   ArrayContainedBy("tags", []string{"a", "b"}) -> ("tags" <@ ARRAY['a', 'b'])
+func ArrayContainedBy(target, val interface{}) exp.BooleanExpression {
+	return exp.NewBooleanExpression(exp.ArrayContainedByOp, arrayTarget(target), val)
+}
+
+// Creates a new array overlaps (&&) expression, slices are converted to arrays
+//   ArrayOverlaps("tags", []string{"a", "b"}) -> ("tags" && ARRAY['a', 'b'])
+func ArrayOverlaps(target, val interface{}) exp.BooleanExpression {
+	return exp.NewBooleanExpression(exp.ArrayOverlapsOp, arrayTarget(target), val)
+}
+
+// Creates a new array_length sql function for the first dimension of the array
+//   ArrayLength("tags") -> array_length("tags", 1)
+func ArrayLength(target interface{}) exp.SQLFunctionExpression {
+	return Func("array_length", arrayTarget(target), 1)
+}
+
+// Creates a new unnest sql function expanding an array to a set of rows
+//   Unnest("tags") -> unnest("tags")
+//   From(Unnest([]int64{1, 2}).As("id")) -> FROM unnest(ARRAY[1, 2]) AS "id"
+func Unnest(target interface{}) exp.SQLFunctionExpression {
+	return Func("unnest", arrayTarget(target))
+}
+
+// Creates a new full-text search expression matching the documents against the query, string documents are
+// identifiers. Use WithConfig to set the text search configuration or language and WithMode to use the boolean mode
+//   TextSearch("cats", "title", "body")
+//     -> ((to_tsvector("title") || to_tsvector("body")) @@ websearch_to_tsquery('cats')) //postgres
+//     -> MATCH (`title`, `body`) AGAINST ('cats' IN NATURAL LANGUAGE MODE) //mysql
+//     -> FREETEXT(("title", "body"), 'cats') //sqlserver
+//   TextSearch("cats", "docs") -> ("docs" MATCH 'cats') //sqlite3 FTS5 table
+func TextSearch(query interface{}, documents ...interface{}) exp.TextSearchExpression {
+	return exp.NewTextSearchExpression(exp.TextSearchMatchType, textSearchDocuments(documents), query)
+}
+
+// Creates a new full-text search rank expression to be used in an ORDER BY, string documents are identifiers
+//   TextSearchRank("cats", "body") -> ts_rank(to_tsvector("body"), websearch_to_tsquery('cats')) //postgres
+//   TextSearchRank("cats", "body") -> MATCH (`body`) AGAINST ('cats' IN NATURAL LANGUAGE MODE) //mysql
+//   TextSearchRank("cats", "docs") -> bm25("docs") //sqlite3, lower is a better match
+func TextSearchRank(query interface{}, documents ...interface{}) exp.TextSearchExpression {
+	return exp.NewTextSearchExpression(exp.TextSearchRankType, textSearchDocuments(documents), query)
+}
+
+// used internally to normalize full-text search documents, strings are identifiers
+func textSearchDocuments(documents []interface{}) []exp.Expression {
+	exps := make([]exp.Expression, 0, len(documents))
+	for _, document := range documents {
+		switch d := document.(type) {
+		case string:
+			exps = append(exps, I(d))
+		case exp.Expression:
+			exps = append(exps, d)
+		default:
+			exps = append(exps, V(d))
+		}
+	}
+	return exps
+}
+
+// Creates a new string concatenation expression, values that are not expressions are used as is
+//   Concat(C("first"), " ", C("last"))
+//     -> ("first" || ' ' || "last") //postgres, sqlite3
+//     -> CONCAT(`first`, ' ', `last`) //mysql
+//     -> ("first" + ' ' + "last") //sqlserver
+func Concat(vals ...interface{}) exp.StringExpression {
+	return exp.NewStringExpression(exp.ConcatOp, vals...)
+}
+
+// used internally to normalize the target of a string function, strings are identifiers
+func stringTarget(target interface{}) interface{} {
+	if s, ok := target.(string); ok {
+		return I(s)
+	}
+	return target
+}
+
+// Creates a new LOWER expression, string targets are identifiers
+//   Lower("name") -> LOWER("name")
+func Lower(target interface{}) exp.StringExpression {
+	return exp.NewStringExpression(exp.LowerOp, stringTarget(target))
+}
+
+// Creates a new UPPER expression, string targets are identifiers
+//   Upper("name") -> UPPER("name")
+func Upper(target interface{}) exp.StringExpression {
+	return exp.NewStringExpression(exp.UpperOp, stringTarget(target))
+}
+
+// Creates a new TRIM expression, string targets are identifiers
+//   Trim("name") -> TRIM("name")
+func Trim(target interface{}) exp.StringExpression {
+	return exp.NewStringExpression(exp.TrimOp, stringTarget(target))
+}
+
+// Creates a new expression taking length characters starting at the 1 based start position, string targets are
+// identifiers
+//   Substring("name", 2, 3) -> SUBSTRING("name", 2, 3)
+//   Substring("name", 2, 3) -> SUBSTR(`name`, 2, 3) //sqlite3
+func Substring(target, start, length interface{}) exp.StringExpression {
+	return exp.NewStringExpression(exp.SubstringOp, stringTarget(target), start, length)
+}
+
+// Creates a new expression taking the characters from the 1 based start position to the end of the string, string
+// targets are identifiers
+//   SubstringFrom("name", 2) -> SUBSTRING("name", 2)
+//   SubstringFrom("name", 2) -> SUBSTRING("name", 2, LEN("name")) //sqlserver
+func SubstringFrom(target, start interface{}) exp.StringExpression {
+	return exp.NewStringExpression(exp.SubstringOp, stringTarget(target), start)
+}
+
+// Creates a new expression for the number of characters in the string, string targets are identifiers
+//   Length("name") -> LENGTH("name")
+//   Length("name") -> CHAR_LENGTH(`name`) //mysql
+//   Length("name") -> LEN("name") //sqlserver
+func Length(target interface{}) exp.StringExpression {
+	return exp.NewStringExpression(exp.LengthOp, stringTarget(target))
+}
+
+// Creates a new expression for the 1 based position of the substring in the target, 0 when the substring is not
+// found. String targets are identifiers
+//   Position("name", "a") -> POSITION('a' IN "name")
+//   Position("name", "a") -> INSTR(`name`, 'a') //sqlite3
+//   Position("name", "a") -> CHARINDEX('a', "name") //sqlserver
+func Position(target, substring interface{}) exp.StringExpression {
+	return exp.NewStringExpression(exp.PositionOp, stringTarget(target), substring)
+}
+
+// Creates a new expression replacing all occurrences of from with to, string targets are identifiers
+//   Replace("name", "a", "b") -> REPLACE("name", 'a', 'b')
+func Replace(target, from, to interface{}) exp.StringExpression {
+	return exp.NewStringExpression(exp.ReplaceOp, stringTarget(target), from, to)
+}
+
+// Creates a new interval of the given amount of units, see DateAdd and DateSub for dialect independent arithmetic
+//   Interval(7, exp.DayUnit) -> INTERVAL '7 days' //postgres
+//   Interval(7, exp.DayUnit) -> INTERVAL 7 DAY //mysql
+func Interval(amount int64, unit exp.DateTimeUnit) exp.IntervalExpression {
+	return exp.NewIntervalExpression(amount, unit)
+}
+
+// Creates a new interval from a time.Duration using the largest unit that represents the duration exactly
+//   IntervalFromDuration(90 * time.Minute) -> INTERVAL '90 minutes' //postgres
+func IntervalFromDuration(d time.Duration) exp.IntervalExpression {
+	return exp.NewDurationIntervalExpression(d)
+}
+
+// used internally to normalize date and time targets, strings are identifiers
+func dateTimeTarget(target interface{}) exp.Expression {
+	switch t := target.(type) {
+	case string:
+		return I(t)
+	case exp.Expression:
+		return t
+	}
+	return V(target)
+}
+
+// Creates a new expression adding the interval to the target, string targets are identifiers
+//   DateAdd("created_at", Interval(7, exp.DayUnit))
+//     -> ("created_at" + INTERVAL '7 days') //postgres
+//     -> DATE_ADD(`created_at`, INTERVAL 7 DAY) //mysql
+//     -> DATEADD(day, 7, "created_at") //sqlserver
+//     -> datetime(`created_at`, '+7 days') //sqlite3
+func DateAdd(target interface{}, interval exp.IntervalExpression) exp.DateArithmeticExpression {
+	return exp.NewDateArithmeticExpression(exp.DateAddOp, dateTimeTarget(target), interval)
+}
+
+// Creates a new expression subtracting the interval from the target, string targets are identifiers
+//   DateSub(Now(), Interval(7, exp.DayUnit))
+//     -> (NOW() - INTERVAL '7 days') //postgres
+//     -> DATE_SUB(NOW(), INTERVAL 7 DAY) //mysql
+//     -> DATEADD(day, -7, SYSDATETIME()) //sqlserver
+//     -> datetime(CURRENT_TIMESTAMP, '-7 days') //sqlite3
+func DateSub(target interface{}, interval exp.IntervalExpression) exp.DateArithmeticExpression {
+	return exp.NewDateArithmeticExpression(exp.DateSubOp, dateTimeTarget(target), interval)
+}
+
+// Creates a new expression extracting the field from the target, string targets are identifiers
+//   Extract(exp.YearUnit, "created_at") -> EXTRACT(YEAR FROM "created_at") //postgres
+//   Extract(exp.YearUnit, "created_at") -> DATEPART(year, "created_at") //sqlserver
+//   Extract(exp.YearUnit, "created_at") -> CAST(strftime('%Y', `created_at`) AS INTEGER) //sqlite3
+func Extract(field exp.DateTimeUnit, target interface{}) exp.ExtractExpression {
+	return exp.NewExtractExpression(field, dateTimeTarget(target))
+}
+
+// Creates a new expression truncating the target to the unit, string targets are identifiers
+//   DateTrunc(exp.MonthUnit, "created_at") -> date_trunc('month', "created_at") //postgres
+//   DateTrunc(exp.MonthUnit, "created_at") -> CAST(DATE_FORMAT(`created_at`, '%Y-%m-01 00:00:00') AS DATETIME) //mysql
+//   DateTrunc(exp.MonthUnit, "created_at") -> DATETRUNC(month, "created_at") //sqlserver
+//   DateTrunc(exp.MonthUnit, "created_at") -> strftime('%Y-%m-01 00:00:00', `created_at`) //sqlite3
+func DateTrunc(unit exp.DateTimeUnit, target interface{}) exp.DateTruncExpression {
+	return exp.NewDateTruncExpression(unit, dateTimeTarget(target))
+}
+
+// Creates a new expression for the current date and time
+//   Now() -> NOW() //postgres, mysql
+//   Now() -> SYSDATETIME() //sqlserver
+//   Now() -> CURRENT_TIMESTAMP //sqlite3
+func Now() exp.CurrentTimeExpression {
+	return exp.NewCurrentTimeExpression(exp.NowCurrentTimeType)
+}
+
+// Creates a new CURRENT_TIMESTAMP expression
+func CurrentTimestamp() exp.CurrentTimeExpression {
+	return exp.NewCurrentTimeExpression(exp.TimestampCurrentTimeType)
+}
+
+// Creates a new expression for the current date
+//   CurrentDate() -> CURRENT_DATE
+//   CurrentDate() -> CAST(GETDATE() AS DATE) //sqlserver
+func CurrentDate() exp.CurrentTimeExpression {
+	return exp.NewCurrentTimeExpression(exp.DateCurrentTimeType)
+}
+
+// Creates a new expression for the current time
+//   CurrentTime() -> CURRENT_TIME
+//   CurrentTime() -> CAST(GETDATE() AS TIME) //sqlserver
+func CurrentTime() exp.CurrentTimeExpression {
+	return exp.NewCurrentTimeExpression(exp.TimeCurrentTimeType)
+}
+
+// Creates a new row value expression for multi-column comparisons and IN lists
+//   Tuple(C("a"), C("b")).Gt([]interface{}{1, 2}) -> (("a", "b") > (1, 2))
+//   Tuple(C("a"), C("b")).In([][]interface{}{{1, 2}, {1, 3}}) -> (("a", "b") IN ((1, 2), (1, 3)))
+//   Tuple(C("a"), C("b")).In(From("test").Select("a", "b")) -> (("a", "b") IN ((SELECT "a", "b" FROM "test")))
+func Tuple(vals ...interface{}) exp.TupleExpression {
+	return exp.NewTupleExpression(vals...)
+}
+
 func Case() exp.CaseExpression {
 	return exp.NewCaseExpression()
 }
@@ -212,6 +212,28 @@ func ExampleC_cast() {
 	// SELECT * FROM "test" WHERE (CAST("json1" AS TEXT) != CAST("json2" AS TEXT))
 }
 
+func ExampleC_arithmetic() {
+	sql, _, _ := depiq.Update("items").Set(
+		depiq.Record{"qty": depiq.C("qty").Add(1)},
+	).ToSQL()
+	fmt.Println(sql)
+
+	sql, args, _ := depiq.From("items").
+		Select(depiq.C("price").Mul(depiq.C("qty")).As("total")).
+		Where(depiq.C("price").Sub(depiq.C("discount")).Gt(10)).
+		Prepared(true).
+		ToSQL()
+	fmt.Println(sql, args)
+
+	sql, _, _ = depiq.From("items").Order(depiq.C("score").Neg().Asc()).ToSQL()
+	fmt.Println(sql)
+
+	// Output:
+	// UPDATE "items" SET "qty"=("qty" + 1)
+	// SELECT ("price" * "qty") AS "total" FROM "items" WHERE (("price" - "discount") > ?) [10]
+	// SELECT * FROM "items" ORDER BY (- "score") ASC
+}
+
 func ExampleC_comparisons() {
 	// used from an identifier
 	sql, _, _ := depiq.From("test").Where(depiq.C("a").Eq(10)).ToSQL()
@@ -351,6 +373,39 @@ func ExampleC_isComparisons() {
 	// SELECT * FROM "test" WHERE ("a" IS NOT FALSE) []
 }
 
+func ExampleC_distinctFromComparisons() {
+	ds := depiq.From("test").Where(depiq.C("a").IsDistinctFrom(depiq.C("b")))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).Where(depiq.C("c").IsNotDistinctFrom(10)).ToSQL()
+	fmt.Println(sql, args)
+
+	// mysql uses the NULL safe equal operator
+	sql, args, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.WithDialect("mysql").Where(depiq.C("c").IsNotDistinctFrom(10)).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE ("a" IS DISTINCT FROM "b") []
+	// SELECT * FROM "test" WHERE (("a" IS DISTINCT FROM "b") AND ("c" IS NOT DISTINCT FROM ?)) [10]
+	// SELECT * FROM `test` WHERE NOT (`a` <=> `b`) []
+	// SELECT * FROM `test` WHERE (NOT (`a` <=> `b`) AND (`c` <=> 10)) []
+}
+
+func ExampleC_collate() {
+	ds := depiq.From("users").
+		Where(depiq.C("name").Collate("C").Gt("m")).
+		Order(depiq.C("name").Collate("C").Asc())
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "users" WHERE ("name" COLLATE "C" > 'm') ORDER BY "name" COLLATE "C" ASC []
+}
+
 func ExampleC_betweenComparisons() {
 	ds := depiq.From("test").Where(
 		depiq.C("a").Between(depiq.Range(1, 10)),
@@ -1738,6 +1793,95 @@ func ExampleW() {
 	// SELECT ROW_NUMBER() OVER ("w" ORDER BY "b") FROM "test" WINDOW "w" AS (PARTITION BY "a") []
 }
 
+func ExampleRollup() {
+	ds := depiq.From("sales").
+		Select("region", "product", depiq.SUM("amount")).
+		GroupBy(depiq.Rollup("region", "product"))
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+
+	query, args, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(query, args)
+	// Output:
+	// SELECT "region", "product", SUM("amount") FROM "sales" GROUP BY ROLLUP("region", "product") []
+	// SELECT `region`, `product`, SUM(`amount`) FROM `sales` GROUP BY `region`, `product` WITH ROLLUP []
+}
+
+func ExampleCube() {
+	ds := depiq.From("sales").
+		Select("region", "product", depiq.SUM("amount")).
+		GroupBy(depiq.Cube("region", "product"))
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+	// Output:
+	// SELECT "region", "product", SUM("amount") FROM "sales" GROUP BY CUBE("region", "product") []
+}
+
+func ExampleGroupingSets() {
+	ds := depiq.From("sales").
+		Select("region", "product", depiq.GROUPING("region", "product").As("level"), depiq.SUM("amount")).
+		GroupBy(depiq.GroupingSets(
+			depiq.GroupingSet("region", "product"),
+			depiq.GroupingSet("region"),
+			depiq.GroupingSet(),
+		))
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+	// Output:
+	// SELECT "region", "product", GROUPING("region", "product") AS "level", SUM("amount") FROM "sales" GROUP BY GROUPING SETS(("region", "product"), ("region"), ()) []
+}
+
+func ExampleFunc_aggregateClauses() {
+	ds := depiq.From("test").Select(
+		depiq.COUNT(depiq.Star()).Filter(depiq.C("status").Eq("active")).As("active"),
+		depiq.Func("string_agg", depiq.C("name"), ",").OrderBy(depiq.C("name").Asc()).As("names"),
+		depiq.Func("percentile_cont", 0.5).WithinGroup(depiq.C("price").Asc()).As("median"),
+	)
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+
+	query, args, _ = depiq.Dialect("mysql").From("test").
+		Select(depiq.COUNT(depiq.Star()).Filter(depiq.C("status").Eq("active")).As("active")).
+		ToSQL()
+	fmt.Println(query, args)
+	// Output:
+	// SELECT COUNT(*) FILTER (WHERE ("status" = 'active')) AS "active", string_agg("name", ',' ORDER BY "name" ASC) AS "names", percentile_cont(0.5) WITHIN GROUP (ORDER BY "price" ASC) AS "median" FROM "test" []
+	// SELECT COUNT(CASE  WHEN (`status` = 'active') THEN 1 END) AS `active` FROM `test` []
+}
+
+func ExampleW_frame() {
+	ds := depiq.From("test").
+		Select(depiq.SUM("x").Over(
+			depiq.W().OrderBy("ts").RowsBetween(depiq.Preceding(6), depiq.CurrentRow()),
+		))
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+
+	ds = depiq.From("test").
+		Select(depiq.SUM("x").Over(
+			depiq.W().PartitionBy("a").OrderBy("ts").Range(depiq.UnboundedPreceding()),
+		))
+	query, args, _ = ds.ToSQL()
+	fmt.Println(query, args)
+
+	ds = depiq.From("test").
+		Select(depiq.AVG("x").Over(
+			depiq.W().OrderBy("ts").
+				GroupsBetween(depiq.Preceding(1), depiq.Following(1)).
+				Exclude(exp.CurrentRowFrameExclusion),
+		))
+	query, args, _ = ds.ToSQL()
+	fmt.Println(query, args)
+
+	query, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(query, args)
+	// Output:
+	// SELECT SUM("x") OVER (ORDER BY "ts" ROWS BETWEEN 6 PRECEDING AND CURRENT ROW) FROM "test" []
+	// SELECT SUM("x") OVER (PARTITION BY "a" ORDER BY "ts" RANGE UNBOUNDED PRECEDING) FROM "test" []
+	// SELECT AVG("x") OVER (ORDER BY "ts" GROUPS BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE CURRENT ROW) FROM "test" []
+	// SELECT AVG("x") OVER (ORDER BY "ts" GROUPS BETWEEN ? PRECEDING AND ? FOLLOWING EXCLUDE CURRENT ROW) FROM "test" [1 1]
+}
+
 func ExampleLateral() {
 	maxEntry := depiq.From("entry").
 		Select(depiq.MAX("int").As("max_int")).
@@ -1822,6 +1966,371 @@ func ExampleAll() {
 	// SELECT * FROM "test" WHERE ("id" = ALL ((SELECT "test_id" FROM "other"))) []
 }
 
+func ExampleAnyOf() {
+	ds := depiq.From("items").Where(
+		depiq.C("price").Gt(depiq.AnyOf(
+			depiq.From("competitor_items").
+				Select("price").
+				Where(depiq.C("sku").Eq(depiq.I("items.sku")), depiq.C("region").Eq("eu")),
+		)),
+	)
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+	// Output:
+	// SELECT * FROM "items" WHERE ("price" > ANY(SELECT "price" FROM "competitor_items" WHERE (("sku" = "items"."sku") AND ("region" = 'eu')))) []
+	// SELECT * FROM "items" WHERE ("price" > ANY(SELECT "price" FROM "competitor_items" WHERE (("sku" = "items"."sku") AND ("region" = ?)))) [eu]
+}
+
+func ExampleExists() {
+	pg := depiq.Dialect("postgres")
+	ds := pg.From("users").Where(
+		depiq.C("country").Eq("NL"),
+		depiq.Exists(
+			pg.From("orders").Where(
+				depiq.I("orders.user_id").Eq(depiq.I("users.id")),
+				depiq.I("orders.status").Eq("paid"),
+			),
+		),
+	)
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+	// Output:
+	// SELECT * FROM "users" WHERE (("country" = 'NL') AND EXISTS (SELECT * FROM "orders" WHERE (("orders"."user_id" = "users"."id") AND ("orders"."status" = 'paid')))) []
+	// SELECT * FROM "users" WHERE (("country" = $1) AND EXISTS (SELECT * FROM "orders" WHERE (("orders"."user_id" = "users"."id") AND ("orders"."status" = $2)))) [NL paid]
+}
+
+func ExampleNotExists() {
+	ds := depiq.From("users").Where(
+		depiq.NotExists(
+			depiq.From("orders").Where(depiq.I("orders.user_id").Eq(depiq.I("users.id"))),
+		),
+	)
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+	// Output:
+	// SELECT * FROM "users" WHERE NOT EXISTS (SELECT * FROM "orders" WHERE ("orders"."user_id" = "users"."id")) []
+}
+
+func ExampleJSONPath() {
+	ds := depiq.From("test").Where(depiq.JSONPath("data", "tags", 0).Eq(`"a"`))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE (("data"->'tags'->0) = '"a"') []
+	// SELECT * FROM `test` WHERE (JSON_EXTRACT(`data`, '$.tags[0]') = '\"a\"') []
+}
+
+func ExampleJSONPathText() {
+	ds := depiq.From("test").
+		Where(depiq.JSONPathText("data", "address", "city").Eq("NYC")).
+		Order(depiq.JSONPathText("data", "name").Asc())
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.WithDialect("sqlite3").ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE (("data"->'address'->>'city') = 'NYC') ORDER BY ("data"->>'name') ASC []
+	// SELECT * FROM "test" WHERE (("data"->'address'->>'city') = ?) ORDER BY ("data"->>'name') ASC [NYC]
+	// SELECT * FROM `test` WHERE ((`data`->>'$.address.city') = 'NYC') ORDER BY (`data`->>'$.name') ASC []
+	// SELECT * FROM `test` WHERE (json_extract(`data`, '$.address.city') = 'NYC') ORDER BY json_extract(`data`, '$.name') ASC []
+}
+
+func ExampleJSONContains() {
+	ds := depiq.From("test").Where(depiq.JSONContains("data", map[string]interface{}{"status": "active"}))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE ("data" @> '{"status":"active"}') []
+	// SELECT * FROM "test" WHERE ("data" @> ?) [{"status":"active"}]
+}
+
+func ExampleJSONHasKey() {
+	ds := depiq.From("test").Where(depiq.JSONHasKey("data", "email"))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE jsonb_exists("data", 'email') []
+	// SELECT * FROM `test` WHERE (JSON_EXTRACT(`data`, '$.email') IS NOT NULL) []
+}
+
+func ExampleJSONArrayLength() {
+	ds := depiq.From("test").Where(depiq.JSONArrayLength(depiq.JSONPath("data", "tags")).Gt(2))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE (jsonb_array_length(("data"->'tags')) > 2) []
+	// SELECT * FROM `test` WHERE (JSON_LENGTH(JSON_EXTRACT(`data`, '$.tags')) > 2) []
+}
+
+func ExampleEx_jsonPath() {
+	ds := depiq.From("test").Where(depiq.Ex{
+		"data->address->>city": "NYC",
+		"data->tags->0":        depiq.Op{"neq": `"b"`},
+	})
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE ((("data"->'address'->>'city') = 'NYC') AND (("data"->'tags'->0) != '"b"')) []
+}
+
+func ExampleArray() {
+	ds := depiq.From("test").Where(depiq.C("tags").Eq(depiq.Array("a", "b")))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE ("tags" = ARRAY['a', 'b']) []
+	// SELECT * FROM "test" WHERE ("tags" = ARRAY[?, ?]) [a b]
+}
+
+func ExampleArrayContains() {
+	ds := depiq.From("test").Where(
+		depiq.ArrayContains("tags", []string{"a", "b"}),
+		depiq.ArrayOverlaps("tags", []string{"c", "d"}),
+		depiq.ArrayLength("tags").Lt(10),
+	)
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE (("tags" @> ARRAY['a', 'b']) AND ("tags" && ARRAY['c', 'd']) AND (array_length("tags", 1) < 10)) []
+}
+
+func ExampleUnnest() {
+	sql, args, _ := depiq.From(depiq.Unnest([]int64{1, 2, 3}).As("id")).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM unnest(ARRAY[1, 2, 3]) AS "id" []
+}
+
+func ExampleAnyOf_array() {
+	ds := depiq.From("test").Where(depiq.C("id").Eq(depiq.AnyOf([]int64{1, 2, 3})))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE ("id" = ANY(ARRAY[1, 2, 3])) []
+	// SELECT * FROM "test" WHERE ("id" = ANY(ARRAY[?, ?, ?])) [1 2 3]
+}
+
+func ExampleArray_bindSliceAsArray() {
+	opts := depiq.DefaultDialectOptions()
+	opts.BindSliceAsArray = true
+	// when using github.com/lib/pq wrap the slice so it can be passed to the driver
+	// opts.ArrayValuer = func(slice interface{}) interface{} { return pq.Array(slice) }
+	depiq.RegisterDialect("bound-arrays", opts)
+
+	ds := depiq.Dialect("bound-arrays").
+		From("test").
+		Where(
+			depiq.C("id").In([]int64{1, 2, 3}),
+			depiq.C("status").NotIn("archived", "deleted"),
+		).
+		Prepared(true)
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE (("id" = ANY(?)) AND ("status" != ALL(?))) [[1 2 3] [archived deleted]]
+}
+
+func ExampleTextSearch() {
+	search := depiq.TextSearch("cats", "title", "body")
+	ds := depiq.From("posts").
+		Select("id", search.Rank().As("rank")).
+		Where(search).
+		Order(depiq.C("rank").Desc())
+
+	sql, args, _ := ds.WithDialect("postgres").ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.WithDialect("postgres").Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT "id", ts_rank((to_tsvector("title") || to_tsvector("body")), websearch_to_tsquery('cats')) AS "rank" FROM "posts" WHERE ((to_tsvector("title") || to_tsvector("body")) @@ websearch_to_tsquery('cats')) ORDER BY "rank" DESC []
+	// SELECT "id", ts_rank((to_tsvector("title") || to_tsvector("body")), websearch_to_tsquery($1)) AS "rank" FROM "posts" WHERE ((to_tsvector("title") || to_tsvector("body")) @@ websearch_to_tsquery($2)) ORDER BY "rank" DESC [cats cats]
+	// SELECT `id`, MATCH (`title`, `body`) AGAINST ('cats' IN NATURAL LANGUAGE MODE) AS `rank` FROM `posts` WHERE MATCH (`title`, `body`) AGAINST ('cats' IN NATURAL LANGUAGE MODE) ORDER BY `rank` DESC []
+}
+
+func ExampleTextSearch_config() {
+	ds := depiq.From("posts").Where(
+		depiq.TextSearch("cats -dogs", "body").WithConfig("english").WithMode(exp.BooleanTextSearchMode),
+	)
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "posts" WHERE (to_tsvector('english', "body") @@ to_tsquery('english', 'cats -dogs')) []
+}
+
+func ExampleTextSearchRank() {
+	// sqlite3 searches an FTS5 virtual table, bm25 returns lower values for better matches
+	ds := depiq.From("docs").
+		Where(depiq.TextSearch("cats", "docs")).
+		Order(depiq.TextSearchRank("cats", "docs").Asc())
+	sql, args, _ := ds.WithDialect("sqlite3").ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM `docs` WHERE (`docs` MATCH 'cats') ORDER BY bm25(`docs`) ASC []
+}
+
+func ExampleConcat() {
+	ds := depiq.From("users").Select(depiq.Concat(depiq.C("first"), " ", depiq.C("last")).As("name"))
+	for _, dialect := range []string{"postgres", "mysql", "sqlite3"} {
+		sql, _, _ := ds.WithDialect(dialect).ToSQL()
+		fmt.Println(sql)
+	}
+
+	// Output:
+	// SELECT ("first" || ' ' || "last") AS "name" FROM "users"
+	// SELECT CONCAT(`first`, ' ', `last`) AS `name` FROM `users`
+	// SELECT (`first` || ' ' || `last`) AS `name` FROM `users`
+}
+
+func ExampleLower() {
+	ds := depiq.From("users").Where(depiq.Lower("email").Eq("bob@example.com"))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "users" WHERE (LOWER("email") = 'bob@example.com') []
+	// SELECT * FROM "users" WHERE (LOWER("email") = ?) [bob@example.com]
+}
+
+func ExamplePosition() {
+	ds := depiq.From("users").Where(depiq.Position("name", "a").Gt(0))
+	for _, dialect := range []string{"postgres", "sqlite3"} {
+		sql, _, _ := ds.WithDialect(dialect).ToSQL()
+		fmt.Println(sql)
+	}
+
+	// Output:
+	// SELECT * FROM "users" WHERE (POSITION('a' IN "name") > 0)
+	// SELECT * FROM `users` WHERE (INSTR(`name`, 'a') > 0)
+}
+
+func ExampleDateSub() {
+	ds := depiq.From("events").
+		Where(depiq.C("created_at").Gt(depiq.DateSub(depiq.Now(), depiq.Interval(7, exp.DayUnit))))
+	for _, dialect := range []string{"postgres", "mysql", "sqlite3"} {
+		sql, _, _ := ds.WithDialect(dialect).ToSQL()
+		fmt.Println(sql)
+	}
+
+	// Output:
+	// SELECT * FROM "events" WHERE ("created_at" > (NOW() - INTERVAL '7 days'))
+	// SELECT * FROM `events` WHERE (`created_at` > DATE_SUB(NOW(), INTERVAL 7 DAY))
+	// SELECT * FROM `events` WHERE (`created_at` > datetime(CURRENT_TIMESTAMP, '-7 days'))
+}
+
+func ExampleDateTrunc() {
+	ds := depiq.From("events").
+		Select(depiq.DateTrunc(exp.MonthUnit, "created_at").As("month"), depiq.COUNT("*")).
+		GroupBy(depiq.DateTrunc(exp.MonthUnit, "created_at"))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT date_trunc('month', "created_at") AS "month", COUNT(*) FROM "events" GROUP BY date_trunc('month', "created_at") []
+}
+
+func ExampleExtract() {
+	ds := depiq.From("events").Where(depiq.Extract(exp.YearUnit, "created_at").Eq(2024))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "events" WHERE (EXTRACT(YEAR FROM "created_at") = 2024) []
+	// SELECT * FROM "events" WHERE (EXTRACT(YEAR FROM "created_at") = ?) [2024]
+}
+
+func ExampleTuple() {
+	t := depiq.Tuple(depiq.C("tenant_id"), depiq.C("id"))
+	ds := depiq.From("test").Where(t.Gt([]interface{}{1, 10}))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = depiq.From("test").Where(t.In([][]interface{}{{1, 2}, {1, 3}})).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE (("tenant_id", "id") > (1, 10)) []
+	// SELECT * FROM "test" WHERE (("tenant_id", "id") > (?, ?)) [1 10]
+	// SELECT * FROM "test" WHERE (("tenant_id", "id") IN ((1, 2), (1, 3))) []
+}
+
+func ExampleTuple_expanded() {
+	// dialects without row value support (e.g. sqlserver) expand the comparison
+	opts := depiq.DefaultDialectOptions()
+	opts.SupportsRowValueComparison = false
+	opts.SupportsRowValueInList = false
+	depiq.RegisterDialect("tuple-example", opts)
+
+	t := depiq.Tuple(depiq.C("tenant_id"), depiq.C("id"))
+	ds := depiq.Dialect("tuple-example").From("test")
+	sql, args, _ := ds.Where(t.Gt([]interface{}{1, 10})).ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Where(t.In([][]interface{}{{1, 2}, {1, 3}})).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE (("tenant_id" > 1) OR (("tenant_id" = 1) AND ("id" > 10))) []
+	// SELECT * FROM "test" WHERE ((("tenant_id" = 1) AND ("id" = 2)) OR (("tenant_id" = 1) AND ("id" = 3))) []
+}
+
 func ExampleCase_search() {
 	ds := depiq.From("test").
 		Select(
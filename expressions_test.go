@@ -2,6 +2,7 @@ package depiq_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/orn-id/depiq"
 	"github.com/orn-id/depiq/exp"
@@ -180,11 +181,180 @@ func (ges *depiqExpressionsSuite) TestAny() {
 	ges.Equal(exp.NewSQLFunctionExpression("ANY ", ds), depiq.Any(ds))
 }
 
+func (ges *depiqExpressionsSuite) TestAnyOf() {
+	ds := depiq.From("test").Select("id")
+	ges.Equal(exp.NewQuantifiedExpression(exp.AnyQuantifierType, ds), depiq.AnyOf(ds))
+}
+
 func (ges *depiqExpressionsSuite) TestAll() {
 	ds := depiq.From("test").Select("id")
 	ges.Equal(exp.NewSQLFunctionExpression("ALL ", ds), depiq.All(ds))
 }
 
+func (ges *depiqExpressionsSuite) TestAllOf() {
+	ds := depiq.From("test").Select("id")
+	ges.Equal(exp.NewQuantifiedExpression(exp.AllQuantifierType, ds), depiq.AllOf(ds))
+}
+
+func (ges *depiqExpressionsSuite) TestExists() {
+	ds := depiq.From("test")
+	ges.Equal(exp.NewExistsExpression(exp.ExistsSubqueryType, ds), depiq.Exists(ds))
+}
+
+func (ges *depiqExpressionsSuite) TestNotExists() {
+	ds := depiq.From("test")
+	ges.Equal(exp.NewExistsExpression(exp.NotExistsSubqueryType, ds), depiq.NotExists(ds))
+}
+
+func (ges *depiqExpressionsSuite) TestJSONPath() {
+	ges.Equal(
+		exp.NewJSONExpression(exp.JSONExtractOp, depiq.I("t.data"), []interface{}{"a", 0}),
+		depiq.JSONPath("t.data", "a", 0),
+	)
+	ges.Equal(
+		exp.NewJSONExpression(exp.JSONExtractOp, depiq.C("data"), []interface{}{"a"}),
+		depiq.JSONPath(depiq.C("data"), "a"),
+	)
+	ges.Equal(
+		exp.NewJSONExpression(exp.JSONExtractOp, depiq.V([]byte(`{"a":1}`)), []interface{}{"a"}),
+		depiq.JSONPath([]byte(`{"a":1}`), "a"),
+	)
+}
+
+func (ges *depiqExpressionsSuite) TestJSONPathText() {
+	ges.Equal(
+		exp.NewJSONExpression(exp.JSONExtractTextOp, depiq.C("data"), []interface{}{"a", "b"}),
+		depiq.JSONPathText("data", "a", "b"),
+	)
+}
+
+func (ges *depiqExpressionsSuite) TestJSONContains() {
+	ges.Equal(exp.NewJSONExpression(exp.JSONContainsOp, depiq.C("data"), `{"a":1}`), depiq.JSONContains("data", `{"a":1}`))
+}
+
+func (ges *depiqExpressionsSuite) TestJSONHasKey() {
+	ges.Equal(exp.NewJSONExpression(exp.JSONHasKeyOp, depiq.C("data"), "a"), depiq.JSONHasKey("data", "a"))
+}
+
+func (ges *depiqExpressionsSuite) TestJSONArrayLength() {
+	ges.Equal(exp.NewJSONExpression(exp.JSONArrayLengthOp, depiq.C("data"), nil), depiq.JSONArrayLength("data"))
+}
+
+func (ges *depiqExpressionsSuite) TestArray() {
+	ges.Equal(exp.NewArrayExpression("a", "b"), depiq.Array("a", "b"))
+	ges.Equal(exp.NewArrayExpression([]int{1, 2}), depiq.Array([]int{1, 2}))
+}
+
+func (ges *depiqExpressionsSuite) TestArrayContains() {
+	ges.Equal(
+		exp.NewBooleanExpression(exp.ArrayContainsOp, depiq.C("tags"), []string{"a"}),
+		depiq.ArrayContains("tags", []string{"a"}),
+	)
+	ges.Equal(
+		exp.NewBooleanExpression(exp.ArrayContainsOp, depiq.Array([]string{"a", "b"}), []string{"a"}),
+		depiq.ArrayContains([]string{"a", "b"}, []string{"a"}),
+	)
+}
+
+func (ges *depiqExpressionsSuite) TestArrayContainedBy() {
+	ges.Equal(
+		exp.NewBooleanExpression(exp.ArrayContainedByOp, depiq.C("tags"), []string{"a"}),
+		depiq.ArrayContainedBy("tags", []string{"a"}),
+	)
+}
+
+func (ges *depiqExpressionsSuite) TestArrayOverlaps() {
+	ges.Equal(
+		exp.NewBooleanExpression(exp.ArrayOverlapsOp, depiq.C("tags"), []string{"a"}),
+		depiq.ArrayOverlaps("tags", []string{"a"}),
+	)
+}
+
+func (ges *depiqExpressionsSuite) TestArrayLength() {
+	ges.Equal(exp.NewSQLFunctionExpression("array_length", depiq.C("tags"), 1), depiq.ArrayLength("tags"))
+}
+
+func (ges *depiqExpressionsSuite) TestUnnest() {
+	ges.Equal(exp.NewSQLFunctionExpression("unnest", depiq.C("tags")), depiq.Unnest("tags"))
+	ges.Equal(exp.NewSQLFunctionExpression("unnest", depiq.Array([]int{1, 2})), depiq.Unnest([]int{1, 2}))
+}
+
+func (ges *depiqExpressionsSuite) TestTextSearch() {
+	ges.Equal(
+		exp.NewTextSearchExpression(exp.TextSearchMatchType, []exp.Expression{depiq.C("title"), depiq.C("body")}, "cats"),
+		depiq.TextSearch("cats", "title", "body"),
+	)
+	ges.Equal(
+		exp.NewTextSearchExpression(exp.TextSearchMatchType, []exp.Expression{depiq.T("docs")}, "cats"),
+		depiq.TextSearch("cats", depiq.T("docs")),
+	)
+}
+
+func (ges *depiqExpressionsSuite) TestTextSearchRank() {
+	ges.Equal(
+		exp.NewTextSearchExpression(exp.TextSearchRankType, []exp.Expression{depiq.C("body")}, "cats"),
+		depiq.TextSearchRank("cats", "body"),
+	)
+}
+
+func (ges *depiqExpressionsSuite) TestConcat() {
+	ges.Equal(
+		exp.NewStringExpression(exp.ConcatOp, depiq.C("a"), " ", depiq.C("b")),
+		depiq.Concat(depiq.C("a"), " ", depiq.C("b")),
+	)
+}
+
+func (ges *depiqExpressionsSuite) TestStringFunctions() {
+	a := depiq.C("a")
+	ges.Equal(exp.NewStringExpression(exp.LowerOp, a), depiq.Lower("a"))
+	ges.Equal(exp.NewStringExpression(exp.UpperOp, a), depiq.Upper("a"))
+	ges.Equal(exp.NewStringExpression(exp.TrimOp, a), depiq.Trim("a"))
+	ges.Equal(exp.NewStringExpression(exp.SubstringOp, a, 1, 2), depiq.Substring("a", 1, 2))
+	ges.Equal(exp.NewStringExpression(exp.SubstringOp, a, 2), depiq.SubstringFrom("a", 2))
+	ges.Equal(exp.NewStringExpression(exp.LengthOp, a), depiq.Length("a"))
+	ges.Equal(exp.NewStringExpression(exp.PositionOp, a, "b"), depiq.Position("a", "b"))
+	ges.Equal(exp.NewStringExpression(exp.ReplaceOp, a, "b", "c"), depiq.Replace("a", "b", "c"))
+	ges.Equal(exp.NewStringExpression(exp.LowerOp, depiq.L("x")), depiq.Lower(depiq.L("x")))
+}
+
+func (ges *depiqExpressionsSuite) TestInterval() {
+	ges.Equal(exp.NewIntervalExpression(7, exp.DayUnit), depiq.Interval(7, exp.DayUnit))
+}
+
+func (ges *depiqExpressionsSuite) TestIntervalFromDuration() {
+	ges.Equal(exp.NewIntervalExpression(90, exp.MinuteUnit), depiq.IntervalFromDuration(90*time.Minute))
+}
+
+func (ges *depiqExpressionsSuite) TestDateAdd() {
+	i := depiq.Interval(7, exp.DayUnit)
+	ges.Equal(exp.NewDateArithmeticExpression(exp.DateAddOp, depiq.C("a"), i), depiq.DateAdd("a", i))
+	ges.Equal(exp.NewDateArithmeticExpression(exp.DateAddOp, depiq.Now(), i), depiq.DateAdd(depiq.Now(), i))
+}
+
+func (ges *depiqExpressionsSuite) TestDateSub() {
+	i := depiq.Interval(7, exp.DayUnit)
+	ges.Equal(exp.NewDateArithmeticExpression(exp.DateSubOp, depiq.C("a"), i), depiq.DateSub("a", i))
+}
+
+func (ges *depiqExpressionsSuite) TestExtract() {
+	ges.Equal(exp.NewExtractExpression(exp.YearUnit, depiq.C("a")), depiq.Extract(exp.YearUnit, "a"))
+}
+
+func (ges *depiqExpressionsSuite) TestDateTrunc() {
+	ges.Equal(exp.NewDateTruncExpression(exp.MonthUnit, depiq.C("a")), depiq.DateTrunc(exp.MonthUnit, "a"))
+}
+
+func (ges *depiqExpressionsSuite) TestCurrentTime() {
+	ges.Equal(exp.NewCurrentTimeExpression(exp.NowCurrentTimeType), depiq.Now())
+	ges.Equal(exp.NewCurrentTimeExpression(exp.TimestampCurrentTimeType), depiq.CurrentTimestamp())
+	ges.Equal(exp.NewCurrentTimeExpression(exp.DateCurrentTimeType), depiq.CurrentDate())
+	ges.Equal(exp.NewCurrentTimeExpression(exp.TimeCurrentTimeType), depiq.CurrentTime())
+}
+
+func (ges *depiqExpressionsSuite) TestTuple() {
+	ges.Equal(exp.NewTupleExpression(depiq.C("a"), depiq.C("b")), depiq.Tuple(depiq.C("a"), depiq.C("b")))
+}
+
 func TestGoquExpressions(t *testing.T) {
 	suite.Run(t, new(depiqExpressionsSuite))
 }
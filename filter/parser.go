@@ -0,0 +1,381 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/orn-id/depiq/internal/errors"
+)
+
+type (
+	// Returned by Parse when a filter is invalid
+	ParseError struct {
+		// The byte offset of the invalid part of the filter
+		Position int
+		Message  string
+	}
+
+	parser struct {
+		filter string
+		pos    int
+		depth  int
+		schema Schema
+	}
+
+	// a value of a condition and the position it starts at
+	value struct {
+		raw string
+		pos int
+	}
+)
+
+const (
+	andRune    = ';'
+	orRune     = '|'
+	sepRune    = ':'
+	listRune   = ','
+	openRune   = '('
+	closeRune  = ')'
+	escapeRune = '\\'
+	// the runes that end a field or operator, values can contain unescaped : (e.g. a timestamp)
+	specialRunes      = "\\:;,|()"
+	valueSpecialRunes = "\\;,|()"
+
+	nullOp       = "null"
+	notNullOp    = "notnull"
+	betweenOp    = "between"
+	notBetweenOp = "notbetween"
+
+	// the maximum number of nested groups, deeper filters are rejected
+	maxDepth = 32
+)
+
+var (
+	booleanOperations = []exp.BooleanOperation{
+		exp.EqOp, exp.NeqOp, exp.IsOp, exp.IsNotOp, exp.GtOp, exp.GteOp, exp.LtOp, exp.LteOp, exp.InOp, exp.NotInOp,
+		exp.LikeOp, exp.NotLikeOp, exp.ILikeOp, exp.NotILikeOp, exp.RegexpLikeOp, exp.RegexpNotLikeOp,
+		exp.RegexpILikeOp, exp.RegexpNotILikeOp, exp.IsDistinctFromOp, exp.IsNotDistinctFromOp, exp.ArrayContainsOp,
+		exp.ArrayContainedByOp, exp.ArrayOverlapsOp,
+	}
+	// operations that compare to a list of values
+	listOperations = map[exp.BooleanOperation]bool{
+		exp.InOp: true, exp.NotInOp: true, exp.ArrayContainsOp: true, exp.ArrayContainedByOp: true,
+		exp.ArrayOverlapsOp: true,
+	}
+	// operations that can only be used with a StringType field
+	patternOperations = map[exp.BooleanOperation]bool{
+		exp.LikeOp: true, exp.NotLikeOp: true, exp.ILikeOp: true, exp.NotILikeOp: true, exp.RegexpLikeOp: true,
+		exp.RegexpNotLikeOp: true, exp.RegexpILikeOp: true, exp.RegexpNotILikeOp: true,
+	}
+)
+
+// Parses a filter into an ExpressionList using the fields of the schema. Filters are usually untrusted input (e.g. a
+// query parameter of an API) so only fields in the schema can be used and every value is converted to the type of its
+// field, the resulting expression can be passed to Where.
+//
+// The grammar of a filter is
+//
+//	filter    = or
+//	or        = and { "|" and }
+//	and       = term { ";" term }
+//	term      = "(" or ")" | condition
+//	condition = field ":" operator [ ":" value { "," value } ]
+//
+// ";" (AND) binds tighter than "|" (OR). The characters \ : ; , | ( ) can be used in a field or value by escaping them
+// with a \, a : does not have to be escaped in a value. Whitespace is never removed.
+//
+// The operators are the names of the exp.BooleanOperations (e.g. eq, neq, gt, in, notin, like, isdistinctfrom,
+// arraycontains), between and notbetween which take two values and null and notnull which take no values. The
+// operators are case insensitive.
+//
+//	status:in:active,pending;created_at:gte:2024-01-01 -> (("status" IN ('active', 'pending')) AND ("created_at" >= ...))
+//	(a:eq:1|b:null);c:between:1,10 -> ((("a" = 1) OR ("b" IS NULL)) AND ("c" BETWEEN 1 AND 10))
+//	deleted:is:null -> ("deleted" IS NULL)
+//	name:like:a\:b% -> ("name" LIKE 'a:b%')
+//
+// An empty filter returns an empty ExpressionList. If the filter is invalid a ParseError is returned.
+func Parse(filter string, schema Schema) (exp.ExpressionList, error) {
+	p := &parser{filter: filter, schema: schema}
+	if filter == "" {
+		return exp.NewExpressionList(exp.AndType), nil
+	}
+	el, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, p.errorf(p.pos, "unexpected %q", p.peek())
+	}
+	return el, nil
+}
+
+func (pe ParseError) Error() string {
+	return errors.New("invalid filter at position %d: %s", pe.Position, pe.Message).Error()
+}
+
+func (p *parser) errorf(pos int, message string, args ...interface{}) error {
+	return ParseError{Position: pos, Message: fmt.Sprintf(message, args...)}
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.filter)
+}
+
+func (p *parser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.filter[p.pos]
+}
+
+// consumes the rune if it is next in the filter
+func (p *parser) accept(r byte) bool {
+	if p.peek() == r && !p.done() {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) or() (exp.ExpressionList, error) {
+	ands := make([]exp.Expression, 0, 1)
+	for {
+		and, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		ands = append(ands, and)
+		if !p.accept(orRune) {
+			break
+		}
+	}
+	if len(ands) == 1 {
+		return ands[0].(exp.ExpressionList), nil
+	}
+	return exp.NewExpressionList(exp.OrType, ands...), nil
+}
+
+func (p *parser) and() (exp.ExpressionList, error) {
+	terms := make([]exp.Expression, 0, 1)
+	for {
+		term, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+		if !p.accept(andRune) {
+			break
+		}
+	}
+	if len(terms) == 1 {
+		if el, ok := terms[0].(exp.ExpressionList); ok {
+			return el, nil
+		}
+	}
+	return exp.NewExpressionList(exp.AndType, terms...), nil
+}
+
+func (p *parser) term() (exp.Expression, error) {
+	start := p.pos
+	if !p.accept(openRune) {
+		return p.condition()
+	}
+	if p.depth == maxDepth {
+		return nil, p.errorf(start, "filter is nested more than %d levels deep", maxDepth)
+	}
+	p.depth++
+	el, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	p.depth--
+	if !p.accept(closeRune) {
+		return nil, p.errorf(p.pos, "expected %q to close %q at position %d", closeRune, openRune, start)
+	}
+	return el, nil
+}
+
+func (p *parser) condition() (exp.Expression, error) {
+	start := p.pos
+	name, err := p.text(specialRunes)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		if p.done() {
+			return nil, p.errorf(start, "expected a field")
+		}
+		return nil, p.errorf(start, "expected a field got %q", p.peek())
+	}
+	field, ok := p.schema[name]
+	if !ok {
+		return nil, p.errorf(start, "unknown field %q", name)
+	}
+	if !p.accept(sepRune) {
+		return nil, p.errorf(p.pos, "expected %q after field %q", sepRune, name)
+	}
+	opPos := p.pos
+	op, err := p.text(specialRunes)
+	if err != nil {
+		return nil, err
+	}
+	var vals []value
+	if p.accept(sepRune) {
+		if vals, err = p.values(); err != nil {
+			return nil, err
+		}
+	}
+	return p.expression(field.column(name), field.Type, strings.ToLower(op), opPos, vals)
+}
+
+func (p *parser) values() ([]value, error) {
+	var vals []value
+	for {
+		pos := p.pos
+		raw, err := p.text(valueSpecialRunes)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, value{raw: raw, pos: pos})
+		if !p.accept(listRune) {
+			return vals, nil
+		}
+	}
+}
+
+// reads text until the next unescaped special rune
+func (p *parser) text(special string) (string, error) {
+	var sb strings.Builder
+	for !p.done() {
+		c := p.peek()
+		if c == escapeRune {
+			if p.pos+1 == len(p.filter) {
+				return "", p.errorf(p.pos, "unterminated escape")
+			}
+			sb.WriteByte(p.filter[p.pos+1])
+			p.pos += 2
+			continue
+		}
+		if strings.IndexByte(special, c) != -1 {
+			break
+		}
+		sb.WriteByte(c)
+		p.pos++
+	}
+	return sb.String(), nil
+}
+
+func (p *parser) expression(
+	col exp.IdentifierExpression,
+	vt ValueType,
+	op string,
+	opPos int,
+	vals []value,
+) (exp.Expression, error) {
+	switch op {
+	case nullOp, notNullOp:
+		if err := p.checkArity(op, vals, 0, 0); err != nil {
+			return nil, err
+		}
+		if op == nullOp {
+			return col.IsNull(), nil
+		}
+		return col.IsNotNull(), nil
+	case betweenOp, notBetweenOp:
+		args, err := p.parseValues(op, vt, vals, 2, 2)
+		if err != nil {
+			return nil, err
+		}
+		rv := exp.NewRangeVal(args[0], args[1])
+		if op == betweenOp {
+			return col.Between(rv), nil
+		}
+		return col.NotBetween(rv), nil
+	}
+	bo, ok := booleanOperation(op)
+	if !ok {
+		return nil, p.errorf(opPos, "unknown operator %q", op)
+	}
+	switch {
+	case bo == exp.IsOp || bo == exp.IsNotOp:
+		if err := p.checkArity(op, vals, 1, 1); err != nil {
+			return nil, err
+		}
+		val, err := p.isValue(vt, vals[0])
+		if err != nil {
+			return nil, err
+		}
+		return exp.NewBooleanExpression(bo, col, val), nil
+	case listOperations[bo]:
+		args, err := p.parseValues(op, vt, vals, 1, -1)
+		if err != nil {
+			return nil, err
+		}
+		return exp.NewBooleanExpression(bo, col, args), nil
+	case patternOperations[bo] && vt != StringType:
+		return nil, p.errorf(opPos, "operator %q requires a %s field", op, StringType)
+	}
+	args, err := p.parseValues(op, vt, vals, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	return exp.NewBooleanExpression(bo, col, args[0]), nil
+}
+
+// checks the number of values of an operator, max is -1 if there is no maximum
+func (p *parser) checkArity(op string, vals []value, min, max int) error {
+	switch {
+	case len(vals) < min && min == 1:
+		return p.errorf(p.pos, "operator %q requires a value", op)
+	case len(vals) < min:
+		return p.errorf(p.pos, "operator %q requires %d values", op, min)
+	case max != -1 && len(vals) > max && max == 0:
+		return p.errorf(vals[0].pos, "operator %q does not accept values", op)
+	case max != -1 && len(vals) > max && max == 1:
+		return p.errorf(vals[max].pos, "operator %q accepts a single value", op)
+	case max != -1 && len(vals) > max:
+		return p.errorf(vals[max].pos, "operator %q accepts at most %d values", op, max)
+	}
+	return nil
+}
+
+func (p *parser) parseValues(op string, vt ValueType, vals []value, min, max int) ([]interface{}, error) {
+	if err := p.checkArity(op, vals, min, max); err != nil {
+		return nil, err
+	}
+	args := make([]interface{}, 0, len(vals))
+	for _, v := range vals {
+		arg, ok := vt.parse(v.raw)
+		if !ok {
+			return nil, p.errorf(v.pos, "invalid %s value %q", vt, v.raw)
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+// the value of an IS or IS NOT operation, true and false can only be used with a BoolType field
+func (p *parser) isValue(vt ValueType, v value) (interface{}, error) {
+	switch strings.ToLower(v.raw) {
+	case "null":
+		return nil, nil
+	case "true", "false":
+		if vt == BoolType {
+			return strings.EqualFold(v.raw, "true"), nil
+		}
+	}
+	if vt == BoolType {
+		return nil, p.errorf(v.pos, "expected null, true or false got %q", v.raw)
+	}
+	return nil, p.errorf(v.pos, "expected null got %q", v.raw)
+}
+
+func booleanOperation(op string) (exp.BooleanOperation, bool) {
+	for _, bo := range booleanOperations {
+		if bo.String() == op {
+			return bo, true
+		}
+	}
+	return 0, false
+}
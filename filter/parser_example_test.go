@@ -0,0 +1,30 @@
+package filter_test
+
+import (
+	"fmt"
+
+	"github.com/orn-id/depiq"
+	"github.com/orn-id/depiq/filter"
+)
+
+func ExampleParse() {
+	schema := filter.Schema{
+		"status":     {Type: filter.StringType},
+		"created_at": {Type: filter.TimeType},
+		"team":       {Column: depiq.T("teams").Col("name")},
+	}
+	where, err := filter.Parse("status:in:active,pending;created_at:gte:2024-01-01|team:null", schema)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	sql, args, _ := depiq.From("users").Where(where).Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	_, err = filter.Parse("status:eq:active;password:eq:secret", schema)
+	fmt.Println(err.Error())
+
+	// Output:
+	// SELECT * FROM "users" WHERE ((("status" IN (?, ?)) AND ("created_at" >= ?)) OR ("teams"."name" IS NULL)) [active pending 2024-01-01 00:00:00 +0000 UTC]
+	// depiq: invalid filter at position 17: unknown field "password"
+}
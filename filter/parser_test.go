@@ -0,0 +1,140 @@
+package filter_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/orn-id/depiq"
+	"github.com/orn-id/depiq/filter"
+	"github.com/stretchr/testify/suite"
+)
+
+type parserSuite struct {
+	suite.Suite
+	schema filter.Schema
+}
+
+func TestParserSuite(t *testing.T) {
+	suite.Run(t, &parserSuite{})
+}
+
+func (ps *parserSuite) SetupTest() {
+	ps.schema = filter.Schema{
+		"status":     {},
+		"name":       {Type: filter.StringType},
+		"age":        {Type: filter.IntType},
+		"score":      {Type: filter.FloatType},
+		"active":     {Type: filter.BoolType},
+		"created_at": {Type: filter.TimeType},
+		"team":       {Column: depiq.T("teams").Col("name")},
+		"a.b":        {Type: filter.IntType},
+	}
+}
+
+func (ps *parserSuite) assertSQL(f, expectedSQL string, expectedArgs ...interface{}) {
+	el, err := filter.Parse(f, ps.schema)
+	ps.Require().NoError(err, f)
+	sql, args, err := depiq.From("test").Where(el).Prepared(true).ToSQL()
+	ps.Require().NoError(err, f)
+	ps.Equal(expectedSQL, sql, f)
+	if len(expectedArgs) == 0 {
+		expectedArgs = []interface{}{}
+	}
+	ps.Equal(expectedArgs, args, f)
+}
+
+func (ps *parserSuite) assertError(f string, position int, message string) {
+	el, err := filter.Parse(f, ps.schema)
+	ps.Nil(el, f)
+	ps.Equal(filter.ParseError{Position: position, Message: message}, err, f)
+}
+
+func (ps *parserSuite) TestParse() {
+	ps.assertSQL("", `SELECT * FROM "test"`)
+	ps.assertSQL("status:eq:active", `SELECT * FROM "test" WHERE ("status" = ?)`, "active")
+	ps.assertSQL(
+		"status:in:a,b;created_at:gte:2024-01-01",
+		`SELECT * FROM "test" WHERE (("status" IN (?, ?)) AND ("created_at" >= ?))`,
+		"a", "b", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	)
+	ps.assertSQL(
+		"age:gt:18|active:is:true;score:lte:1.5",
+		`SELECT * FROM "test" WHERE (("age" > ?) OR (("active" IS TRUE) AND ("score" <= ?)))`,
+		int64(18), 1.5,
+	)
+	ps.assertSQL(
+		"(age:gt:18|active:is:FALSE);score:lte:1.5",
+		`SELECT * FROM "test" WHERE ((("age" > ?) OR ("active" IS FALSE)) AND ("score" <= ?))`,
+		int64(18), 1.5,
+	)
+	ps.assertSQL("((age:eq:1))", `SELECT * FROM "test" WHERE ("age" = ?)`, int64(1))
+	ps.assertSQL(
+		"created_at:gte:2024-01-02T03:04:05Z",
+		`SELECT * FROM "test" WHERE ("created_at" >= ?)`,
+		time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	)
+}
+
+func (ps *parserSuite) TestParse_operators() {
+	ps.assertSQL("age:neq:1", `SELECT * FROM "test" WHERE ("age" != ?)`, int64(1))
+	ps.assertSQL("age:GTE:1", `SELECT * FROM "test" WHERE ("age" >= ?)`, int64(1))
+	ps.assertSQL("age:lt:1", `SELECT * FROM "test" WHERE ("age" < ?)`, int64(1))
+	ps.assertSQL("age:notin:1,2", `SELECT * FROM "test" WHERE ("age" NOT IN (?, ?))`, int64(1), int64(2))
+	ps.assertSQL("name:like:a%", `SELECT * FROM "test" WHERE ("name" LIKE ?)`, "a%")
+	ps.assertSQL("name:notilike:a%", `SELECT * FROM "test" WHERE ("name" NOT ILIKE ?)`, "a%")
+	ps.assertSQL("name:regexplike:^a", `SELECT * FROM "test" WHERE ("name" ~ ?)`, "^a")
+	ps.assertSQL("name:regexpnotilike:^a", `SELECT * FROM "test" WHERE ("name" !~* ?)`, "^a")
+	ps.assertSQL("age:isdistinctfrom:1", `SELECT * FROM "test" WHERE ("age" IS DISTINCT FROM ?)`, int64(1))
+	ps.assertSQL("age:between:1,10", `SELECT * FROM "test" WHERE ("age" BETWEEN ? AND ?)`, int64(1), int64(10))
+	ps.assertSQL("age:notbetween:1,10", `SELECT * FROM "test" WHERE ("age" NOT BETWEEN ? AND ?)`, int64(1), int64(10))
+	ps.assertSQL("age:null", `SELECT * FROM "test" WHERE ("age" IS NULL)`)
+	ps.assertSQL("age:notnull", `SELECT * FROM "test" WHERE ("age" IS NOT NULL)`)
+	ps.assertSQL("age:is:null", `SELECT * FROM "test" WHERE ("age" IS NULL)`)
+	ps.assertSQL("active:isnot:true", `SELECT * FROM "test" WHERE ("active" IS NOT TRUE)`)
+	ps.assertSQL("status:eq:", `SELECT * FROM "test" WHERE ("status" = ?)`, "")
+}
+
+func (ps *parserSuite) TestParse_columns() {
+	ps.assertSQL("team:eq:a", `SELECT * FROM "test" WHERE ("teams"."name" = ?)`, "a")
+	// field names are never parsed into a table and column
+	ps.assertSQL("a.b:eq:1", `SELECT * FROM "test" WHERE ("a.b" = ?)`, int64(1))
+}
+
+func (ps *parserSuite) TestParse_escapes() {
+	ps.assertSQL(`name:eq:a\:b\;c\,d\|e\(f\)g\\h`, `SELECT * FROM "test" WHERE ("name" = ?)`, `a:b;c,d|e(f)g\h`)
+	ps.assertSQL(`name:eq:a:b`, `SELECT * FROM "test" WHERE ("name" = ?)`, "a:b")
+	ps.assertSQL(`name:in:a\,b,c`, `SELECT * FROM "test" WHERE ("name" IN (?, ?))`, "a,b", "c")
+	ps.assertSQL(`name:eq: a `, `SELECT * FROM "test" WHERE ("name" = ?)`, " a ")
+}
+
+func (ps *parserSuite) TestParse_errors() {
+	ps.assertError("unknown:eq:1", 0, `unknown field "unknown"`)
+	ps.assertError("age:eq:1;unknown:eq:1", 9, `unknown field "unknown"`)
+	ps.assertError("age", 3, `expected ':' after field "age"`)
+	ps.assertError("age:nope:1", 4, `unknown operator "nope"`)
+	ps.assertError("age:eq:a", 7, `invalid int value "a"`)
+	ps.assertError("score:eq:a", 9, `invalid float value "a"`)
+	ps.assertError("active:eq:a", 10, `invalid bool value "a"`)
+	ps.assertError("created_at:eq:yesterday", 14, `invalid time value "yesterday"`)
+	ps.assertError("age:in:1,a", 9, `invalid int value "a"`)
+	ps.assertError("age:eq", 6, `operator "eq" requires a value`)
+	ps.assertError("age:eq:1,2", 9, `operator "eq" accepts a single value`)
+	ps.assertError("age:between:1", 13, `operator "between" requires 2 values`)
+	ps.assertError("age:null:1", 9, `operator "null" does not accept values`)
+	ps.assertError("age:is:1", 7, `expected null got "1"`)
+	ps.assertError("active:is:1", 10, `expected null, true or false got "1"`)
+	ps.assertError("age:like:1", 4, `operator "like" requires a string field`)
+	ps.assertError(";", 0, `expected a field got ';'`)
+	ps.assertError("age:eq:1;", 9, `expected a field`)
+	ps.assertError("(age:eq:1", 9, `expected ')' to close '(' at position 0`)
+	ps.assertError("age:eq:1)", 8, `unexpected ')'`)
+	ps.assertError(`name:eq:a\`, 9, `unterminated escape`)
+	ps.assertError(strings.Repeat("(", 33)+"age:null"+strings.Repeat(")", 33), 32,
+		"filter is nested more than 32 levels deep")
+}
+
+func (ps *parserSuite) TestParseError() {
+	_, err := filter.Parse("age:eq:a", ps.schema)
+	ps.EqualError(err, `depiq: invalid filter at position 7: invalid int value "a"`)
+}
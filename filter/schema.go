@@ -0,0 +1,91 @@
+package filter
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/orn-id/depiq/exp"
+)
+
+type (
+	// The type of the values a Field can be compared to
+	ValueType int
+
+	// A field that can be used in a filter.
+	Field struct {
+		// The column the field is compared to. If nil a column with the name of the field is used, the name is never
+		// parsed so a field named "a.b" is the column "a.b" and not column "b" of table "a".
+		Column exp.IdentifierExpression
+		// The type values are converted to before they are used in the expression
+		Type ValueType
+	}
+
+	// The fields that can be used in a filter by name. Fields that are not in the schema are rejected by Parse.
+	Schema map[string]Field
+)
+
+const (
+	// Values are used as is
+	StringType ValueType = iota
+	// Values are parsed as base 10 int64s
+	IntType
+	// Values are parsed as float64s
+	FloatType
+	// Values are parsed with strconv.ParseBool
+	BoolType
+	// Values are parsed as RFC3339 timestamps or 2006-01-02 dates
+	TimeType
+)
+
+var dateLayouts = []string{time.RFC3339Nano, "2006-01-02"}
+
+func (vt ValueType) String() string {
+	switch vt {
+	case StringType:
+		return "string"
+	case IntType:
+		return "int"
+	case FloatType:
+		return "float"
+	case BoolType:
+		return "bool"
+	case TimeType:
+		return "time"
+	}
+	return strconv.Itoa(int(vt))
+}
+
+// Converts a value from a filter into the type, false is returned if the value is invalid
+func (vt ValueType) parse(val string) (interface{}, bool) {
+	switch vt {
+	case StringType:
+		return val, true
+	case IntType:
+		if i, err := strconv.ParseInt(val, 10, 64); err == nil {
+			return i, true
+		}
+	case FloatType:
+		if f, err := strconv.ParseFloat(val, 64); err == nil {
+			return f, true
+		}
+	case BoolType:
+		if b, err := strconv.ParseBool(val); err == nil {
+			return b, true
+		}
+	case TimeType:
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, val); err == nil {
+				return t, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Returns the column the field is compared to
+func (f Field) column(name string) exp.IdentifierExpression {
+	if f.Column != nil {
+		return f.Column
+	}
+	return exp.NewIdentifierExpression("", "", name)
+}
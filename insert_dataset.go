@@ -80,6 +80,11 @@ func (id *InsertDataset) GetClauses() exp.InsertClauses {
 	return id.clauses
 }
 
+// Returns a copy of the dataset using the clauses. Used by exp.Rewrite to rebuild a rewritten dataset.
+func (id *InsertDataset) WithClauses(clauses exp.InsertClauses) exp.Expression {
+	return id.copy(clauses)
+}
+
 // used interally to copy the dataset
 func (id *InsertDataset) copy(clauses exp.InsertClauses) *InsertDataset {
 	return &InsertDataset{
@@ -85,6 +85,16 @@ func (ids *insertDatasetSuite) TestGetClauses() {
 	ids.Equal(ce, ds.GetClauses())
 }
 
+func (ids *insertDatasetSuite) TestWithClauses() {
+	ds := depiq.Insert("test").WithDialect("mock")
+	ce := exp.NewInsertClauses().SetInto(depiq.I("other"))
+	wds, ok := ds.WithClauses(ce).(*depiq.InsertDataset)
+	ids.True(ok)
+	ids.Equal(ce, wds.GetClauses())
+	ids.Equal(ds.Dialect(), wds.Dialect())
+	ids.NotEqual(ce, ds.GetClauses())
+}
+
 func (ids *insertDatasetSuite) TestWith() {
 	from := depiq.From("cte")
 	bd := depiq.Insert("items")
@@ -0,0 +1,117 @@
+package depiq
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"time"
+
+	"github.com/orn-id/depiq/exec"
+	"github.com/orn-id/depiq/internal/errors"
+)
+
+var (
+	// returned by the ForEach callback of Iter when the loop is stopped early
+	errStopIteration = errors.New("iteration stopped")
+
+	sqlScannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	timeType       = reflect.TypeOf(time.Time{})
+)
+
+// Generates the SELECT sql for the dataset and calls fn for every row without loading all rows into memory, ForEach is
+// a function because methods can not have type parameters
+//
+//	err := depiq.ForEach(ctx, db.From("user").Where(C("active").IsTrue()), func(u User) error {
+//		return csvWriter.Write([]string{u.FirstName, u.LastName})
+//	})
+//
+// Rows are scanned into structs (or pointers to structs) like Fetch, the column map of the struct is only created
+// once, any other type is scanned like ScanVal. If the dataset selects all columns only the columns of the struct are
+// selected. The iteration stops at the first error returned by fn and the error is returned, the rows are always
+// closed.
+func ForEach[T any](ctx context.Context, sd *SelectDataset, fn func(row T) error) error {
+	if sd.queryFactory == nil {
+		return ErrQueryFactoryNotFoundError
+	}
+	rowType := reflect.TypeOf((*T)(nil)).Elem()
+	ds := sd
+	if st := structRowType(rowType); st != nil && sd.GetClauses().IsDefaultSelect() {
+		ds = sd.Select(reflect.New(st).Interface())
+	}
+	scanner, err := ds.Executor().ScannerContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = scanner.Close() }()
+	scan := rowScanFunc[T](scanner, rowType)
+	for scanner.Next() {
+		var row T
+		if err := scan(&row); err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// Returns an iterator over the rows of the dataset that can be used with range-over-func (go 1.23+), see ForEach
+//
+//	for user, err := range depiq.Iter[User](ctx, db.From("user")) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(user.FirstName)
+//	}
+//
+// An error ends the iteration and is yielded with the zero value of T, breaking out of the loop closes the rows.
+func Iter[T any](ctx context.Context, sd *SelectDataset) func(yield func(row T, err error) bool) {
+	return func(yield func(row T, err error) bool) {
+		err := ForEach(ctx, sd, func(row T) error {
+			if !yield(row, nil) {
+				return errStopIteration
+			}
+			return nil
+		})
+		if err != nil && err != errStopIteration {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// returns the function used to scan the current row of the scanner into a row of type T
+func rowScanFunc[T any](scanner exec.Scanner, rowType reflect.Type) func(row *T) error {
+	st := structRowType(rowType)
+	switch {
+	case st == nil:
+		return func(row *T) error {
+			return scanner.ScanVal(row)
+		}
+	case rowType.Kind() == reflect.Ptr:
+		return func(row *T) error {
+			v := reflect.New(st)
+			if err := scanner.ScanStruct(v.Interface()); err != nil {
+				return err
+			}
+			reflect.ValueOf(row).Elem().Set(v)
+			return nil
+		}
+	}
+	return func(row *T) error {
+		return scanner.ScanStruct(row)
+	}
+}
+
+// returns the struct type of a row that is scanned into a struct or a pointer to a struct, nil if the row is scanned as
+// a value (e.g. an int, a time.Time or a sql.Scanner)
+func structRowType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == timeType || reflect.PtrTo(t).Implements(sqlScannerType) {
+		return nil
+	}
+	return t
+}
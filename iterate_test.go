@@ -0,0 +1,140 @@
+package depiq_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/orn-id/depiq"
+	"github.com/orn-id/depiq/internal/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+type iterateSuite struct {
+	suite.Suite
+}
+
+func (is *iterateSuite) mockDB(query string, rows *sqlmock.Rows) (*depiq.Database, sqlmock.Sqlmock) {
+	mDB, sqlMock, err := sqlmock.New()
+	is.Require().NoError(err)
+	sqlMock.ExpectQuery(query).WithArgs().WillReturnRows(rows).RowsWillBeClosed()
+	return depiq.New("mock", mDB), sqlMock
+}
+
+func (is *iterateSuite) itemRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"address", "name"}).
+		FromCSVString("111 Test Addr,Test1\n211 Test Addr,Test2\n311 Test Addr,Test3")
+}
+
+func (is *iterateSuite) TestForEach() {
+	db, sqlMock := is.mockDB(`SELECT "address", "name" FROM "items"`, is.itemRows())
+	var items []dsTestActionItem
+	is.NoError(depiq.ForEach(context.Background(), db.From("items"), func(item dsTestActionItem) error {
+		items = append(items, item)
+		return nil
+	}))
+	is.Equal([]dsTestActionItem{
+		{Address: "111 Test Addr", Name: "Test1"},
+		{Address: "211 Test Addr", Name: "Test2"},
+		{Address: "311 Test Addr", Name: "Test3"},
+	}, items)
+	is.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (is *iterateSuite) TestForEach_withPointers() {
+	db, sqlMock := is.mockDB(`SELECT "address", "name" FROM "items"`, is.itemRows())
+	var items []*dsTestActionItem
+	is.NoError(depiq.ForEach(context.Background(), db.From("items"), func(item *dsTestActionItem) error {
+		items = append(items, item)
+		return nil
+	}))
+	is.Len(items, 3)
+	is.Equal(&dsTestActionItem{Address: "311 Test Addr", Name: "Test3"}, items[2])
+	is.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (is *iterateSuite) TestForEach_withValues() {
+	db, sqlMock := is.mockDB(`SELECT "name" FROM "items"`, sqlmock.NewRows([]string{"name"}).
+		FromCSVString("Test1\nTest2"))
+	var names []string
+	is.NoError(depiq.ForEach(context.Background(), db.From("items").Select("name"), func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	is.Equal([]string{"Test1", "Test2"}, names)
+	is.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (is *iterateSuite) TestForEach_stopsOnError() {
+	db, sqlMock := is.mockDB(`SELECT "address", "name" FROM "items"`, is.itemRows())
+	expectedErr := errors.New("test error")
+	var count int
+	err := depiq.ForEach(context.Background(), db.From("items"), func(item dsTestActionItem) error {
+		count++
+		return expectedErr
+	})
+	is.Equal(expectedErr, err)
+	is.Equal(1, count)
+	// the rows are closed
+	is.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (is *iterateSuite) TestForEach_errors() {
+	db, sqlMock := is.mockDB(`SELECT "test" FROM "items"`, sqlmock.NewRows([]string{"test"}).
+		FromCSVString("test1\ntest2"))
+	err := depiq.ForEach(context.Background(), db.From("items").Select("test"), func(item dsTestActionItem) error {
+		return nil
+	})
+	is.EqualError(err, `depiq: unable to find corresponding field to column "test" returned by query`)
+	is.NoError(sqlMock.ExpectationsWereMet())
+
+	err = depiq.ForEach(context.Background(), depiq.From("items"), func(item dsTestActionItem) error {
+		return nil
+	})
+	is.Equal(depiq.ErrQueryFactoryNotFoundError, err)
+
+	db, _ = is.mockDB(`SELECT`, is.itemRows())
+	expectedErr := errors.New("test error")
+	err = depiq.ForEach(context.Background(), db.From("items").SetError(expectedErr), func(item dsTestActionItem) error {
+		return nil
+	})
+	is.Equal(expectedErr, err)
+}
+
+func (is *iterateSuite) TestIter() {
+	db, sqlMock := is.mockDB(`SELECT "address", "name" FROM "items"`, is.itemRows())
+	var names []string
+	depiq.Iter[dsTestActionItem](context.Background(), db.From("items"))(func(item dsTestActionItem, err error) bool {
+		is.NoError(err)
+		names = append(names, item.Name)
+		return true
+	})
+	is.Equal([]string{"Test1", "Test2", "Test3"}, names)
+	is.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (is *iterateSuite) TestIter_break() {
+	db, sqlMock := is.mockDB(`SELECT "address", "name" FROM "items"`, is.itemRows())
+	var names []string
+	depiq.Iter[dsTestActionItem](context.Background(), db.From("items"))(func(item dsTestActionItem, err error) bool {
+		names = append(names, item.Name)
+		return len(names) < 2
+	})
+	is.Equal([]string{"Test1", "Test2"}, names)
+	// the rows are closed
+	is.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (is *iterateSuite) TestIter_error() {
+	var errs []error
+	depiq.Iter[dsTestActionItem](context.Background(), depiq.From("items"))(func(item dsTestActionItem, err error) bool {
+		is.Equal(dsTestActionItem{}, item)
+		errs = append(errs, err)
+		return true
+	})
+	is.Equal([]error{depiq.ErrQueryFactoryNotFoundError}, errs)
+}
+
+func TestIterateSuite(t *testing.T) {
+	suite.Run(t, new(iterateSuite))
+}
@@ -0,0 +1,477 @@
+package depiq
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/orn-id/depiq/internal/errors"
+	"github.com/orn-id/depiq/internal/util"
+)
+
+type (
+	// The cursors of a page returned by Paginator#Fetch
+	Page struct {
+		// The cursor of the next page, empty if this is the last page
+		NextCursor string
+		// The cursor of the previous page, empty if this is the first page
+		PrevCursor string
+	}
+
+	// Fetches a page of a SelectDataset using keyset (seek) pagination, see SelectDataset#Paginate
+	Paginator struct {
+		dataset  *SelectDataset
+		items    []seekItem
+		pageSize uint
+		// the values of the order columns of the row the cursor points to
+		after    []interface{}
+		backward bool
+		err      error
+	}
+
+	// a column of the order of a paginated dataset
+	seekItem struct {
+		col   exp.IdentifierExpression
+		asc   bool
+		nulls exp.NullSortType
+	}
+
+	pageCursor struct {
+		Backward bool     `json:"b,omitempty"`
+		Order    string   `json:"o"`
+		Values   []string `json:"v"`
+	}
+)
+
+var (
+	// Returned by SelectDataset#Paginate when a cursor can not be decoded or was not signed with the pagination secret
+	ErrInvalidCursor = errors.New("invalid pagination cursor")
+	// Returned by SelectDataset#Paginate when a cursor was created for a dataset with a different order
+	ErrCursorOrderMismatch = errors.New("pagination cursor does not match the order of the dataset")
+	// Returned by SelectDataset#Paginate when a cursor has to be signed or verified before a secret was set with
+	// SetPaginationSecret
+	ErrPaginationSecretNotSet = errors.New("pagination secret is not set, see depiq.SetPaginationSecret")
+
+	paginationSecret []byte
+)
+
+// Creates a Paginator to fetch the page of the dataset after the cursor using keyset (seek) pagination, an empty cursor
+// fetches the first page. Instead of an OFFSET the rows of the page are found by comparing the columns of the Order of
+// the dataset with the values of the row the cursor points to, the order must only contain columns and should be
+// unique (e.g. end with the primary key)
+//
+//	var users []User
+//	page, err := db.From("user").
+//		Order(C("created").Desc(), C("id").Asc()).
+//		Paginate(cursor, 20).
+//		Fetch(&users)
+//	// SELECT * FROM "user" WHERE (("created" < '2021-01-01T00:00:00Z') OR (("created" = '2021-01-01T00:00:00Z')
+//	// AND ("id" > 10))) ORDER BY "created" DESC, "id" ASC LIMIT 21
+//
+// If all columns are sorted in the same direction without NullsFirst or NullsLast the row value form is used (e.g.
+// ("created", "id") > ('2021-01-01T00:00:00Z', 10)), dialects that do not support row value comparisons expand it
+// into the equivalent AND/OR chain. Columns that can be NULL must use NullsFirst or NullsLast so NULL values can be
+// compared.
+//
+// The cursors of the returned Page are signed with the secret set by SetPaginationSecret and bound to the order of
+// the dataset, a cursor that has been modified or was created for another order is rejected with ErrInvalidCursor or
+// ErrCursorOrderMismatch. The LIMIT and OFFSET of the dataset are replaced.
+//
+// SetPaginationSecret must be called with a secret shared by all instances of the program before cursors are
+// created or verified, otherwise ErrPaginationSecretNotSet is returned. A first page can be selected without a secret
+// but its cursors can not be created.
+func (sd *SelectDataset) Paginate(cursor string, pageSize uint) *Paginator {
+	p := &Paginator{dataset: sd, pageSize: pageSize}
+	p.items, p.err = seekItems(sd.clauses.Order())
+	if p.err == nil && pageSize == 0 {
+		p.err = errors.New("page size must be greater than 0")
+	}
+	if p.err == nil && cursor != "" {
+		p.err = p.decodeCursor(cursor)
+	}
+	return p
+}
+
+// Generates the SELECT sql of the page, one more row than the page size is selected to know if there is another page.
+//
+// Errors:
+//   - The dataset is not ordered by columns
+//   - The cursor is invalid
+//   - There is an error generating the SQL
+func (p *Paginator) ToSQL() (sql string, params []interface{}, err error) {
+	return p.pageDataset().ToSQL()
+}
+
+// Fetches the rows of the page into a slice of structs, see Paginator#FetchContext.
+//
+// i: A pointer to a slice of structs
+func (p *Paginator) Fetch(i interface{}) (*Page, error) {
+	return p.FetchContext(context.Background(), i)
+}
+
+// Fetches the rows of the page into a slice of structs and returns the cursors of the next and previous pages. The
+// values of the order columns are read from the fields of the structs so they must be columns of the struct.
+//
+// i: A pointer to a slice of structs
+func (p *Paginator) FetchContext(ctx context.Context, i interface{}) (*Page, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	rows := reflect.Indirect(reflect.ValueOf(i))
+	start := 0
+	if rows.Kind() == reflect.Slice {
+		start = rows.Len()
+	}
+	if err := p.pageDataset().FetchContext(ctx, i); err != nil {
+		return nil, err
+	}
+	hasMore := rows.Len()-start > int(p.pageSize)
+	if hasMore {
+		rows.Set(rows.Slice(0, start+int(p.pageSize)))
+	}
+	pageRows := rows.Slice(start, rows.Len())
+	if p.backward {
+		swap := reflect.Swapper(pageRows.Interface())
+		for l, r := 0, pageRows.Len()-1; l < r; l, r = l+1, r-1 {
+			swap(l, r)
+		}
+	}
+
+	// the cursor values are used for the other page if the page is empty
+	first, last := p.after, p.after
+	if pageRows.Len() > 0 {
+		cm, err := util.GetColumnMap(i)
+		if err != nil {
+			return nil, err
+		}
+		if first, err = p.rowValues(cm, pageRows.Index(0)); err != nil {
+			return nil, err
+		}
+		if last, err = p.rowValues(cm, pageRows.Index(pageRows.Len()-1)); err != nil {
+			return nil, err
+		}
+	}
+	hasNext, hasPrev := hasMore, p.after != nil
+	if p.backward {
+		hasNext, hasPrev = true, hasMore
+	}
+	page := &Page{}
+	var err error
+	if hasNext && last != nil {
+		if page.NextCursor, err = p.encodeCursor(last, false); err != nil {
+			return nil, err
+		}
+	}
+	if hasPrev && first != nil {
+		if page.PrevCursor, err = p.encodeCursor(first, true); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// returns the dataset used to select the page
+func (p *Paginator) pageDataset() *SelectDataset {
+	ds := p.dataset.ClearOffset().Limit(p.pageSize + 1)
+	if p.err != nil {
+		return ds.SetError(p.err)
+	}
+	if p.after == nil {
+		return ds
+	}
+	items := p.items
+	if p.backward {
+		// the previous page is selected in the reverse order and reversed after it is fetched
+		items = make([]seekItem, len(p.items))
+		oes := make([]exp.OrderedExpression, len(p.items))
+		for i, item := range p.items {
+			items[i] = item.reverse()
+			oes[i] = items[i].orderedExpression()
+		}
+		ds = ds.Order(oes...)
+	}
+	return ds.Where(seekExpression(items, p.after))
+}
+
+// returns the values of the order columns of a row
+func (p *Paginator) rowValues(cm util.ColumnMap, row reflect.Value) ([]interface{}, error) {
+	row = reflect.Indirect(row)
+	vals := make([]interface{}, len(p.items))
+	for i, item := range p.items {
+		cd, ok := item.columnData(cm)
+		if !ok {
+			return nil, errors.New("unable to find the field of order column %s to paginate", item.name())
+		}
+		var val driver.Value
+		if f, ok := util.SafeGetFieldByIndex(row, cd.FieldIndex); ok {
+			v, err := driver.DefaultParameterConverter.ConvertValue(f.Interface())
+			if err != nil {
+				return nil, errors.New("unable to paginate on order column %s: %s", item.name(), err.Error())
+			}
+			val = v
+		}
+		if val == nil && item.nulls == exp.NoNullsSortType {
+			return nil, errors.New(
+				"order column %s is NULL, use NullsFirst or NullsLast to paginate on a nullable column", item.name(),
+			)
+		}
+		vals[i] = val
+	}
+	return vals, nil
+}
+
+func (p *Paginator) encodeCursor(vals []interface{}, backward bool) (string, error) {
+	pc := pageCursor{Backward: backward, Order: p.orderKey(), Values: make([]string, len(vals))}
+	for i, val := range vals {
+		pc.Values[i] = encodeCursorValue(val)
+	}
+	payload, err := json.Marshal(pc)
+	if err != nil {
+		return "", err
+	}
+	sig, err := signCursor(payload)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+func (p *Paginator) decodeCursor(cursor string) error {
+	parts := strings.Split(cursor, ".")
+	if len(parts) != 2 {
+		return ErrInvalidCursor
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	expectedSig, err := signCursor(payload)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(sig, expectedSig) {
+		return ErrInvalidCursor
+	}
+	var pc pageCursor
+	if err := json.Unmarshal(payload, &pc); err != nil {
+		return ErrInvalidCursor
+	}
+	if pc.Order != p.orderKey() {
+		return ErrCursorOrderMismatch
+	}
+	if len(pc.Values) != len(p.items) {
+		return ErrInvalidCursor
+	}
+	p.after = make([]interface{}, len(pc.Values))
+	for i, v := range pc.Values {
+		if p.after[i], err = decodeCursorValue(v); err != nil {
+			return ErrInvalidCursor
+		}
+	}
+	p.backward = pc.Backward
+	return nil
+}
+
+// returns a key of the order columns and directions that binds a cursor to the order it was created for
+func (p *Paginator) orderKey() string {
+	parts := make([]string, len(p.items))
+	for i, item := range p.items {
+		parts[i] = fmt.Sprintf("%s %t %d", item.name(), item.asc, item.nulls)
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, ",")))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func signCursor(payload []byte) ([]byte, error) {
+	if len(paginationSecret) == 0 {
+		return nil, ErrPaginationSecretNotSet
+	}
+	mac := hmac.New(sha256.New, paginationSecret)
+	mac.Write(payload) // nolint:errcheck // writing to a hash never fails
+	return mac.Sum(nil), nil
+}
+
+// values are tagged with their type so they are decoded into the same driver.Value
+func encodeCursorValue(val interface{}) string {
+	switch v := val.(type) {
+	case int64:
+		return "i" + strconv.FormatInt(v, 10)
+	case float64:
+		return "f" + strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		return "b" + strconv.FormatBool(v)
+	case string:
+		return "s" + v
+	case []byte:
+		return "x" + base64.RawURLEncoding.EncodeToString(v)
+	case time.Time:
+		return "t" + v.Format(time.RFC3339Nano)
+	}
+	return "n"
+}
+
+func decodeCursorValue(v string) (interface{}, error) {
+	if v == "" {
+		return nil, ErrInvalidCursor
+	}
+	tag, val := v[0], v[1:]
+	switch tag {
+	case 'i':
+		return strconv.ParseInt(val, 10, 64)
+	case 'f':
+		return strconv.ParseFloat(val, 64)
+	case 'b':
+		return strconv.ParseBool(val)
+	case 's':
+		return val, nil
+	case 'x':
+		return base64.RawURLEncoding.DecodeString(val)
+	case 't':
+		return time.Parse(time.RFC3339Nano, val)
+	case 'n':
+		return nil, nil
+	}
+	return nil, ErrInvalidCursor
+}
+
+func seekItems(order exp.ColumnListExpression) ([]seekItem, error) {
+	if order == nil || order.IsEmpty() {
+		return nil, errors.New("a dataset must be ordered to paginate, see Order")
+	}
+	cols := order.Columns()
+	items := make([]seekItem, 0, len(cols))
+	for _, c := range cols {
+		oe, ok := c.(exp.OrderedExpression)
+		if !ok {
+			return nil, errors.New("unsupported order expression %T", c)
+		}
+		col, ok := oe.SortExpression().(exp.IdentifierExpression)
+		if !ok {
+			return nil, errors.New("a dataset can only be paginated by columns, got order expression %T",
+				oe.SortExpression())
+		}
+		items = append(items, seekItem{col: col, asc: oe.IsAsc(), nulls: oe.NullSortType()})
+	}
+	return items, nil
+}
+
+// returns the predicate selecting the rows after the values in the order of the items
+//
+//	a ASC, b DESC -> (a > 1) OR ((a = 1) AND (b < 2))
+func seekExpression(items []seekItem, vals []interface{}) exp.Expression {
+	if canCompareRowValues(items, vals) {
+		cols := make([]interface{}, len(items))
+		for i, item := range items {
+			cols[i] = item.col
+		}
+		tuple := exp.NewTupleExpression(cols...)
+		if items[0].asc {
+			return tuple.Gt(vals)
+		}
+		return tuple.Lt(vals)
+	}
+	ors := make([]exp.Expression, 0, len(items))
+	for i, item := range items {
+		after := item.after(vals[i])
+		if after == nil {
+			continue
+		}
+		ands := make([]exp.Expression, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, items[j].equal(vals[j]))
+		}
+		ors = append(ors, exp.NewExpressionList(exp.AndType, append(ands, after)...))
+	}
+	if len(ors) == 0 {
+		// there are no rows after a NULL sorted last in every column
+		return exp.NewLiteralExpression("(1 = 0)")
+	}
+	return exp.NewExpressionList(exp.OrType, ors...)
+}
+
+// row values can be compared if the columns are sorted in the same direction and do not contain NULL values
+func canCompareRowValues(items []seekItem, vals []interface{}) bool {
+	if len(items) < 2 {
+		return false
+	}
+	for i, item := range items {
+		if item.asc != items[0].asc || item.nulls != exp.NoNullsSortType || vals[i] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s seekItem) name() string {
+	return fmt.Sprintf("%s.%s.%v", s.col.GetSchema(), s.col.GetTable(), s.col.GetCol())
+}
+
+// returns the column of the struct for the order column, columns qualified with a table are first looked up as the
+// column of a nested struct (e.g. "address.street")
+func (s seekItem) columnData(cm util.ColumnMap) (util.ColumnData, bool) {
+	col := fmt.Sprint(s.col.GetCol())
+	if table := s.col.GetTable(); table != "" {
+		if cd, ok := cm[table+"."+col]; ok {
+			return cd, true
+		}
+	}
+	cd, ok := cm[col]
+	return cd, ok
+}
+
+func (s seekItem) reverse() seekItem {
+	s.asc = !s.asc
+	switch s.nulls {
+	case exp.NullsFirstSortType:
+		s.nulls = exp.NullsLastSortType
+	case exp.NullsLastSortType:
+		s.nulls = exp.NullsFirstSortType
+	}
+	return s
+}
+
+func (s seekItem) orderedExpression() exp.OrderedExpression {
+	dir := exp.DescSortDir
+	if s.asc {
+		dir = exp.AscDir
+	}
+	return exp.NewOrderedExpression(s.col, dir, s.nulls)
+}
+
+func (s seekItem) equal(val interface{}) exp.Expression {
+	if val == nil {
+		return s.col.IsNull()
+	}
+	return s.col.Eq(val)
+}
+
+// returns the predicate selecting the values of the column after val, nil if no value is sorted after val
+func (s seekItem) after(val interface{}) exp.Expression {
+	if val == nil {
+		if s.nulls == exp.NullsFirstSortType {
+			return s.col.IsNotNull()
+		}
+		return nil
+	}
+	var cmp exp.Expression = s.col.Lt(val)
+	if s.asc {
+		cmp = s.col.Gt(val)
+	}
+	if s.nulls == exp.NullsLastSortType {
+		return exp.NewExpressionList(exp.OrType, cmp, s.col.IsNull())
+	}
+	return cmp
+}
@@ -0,0 +1,244 @@
+package depiq_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/orn-id/depiq"
+	"github.com/orn-id/depiq/exp"
+	"github.com/orn-id/depiq/internal/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	paginationItem struct {
+		ID      int64          `db:"id"`
+		Name    string         `db:"name"`
+		Created sql.NullString `db:"created"`
+	}
+	paginationSuite struct {
+		suite.Suite
+	}
+)
+
+// fetches a page of items from a mock database returning the rows
+func (ps *paginationSuite) fetchPage(ds *depiq.SelectDataset, cursor string, pageSize uint, rows *sqlmock.Rows) (
+	[]paginationItem, *depiq.Page,
+) {
+	order := make([]exp.OrderedExpression, 0)
+	for _, oe := range ds.GetClauses().Order().Columns() {
+		order = append(order, oe.(exp.OrderedExpression))
+	}
+	mDB, sqlMock, err := sqlmock.New()
+	ps.Require().NoError(err)
+	sqlMock.ExpectQuery(`SELECT "created", "id", "name" FROM "items"`).WillReturnRows(rows)
+	var items []paginationItem
+	page, err := depiq.New("mock", mDB).From("items").Order(order...).Paginate(cursor, pageSize).Fetch(&items)
+	ps.Require().NoError(err)
+	ps.NoError(sqlMock.ExpectationsWereMet())
+	return items, page
+}
+
+func (ps *paginationSuite) itemRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"created", "id", "name"})
+}
+
+func (ps *paginationSuite) TestPaginate_firstPage() {
+	ds := depiq.From("items").Order(depiq.C("id").Asc()).Offset(10)
+	query, args, err := ds.Paginate("", 2).ToSQL()
+	ps.NoError(err)
+	ps.Empty(args)
+	ps.Equal(`SELECT * FROM "items" ORDER BY "id" ASC LIMIT 3`, query)
+
+	items, page := ps.fetchPage(ds, "", 2, ps.itemRows().
+		AddRow("2021-01-01", 1, "a").
+		AddRow("2021-01-02", 2, "b").
+		AddRow("2021-01-03", 3, "c"))
+	ps.Equal([]paginationItem{
+		{ID: 1, Name: "a", Created: sql.NullString{String: "2021-01-01", Valid: true}},
+		{ID: 2, Name: "b", Created: sql.NullString{String: "2021-01-02", Valid: true}},
+	}, items)
+	ps.NotEmpty(page.NextCursor)
+	ps.Empty(page.PrevCursor)
+
+	items, page = ps.fetchPage(ds, "", 2, ps.itemRows().AddRow("2021-01-01", 1, "a"))
+	ps.Len(items, 1)
+	ps.Equal(&depiq.Page{}, page)
+}
+
+func (ps *paginationSuite) TestPaginate_nextAndPreviousPage() {
+	ds := depiq.From("items").Order(depiq.C("id").Asc())
+	_, page := ps.fetchPage(ds, "", 2, ps.itemRows().
+		AddRow(nil, 1, "a").
+		AddRow(nil, 2, "b").
+		AddRow(nil, 3, "c"))
+
+	query, _, err := ds.Paginate(page.NextCursor, 2).ToSQL()
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "items" WHERE ("id" > 2) ORDER BY "id" ASC LIMIT 3`, query)
+
+	items, page := ps.fetchPage(ds, page.NextCursor, 2, ps.itemRows().
+		AddRow(nil, 3, "c").
+		AddRow(nil, 4, "d").
+		AddRow(nil, 5, "e"))
+	ps.Equal([]int64{3, 4}, ps.ids(items))
+	ps.NotEmpty(page.NextCursor)
+	ps.NotEmpty(page.PrevCursor)
+
+	query, _, err = ds.Paginate(page.PrevCursor, 2).ToSQL()
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "items" WHERE ("id" < 3) ORDER BY "id" DESC LIMIT 3`, query)
+
+	// the previous page is fetched in the reverse order
+	items, page = ps.fetchPage(ds, page.PrevCursor, 2, ps.itemRows().
+		AddRow(nil, 2, "b").
+		AddRow(nil, 1, "a"))
+	ps.Equal([]int64{1, 2}, ps.ids(items))
+	ps.Empty(page.PrevCursor)
+	ps.NotEmpty(page.NextCursor)
+
+	query, _, err = ds.Paginate(page.NextCursor, 2).ToSQL()
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "items" WHERE ("id" > 2) ORDER BY "id" ASC LIMIT 3`, query)
+}
+
+func (ps *paginationSuite) TestPaginate_mixedOrder() {
+	ds := depiq.From("items").Order(depiq.C("name").Desc(), depiq.C("id").Asc())
+	_, page := ps.fetchPage(ds, "", 1, ps.itemRows().AddRow(nil, 1, "a").AddRow(nil, 2, "b"))
+
+	query, _, err := ds.Paginate(page.NextCursor, 1).ToSQL()
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "items" WHERE (("name" < 'a') OR (("name" = 'a') AND ("id" > 1))) `+
+		`ORDER BY "name" DESC, "id" ASC LIMIT 2`, query)
+
+	query, args, err := ds.Prepared(true).Paginate(page.NextCursor, 1).ToSQL()
+	ps.NoError(err)
+	ps.Equal([]interface{}{"a", "a", int64(1), int64(2)}, args)
+	ps.Equal(`SELECT * FROM "items" WHERE (("name" < ?) OR (("name" = ?) AND ("id" > ?))) `+
+		`ORDER BY "name" DESC, "id" ASC LIMIT ?`, query)
+}
+
+func (ps *paginationSuite) TestPaginate_rowValues() {
+	ds := depiq.From("items").Order(depiq.C("name").Asc(), depiq.C("id").Asc())
+	_, page := ps.fetchPage(ds, "", 1, ps.itemRows().AddRow(nil, 1, "a").AddRow(nil, 2, "b"))
+
+	query, _, err := ds.Paginate(page.NextCursor, 1).ToSQL()
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "items" WHERE (("name", "id") > ('a', 1)) ORDER BY "name" ASC, "id" ASC LIMIT 2`, query)
+}
+
+func (ps *paginationSuite) TestPaginate_nulls() {
+	ds := depiq.From("items").Order(depiq.C("created").Asc().NullsLast(), depiq.C("id").Asc())
+	_, page := ps.fetchPage(ds, "", 1, ps.itemRows().AddRow("2021-01-01", 1, "a").AddRow(nil, 2, "b"))
+
+	query, _, err := ds.Paginate(page.NextCursor, 1).ToSQL()
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "items" `+
+		`WHERE ((("created" > '2021-01-01') OR ("created" IS NULL)) OR (("created" = '2021-01-01') AND ("id" > 1))) `+
+		`ORDER BY "created" ASC NULLS LAST, "id" ASC LIMIT 2`, query)
+
+	items, page := ps.fetchPage(ds, page.NextCursor, 1, ps.itemRows().AddRow(nil, 2, "b").AddRow(nil, 3, "c"))
+	ps.Equal([]int64{2}, ps.ids(items))
+
+	query, _, err = ds.Paginate(page.NextCursor, 1).ToSQL()
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "items" WHERE (("created" IS NULL) AND ("id" > 2)) `+
+		`ORDER BY "created" ASC NULLS LAST, "id" ASC LIMIT 2`, query)
+
+	query, _, err = ds.Paginate(page.PrevCursor, 1).ToSQL()
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "items" WHERE (("created" IS NOT NULL) OR (("created" IS NULL) AND ("id" < 2))) `+
+		`ORDER BY "created" DESC NULLS FIRST, "id" DESC LIMIT 2`, query)
+}
+
+func (ps *paginationSuite) TestPaginate_nullWithoutNullSortType() {
+	mDB, sqlMock, err := sqlmock.New()
+	ps.Require().NoError(err)
+	sqlMock.ExpectQuery(`SELECT "created", "id", "name" FROM "items"`).
+		WillReturnRows(ps.itemRows().AddRow(nil, 1, "a").AddRow(nil, 2, "b"))
+	var items []paginationItem
+	_, err = depiq.New("mock", mDB).From("items").
+		Order(depiq.C("created").Asc(), depiq.C("id").Asc()).
+		Paginate("", 1).
+		Fetch(&items)
+	ps.EqualError(err, "depiq: order column ..created is NULL, use NullsFirst or NullsLast to paginate on a "+
+		"nullable column")
+}
+
+func (ps *paginationSuite) TestPaginate_invalidCursor() {
+	ds := depiq.From("items").Order(depiq.C("id").Asc())
+	_, page := ps.fetchPage(ds, "", 1, ps.itemRows().AddRow(nil, 1, "a").AddRow(nil, 2, "b"))
+
+	tampered := []byte(page.NextCursor)
+	tampered[5]++
+	for _, cursor := range []string{"invalid", "a.b", string(tampered), page.NextCursor + "a"} {
+		_, _, err := ds.Paginate(cursor, 1).ToSQL()
+		ps.Equal(depiq.ErrInvalidCursor, err, cursor)
+	}
+
+	_, _, err := ds.Order(depiq.C("id").Desc()).Paginate(page.NextCursor, 1).ToSQL()
+	ps.Equal(depiq.ErrCursorOrderMismatch, err)
+	_, _, err = ds.Order(depiq.C("name").Asc()).Paginate(page.NextCursor, 1).ToSQL()
+	ps.Equal(depiq.ErrCursorOrderMismatch, err)
+
+	depiq.SetPaginationSecret([]byte("other secret"))
+	defer depiq.SetPaginationSecret([]byte("test secret"))
+	_, _, err = ds.Paginate(page.NextCursor, 1).ToSQL()
+	ps.Equal(depiq.ErrInvalidCursor, err)
+}
+
+func (ps *paginationSuite) TestPaginate_secretNotSet() {
+	ds := depiq.From("items").Order(depiq.C("id").Asc())
+	_, page := ps.fetchPage(ds, "", 1, ps.itemRows().AddRow(nil, 1, "a").AddRow(nil, 2, "b"))
+
+	depiq.SetPaginationSecret(nil)
+	defer depiq.SetPaginationSecret([]byte("test secret"))
+
+	query, _, err := ds.Paginate("", 1).ToSQL()
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "items" ORDER BY "id" ASC LIMIT 2`, query)
+
+	_, _, err = ds.Paginate(page.NextCursor, 1).ToSQL()
+	ps.Equal(depiq.ErrPaginationSecretNotSet, err)
+
+	mDB, sqlMock, err := sqlmock.New()
+	ps.Require().NoError(err)
+	sqlMock.ExpectQuery(`SELECT "created", "id", "name" FROM "items"`).
+		WillReturnRows(ps.itemRows().AddRow(nil, 1, "a").AddRow(nil, 2, "b"))
+	var items []paginationItem
+	_, err = depiq.New("mock", mDB).From("items").Order(depiq.C("id").Asc()).Paginate("", 1).Fetch(&items)
+	ps.Equal(depiq.ErrPaginationSecretNotSet, err)
+}
+
+func (ps *paginationSuite) TestPaginate_errors() {
+	_, _, err := depiq.From("items").Paginate("", 1).ToSQL()
+	ps.EqualError(err, "depiq: a dataset must be ordered to paginate, see Order")
+
+	_, _, err = depiq.From("items").Order(depiq.L("random()").Asc()).Paginate("", 1).ToSQL()
+	ps.EqualError(err, "depiq: a dataset can only be paginated by columns, got order expression exp.literal")
+
+	_, _, err = depiq.From("items").Order(depiq.C("id").Asc()).Paginate("", 0).ToSQL()
+	ps.EqualError(err, "depiq: page size must be greater than 0")
+
+	var items []paginationItem
+	_, err = depiq.From("items").Order(depiq.C("id").Asc()).Paginate("", 1).Fetch(&items)
+	ps.Equal(depiq.ErrQueryFactoryNotFoundError, err)
+
+	expectedErr := errors.New("test error")
+	_, _, err = depiq.From("items").Order(depiq.C("id").Asc()).SetError(expectedErr).Paginate("", 1).ToSQL()
+	ps.Equal(expectedErr, err)
+}
+
+func (ps *paginationSuite) ids(items []paginationItem) []int64 {
+	ids := make([]int64, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+func TestPaginationSuite(t *testing.T) {
+	depiq.SetPaginationSecret([]byte("test secret"))
+	suite.Run(t, new(paginationSuite))
+}
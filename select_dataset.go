@@ -15,6 +15,7 @@ type SelectDataset struct {
 	dialect      SQLDialect
 	clauses      exp.SelectClauses
 	isPrepared   prepared
+	simplify     bool
 	queryFactory exec.QueryFactory
 	err          error
 }
@@ -40,6 +41,25 @@ func Select(cols ...interface{}) *SelectDataset {
 	return newDataset("default", nil).Select(cols...)
 }
 
+// Creates a dataset for the dialect from JSON created by SelectDataset#MarshalJSON. The JSON is validated while it is
+// decoded, subqueries are created as datasets of the same dialect. The dataset can not be executed, use
+// Database#SelectFromJSON to decode a dataset that can.
+func SelectFromJSON(dialect string, data []byte) (*SelectDataset, error) {
+	return selectFromJSON(dialect, nil, data)
+}
+
+// used internally by SelectFromJSON and database to decode a dataset that executes its queries with the queryFactory
+func selectFromJSON(dialect string, queryFactory exec.QueryFactory, data []byte) (*SelectDataset, error) {
+	ds := newDataset(dialect, queryFactory)
+	clauses, err := exp.UnmarshalSelectClausesJSON(data, func(c exp.SelectClauses) exp.AppendableExpression {
+		return ds.copy(c)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ds.copy(clauses), nil
+}
+
 // Sets the adapter used to serialize values and create the SQL statement
 func (sd *SelectDataset) WithDialect(dl string) *SelectDataset {
 	ds := sd.copy(sd.GetClauses())
@@ -60,6 +80,18 @@ func (sd *SelectDataset) IsPrepared() bool {
 	return sd.isPrepared.Bool()
 }
 
+// Set to true to simplify the boolean expressions of the dataset before the SQL is generated, e.g. empty expression
+// lists are removed and x IN () is folded into FALSE. See sqlgen.Simplifier
+func (sd *SelectDataset) Simplify(simplify bool) *SelectDataset {
+	ret := sd.copy(sd.clauses)
+	ret.simplify = simplify
+	return ret
+}
+
+func (sd *SelectDataset) IsSimplified() bool {
+	return sd.simplify
+}
+
 // Returns the current adapter on the dataset
 func (sd *SelectDataset) Dialect() SQLDialect {
 	return sd.dialect
@@ -86,12 +118,27 @@ func (sd *SelectDataset) GetClauses() exp.SelectClauses {
 	return sd.clauses
 }
 
+// Returns a copy of the dataset using the clauses. Used by exp.Rewrite to rebuild a rewritten dataset.
+func (sd *SelectDataset) WithClauses(clauses exp.SelectClauses) exp.Expression {
+	return sd.copy(clauses)
+}
+
+// Encodes the clauses of the dataset, including subqueries, into a stable and versioned JSON representation that can
+// be decoded with SelectFromJSON. The dialect and execution settings of the dataset are not encoded.
+func (sd *SelectDataset) MarshalJSON() ([]byte, error) {
+	if sd.err != nil {
+		return nil, sd.err
+	}
+	return exp.MarshalSelectClausesJSON(sd.clauses)
+}
+
 // used interally to copy the dataset
 func (sd *SelectDataset) copy(clauses exp.SelectClauses) *SelectDataset {
 	return &SelectDataset{
 		dialect:      sd.dialect,
 		clauses:      clauses,
 		isPrepared:   sd.isPrepared,
+		simplify:     sd.simplify,
 		queryFactory: sd.queryFactory,
 		err:          sd.err,
 	}
@@ -101,7 +148,8 @@ func (sd *SelectDataset) copy(clauses exp.SelectClauses) *SelectDataset {
 // `ORDER , and `LIMIT`
 func (sd *SelectDataset) Update() *UpdateDataset {
 	u := newUpdateDataset(sd.dialect.Dialect(), sd.queryFactory).
-		Prepared(sd.isPrepared.Bool())
+		Prepared(sd.isPrepared.Bool()).
+		Simplify(sd.simplify)
 	if sd.clauses.HasSources() {
 		u = u.Table(sd.GetClauses().From().Columns()[0])
 	}
@@ -144,7 +192,8 @@ func (sd *SelectDataset) Insert() *InsertDataset {
 // `ORDER , and `LIMIT`
 func (sd *SelectDataset) Delete() *DeleteDataset {
 	d := newDeleteDataset(sd.dialect.Dialect(), sd.queryFactory).
-		Prepared(sd.isPrepared.Bool())
+		Prepared(sd.isPrepared.Bool()).
+		Simplify(sd.simplify)
 	if sd.clauses.HasSources() {
 		d = d.From(sd.clauses.From().Columns()[0])
 	}
@@ -475,6 +524,20 @@ func (sd *SelectDataset) IntersectAll(other *SelectDataset) *SelectDataset {
 	return sd.withCompound(exp.IntersectAllCompoundType, other.CompoundFromSelf())
 }
 
+// Creates an EXCEPT statement with another dataset.
+// If this or the other dataset has a limit or offset it will use that dataset as a subselect in the FROM clause.
+// See examples.
+func (sd *SelectDataset) Except(other *SelectDataset) *SelectDataset {
+	return sd.withCompound(exp.ExceptCompoundType, other.CompoundFromSelf())
+}
+
+// Creates an EXCEPT ALL statement with another dataset.
+// If this or the other dataset has a limit or offset it will use that dataset as a subselect in the FROM clause.
+// See examples.
+func (sd *SelectDataset) ExceptAll(other *SelectDataset) *SelectDataset {
+	return sd.withCompound(exp.ExceptAllCompoundType, other.CompoundFromSelf())
+}
+
 func (sd *SelectDataset) withCompound(ct exp.CompoundType, other exp.AppendableExpression) *SelectDataset {
 	ce := exp.NewCompoundExpression(ct, other)
 	ret := sd.CompoundFromSelf()
@@ -556,7 +619,7 @@ func (sd *SelectDataset) AppendSQL(b sb.SQLBuilder) {
 		b.SetError(sd.err)
 		return
 	}
-	sd.dialect.ToSelectSQL(b, sd.GetClauses())
+	sd.dialect.ToSelectSQL(b, sd.sqlClauses())
 }
 
 func (sd *SelectDataset) ReturnsColumns() bool {
@@ -653,6 +716,79 @@ func (sd *SelectDataset) ScanValContext(ctx context.Context, i interface{}) (boo
 	return sd.Limit(1).Executor().ScanValContext(ctx, i)
 }
 
+// Generates the SELECT sql for this dataset and uses Exec#ScanMaps to scan the results into a slice of Records, the
+// values are normalized using the database type of the columns (e.g. []byte values of text columns are converted to a
+// string)
+//
+// rs: A pointer to a slice of Records
+func (sd *SelectDataset) ScanMaps(rs *[]exp.Record) error {
+	return sd.ScanMapsContext(context.Background(), rs)
+}
+
+// Generates the SELECT sql for this dataset and uses Exec#ScanMapsContext to scan the results into a slice of Records
+//
+// rs: A pointer to a slice of Records
+func (sd *SelectDataset) ScanMapsContext(ctx context.Context, rs *[]exp.Record) error {
+	if sd.queryFactory == nil {
+		return ErrQueryFactoryNotFoundError
+	}
+	return sd.Executor().ScanMapsContext(ctx, rs)
+}
+
+// Generates the SELECT sql for this dataset and uses Exec#ScanMap to scan the result into a Record
+//
+// r: A pointer to a Record
+func (sd *SelectDataset) ScanMap(r *exp.Record) (bool, error) {
+	return sd.ScanMapContext(context.Background(), r)
+}
+
+// Generates the SELECT sql for this dataset and uses Exec#ScanMapContext to scan the result into a Record
+//
+// r: A pointer to a Record
+func (sd *SelectDataset) ScanMapContext(ctx context.Context, r *exp.Record) (bool, error) {
+	if sd.queryFactory == nil {
+		return false, ErrQueryFactoryNotFoundError
+	}
+	return sd.Limit(1).Executor().ScanMapContext(ctx, r)
+}
+
+// Generates the SELECT sql for this dataset and uses Exec#ScanOrderedMaps to scan the results into a slice of
+// OrderedRecords that keep the order of the selected columns
+//
+// rs: A pointer to a slice of OrderedRecords
+func (sd *SelectDataset) ScanOrderedMaps(rs *[]exp.OrderedRecord) error {
+	return sd.ScanOrderedMapsContext(context.Background(), rs)
+}
+
+// Generates the SELECT sql for this dataset and uses Exec#ScanOrderedMapsContext to scan the results into a slice of
+// OrderedRecords that keep the order of the selected columns
+//
+// rs: A pointer to a slice of OrderedRecords
+func (sd *SelectDataset) ScanOrderedMapsContext(ctx context.Context, rs *[]exp.OrderedRecord) error {
+	if sd.queryFactory == nil {
+		return ErrQueryFactoryNotFoundError
+	}
+	return sd.Executor().ScanOrderedMapsContext(ctx, rs)
+}
+
+// Generates the SELECT sql for this dataset and uses Exec#ScanOrderedMap to scan the result into an OrderedRecord
+//
+// r: A pointer to an OrderedRecord
+func (sd *SelectDataset) ScanOrderedMap(r *exp.OrderedRecord) (bool, error) {
+	return sd.ScanOrderedMapContext(context.Background(), r)
+}
+
+// Generates the SELECT sql for this dataset and uses Exec#ScanOrderedMapContext to scan the result into an
+// OrderedRecord
+//
+// r: A pointer to an OrderedRecord
+func (sd *SelectDataset) ScanOrderedMapContext(ctx context.Context, r *exp.OrderedRecord) (bool, error) {
+	if sd.queryFactory == nil {
+		return false, ErrQueryFactoryNotFoundError
+	}
+	return sd.Limit(1).Executor().ScanOrderedMapContext(ctx, r)
+}
+
 // Generates the SELECT COUNT(*) sql for this dataset and uses Exec#ScanVal to scan the result into an int64.
 func (sd *SelectDataset) Count() (int64, error) {
 	return sd.CountContext(context.Background())
@@ -665,6 +801,63 @@ func (sd *SelectDataset) CountContext(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+// Fetches a page of rows into a slice of structs like FetchContext and returns the total number of rows of the dataset
+// in the same call. Pages start at 1, the LIMIT and OFFSET of the dataset are replaced
+//
+//	var users []User
+//	total, err := db.From("user").Order(C("id").Asc()).FetchPage(ctx, &users, 2, 20)
+//
+// If the dialect supports window functions the total is selected with the rows using COUNT(*) OVER ()
+//
+//	SELECT "first_name", "id", COUNT(*) OVER () AS "depiq_total" FROM "user" ORDER BY "id" ASC LIMIT 20 OFFSET 20
+//
+// otherwise (or if the page is empty) it is counted with a separate query. Datasets with a GROUP BY, DISTINCT or
+// compounds (e.g. UNION) are counted with FromSelf so the rows of the dataset are counted instead of the rows of the
+// table, COUNT(*) OVER () is not used for datasets with a DISTINCT or compounds.
+//
+// i: A pointer to a slice of structs
+func (sd *SelectDataset) FetchPage(ctx context.Context, i interface{}, page, size uint) (total int64, err error) {
+	if sd.queryFactory == nil {
+		return 0, ErrQueryFactoryNotFoundError
+	}
+	if page == 0 || size == 0 {
+		return 0, errors.New("page and page size must be greater than 0")
+	}
+	ds := sd.Limit(size).Offset((page - 1) * size)
+	if sd.GetClauses().IsDefaultSelect() {
+		ds = ds.Select(i)
+	}
+	if dialectOptionsOf(sd.dialect).SupportsWindowFunction && !sd.hasDistinctOrCompounds() {
+		ds = ds.SelectAppend(COUNT(Star()).Over(W()).As(pageTotalColumn))
+		err = ds.Executor().ScanStructsWithColumnContext(ctx, i, pageTotalColumn, &total)
+		if err != nil || total > 0 || page == 1 {
+			return total, err
+		}
+		// the page is after the last row, the total is counted with a separate query
+		return sd.pageCount(ctx)
+	}
+	if total, err = sd.pageCount(ctx); err != nil || total <= int64((page-1)*size) {
+		return total, err
+	}
+	return total, ds.FetchContext(ctx, i)
+}
+
+// the alias of the COUNT(*) OVER () column selected by FetchPage
+const pageTotalColumn = "depiq_total"
+
+// counts the rows of the dataset for FetchPage
+func (sd *SelectDataset) pageCount(ctx context.Context) (int64, error) {
+	ds := sd.ClearOrder().ClearLimit().ClearOffset()
+	if sd.hasDistinctOrCompounds() || sd.clauses.GroupBy() != nil {
+		ds = ds.FromSelf()
+	}
+	return ds.CountContext(ctx)
+}
+
+func (sd *SelectDataset) hasDistinctOrCompounds() bool {
+	return sd.clauses.Distinct() != nil || len(sd.clauses.Compounds()) > 0
+}
+
 // Generates the SELECT sql only selecting the passed in column and uses Exec#ScanVals to scan the result into a slice
 // of primitive values.
 //
@@ -690,6 +883,14 @@ func (sd *SelectDataset) selectSQLBuilder() sb.SQLBuilder {
 	if sd.err != nil {
 		return buf.SetError(sd.err)
 	}
-	sd.dialect.ToSelectSQL(buf, sd.GetClauses())
+	sd.dialect.ToSelectSQL(buf, sd.sqlClauses())
 	return buf
 }
+
+// returns the clauses used to generate the SQL, simplified if Simplify(true) was used
+func (sd *SelectDataset) sqlClauses() exp.SelectClauses {
+	if fn := datasetSimplifier(sd.dialect, sd.simplify); fn != nil {
+		return exp.RewriteSelectClauses(sd.clauses, fn)
+	}
+	return sd.clauses
+}
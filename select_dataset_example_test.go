@@ -3,6 +3,7 @@ package depiq_test
 
 import (
 	goSQL "database/sql"
+	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"github.com/lib/pq"
 	"github.com/orn-id/depiq"
 	"github.com/orn-id/depiq/exp"
+	"github.com/orn-id/depiq/sqlgen"
 )
 
 const schema = `
@@ -330,6 +332,50 @@ func ExampleSelectDataset_IntersectAll() {
 	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" INTERSECT ALL (SELECT * FROM (SELECT * FROM "test2" ORDER BY "id" DESC) AS "t1")
 }
 
+func ExampleSelectDataset_Except() {
+	sql, _, _ := depiq.From("test").
+		Except(depiq.From("test2")).
+		ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = depiq.From("test").
+		Limit(1).
+		Except(depiq.From("test2")).
+		ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = depiq.From("test").
+		Limit(1).
+		Except(depiq.From("test2").
+			Order(depiq.C("id").Desc())).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "test" EXCEPT (SELECT * FROM "test2")
+	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" EXCEPT (SELECT * FROM "test2")
+	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" EXCEPT (SELECT * FROM (SELECT * FROM "test2" ORDER BY "id" DESC) AS "t1")
+}
+
+func ExampleSelectDataset_ExceptAll() {
+	sql, _, _ := depiq.From("test").
+		ExceptAll(depiq.From("test2")).
+		ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = depiq.From("test").
+		Limit(1).
+		ExceptAll(depiq.From("test2")).
+		ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = depiq.From("test").
+		Limit(1).
+		ExceptAll(depiq.From("test2").
+			Order(depiq.C("id").Desc())).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "test" EXCEPT ALL (SELECT * FROM "test2")
+	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" EXCEPT ALL (SELECT * FROM "test2")
+	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" EXCEPT ALL (SELECT * FROM (SELECT * FROM "test2" ORDER BY "id" DESC) AS "t1")
+}
+
 func ExampleSelectDataset_ClearOffset() {
 	ds := depiq.From("test").
 		Offset(2)
@@ -598,6 +644,43 @@ func ExampleSelectDataset_Where_prepared() {
 	// SELECT * FROM "test" WHERE (("a" > ?) OR (("b" < ?) AND ("c" IS NULL))) [10 10]
 }
 
+// A custom expression that writes its own SQL, see sqlgen.SQLAppender
+type stDWithin struct {
+	geom     exp.Expression
+	point    interface{}
+	distance float64
+}
+
+func (s stDWithin) Expression() exp.Expression { return s }
+func (s stDWithin) Clone() exp.Expression      { return s }
+
+func (s stDWithin) GenerateSQL(b sqlgen.SQLBuilder, esg sqlgen.ExpressionSQLGenerator) {
+	b.WriteStrings("ST_DWithin(")
+	esg.Generate(b, s.geom)
+	b.WriteStrings(", ")
+	esg.Generate(b, s.point)
+	b.WriteStrings(", ")
+	esg.Generate(b, s.distance)
+	b.WriteRunes(')')
+}
+
+func ExampleSelectDataset_Where_customExpression() {
+	ds := depiq.From("stores").Where(stDWithin{
+		geom:     depiq.C("location"),
+		point:    depiq.Func("ST_MakePoint", -71.06, 42.36),
+		distance: 1000,
+	})
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "stores" WHERE ST_DWithin("location", ST_MakePoint(-71.06, 42.36), 1000) []
+	// SELECT * FROM "stores" WHERE ST_DWithin("location", ST_MakePoint(?, ?), ?) [-71.06 42.36 1000]
+}
+
 func ExampleSelectDataset_ClearWhere() {
 	ds := depiq.From("test").Where(
 		depiq.Or(
@@ -1257,6 +1340,37 @@ func ExampleSelectDataset_Prepared() {
 	// SELECT * FROM "items" WHERE (("col1" = ?) AND ("col2" = ?) AND ("col3" IS TRUE) AND ("col4" IS FALSE) AND ("col5" IN (?, ?, ?))) [a 1 a b c]
 }
 
+func ExampleSelectDataset_Simplify() {
+	ids := []int64{}
+	ds := depiq.From("test").Where(
+		depiq.And(depiq.Ex{}, depiq.Or(), depiq.C("a").Eq(1)),
+		depiq.C("a").Eq(1),
+		depiq.Or(depiq.C("b").Gt(10), depiq.C("id").In(ids)),
+	)
+	sql, _, _ := ds.ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = ds.Simplify(true).ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = ds.Simplify(true).Where(depiq.C("id").In(ids)).ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "test" WHERE (("a" = 1) AND ("a" = 1) AND (("b" > 10) OR ("id" IN ())))
+	// SELECT * FROM "test" WHERE (("a" = 1) AND ("b" > 10))
+	// SELECT * FROM "test" WHERE FALSE
+}
+
+func ExampleSelectDataset_Paginate() {
+	ds := depiq.From("user").Order(depiq.C("created").Desc(), depiq.C("id").Asc())
+	// the first page, use the cursors of the Page returned by Fetch to select the next or previous page
+	sql, _, _ := ds.Paginate("", 20).ToSQL()
+	fmt.Println(sql)
+	_, _, err := ds.Paginate("invalid", 20).ToSQL()
+	fmt.Println(err)
+	// Output:
+	// SELECT * FROM "user" ORDER BY "created" DESC, "id" ASC LIMIT 21
+	// depiq: invalid pagination cursor
+}
+
 func ExampleSelectDataset_ScanStructs() {
 	type User struct {
 		FirstName string `db:"first_name"`
@@ -1683,3 +1797,60 @@ func ExampleForUpdate_ofMultiple() {
 	// Output:
 	// SELECT * FROM "table1" INNER JOIN "table2" ON ("table2"."id" = "table1"."id") FOR UPDATE OF "table1", "table2"  []
 }
+
+func ExampleSelectDataset_WithClauses() {
+	ds := depiq.From("users").
+		Where(depiq.C("id").In(depiq.From("orders").Select("user_id").Where(depiq.C("total").Gt(100)))).
+		UnionAll(depiq.From("archived_users"))
+
+	for _, table := range exp.ReferencedTables(ds) {
+		fmt.Println(table.GetTable())
+	}
+
+	// add a tenant predicate to every query and rename the legacy "total" column
+	rewritten := exp.Rewrite(ds, func(e exp.Expression) exp.Expression {
+		switch t := e.(type) {
+		case *depiq.SelectDataset:
+			return t.Where(depiq.C("tenant_id").Eq(1))
+		case exp.IdentifierExpression:
+			if t.GetCol() == "total" {
+				return t.Col("amount")
+			}
+		}
+		return e
+	})
+	sql, args, _ := rewritten.(*depiq.SelectDataset).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// users
+	// orders
+	// archived_users
+	// SELECT * FROM "users" WHERE (("id" IN ((SELECT "user_id" FROM "orders" WHERE (("amount" > 100) AND ("tenant_id" = 1))))) AND ("tenant_id" = 1)) UNION ALL (SELECT * FROM "archived_users" WHERE ("tenant_id" = 1)) []
+}
+
+func ExampleSelectDataset_MarshalJSON() {
+	ds := depiq.From("users").
+		Select("id", "name").
+		Where(depiq.C("id").In(depiq.From("orders").Select("user_id"))).
+		Order(depiq.C("id").Desc()).
+		Limit(10)
+
+	data, _ := json.Marshal(ds)
+
+	// the JSON can be stored and decoded later, the input is validated while decoding
+	decoded, err := depiq.SelectFromJSON("postgres", data)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	sql, args, _ := decoded.ToSQL()
+	fmt.Println(sql, args)
+
+	_, err = depiq.SelectFromJSON("postgres", []byte(`{"version":2,"select":{}}`))
+	fmt.Println(err.Error())
+
+	// Output:
+	// SELECT "id", "name" FROM "users" WHERE ("id" IN ((SELECT "user_id" FROM "orders"))) ORDER BY "id" DESC LIMIT 10 []
+	// depiq: invalid JSON at $.version: unsupported version 2 expected 1
+}
@@ -1,10 +1,13 @@
 package depiq_test
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/orn-id/depiq"
+	"github.com/orn-id/depiq/exec"
 	"github.com/orn-id/depiq/exp"
 	"github.com/orn-id/depiq/internal/errors"
 	"github.com/orn-id/depiq/internal/sb"
@@ -85,12 +88,225 @@ func (sds *selectDatasetSuite) TestPrepared() {
 	sds.True(ds.IsPrepared())
 }
 
+func (sds *selectDatasetSuite) TestSimplify() {
+	ds := depiq.From("test").Where(depiq.And(depiq.Ex{}, depiq.Or(), depiq.C("a").Eq(1), depiq.C("a").Eq(1)))
+	simplifiedDs := ds.Simplify(true)
+	sds.True(simplifiedDs.IsSimplified())
+	sds.False(ds.IsSimplified())
+	// should apply the simplification to any datasets created from the root
+	sds.True(simplifiedDs.Where(depiq.Ex{"b": 1}).IsSimplified())
+	sds.True(simplifiedDs.Update().IsSimplified())
+	sds.True(simplifiedDs.Delete().IsSimplified())
+
+	sql, _, err := ds.ToSQL()
+	sds.NoError(err)
+	sds.Equal(`SELECT * FROM "test" WHERE (("a" = 1) AND ("a" = 1))`, sql)
+
+	sql, _, err = simplifiedDs.ToSQL()
+	sds.NoError(err)
+	sds.Equal(`SELECT * FROM "test" WHERE ("a" = 1)`, sql)
+
+	sql, _, err = simplifiedDs.Where(depiq.C("b").In([]int{})).ToSQL()
+	sds.NoError(err)
+	sds.Equal(`SELECT * FROM "test" WHERE FALSE`, sql)
+
+	// subqueries are simplified with the dataset
+	sql, _, err = depiq.From("test").Simplify(true).Where(depiq.C("a").In(ds.Select("a"))).ToSQL()
+	sds.NoError(err)
+	sds.Equal(`SELECT * FROM "test" WHERE ("a" IN ((SELECT "a" FROM "test" WHERE ("a" = 1))))`, sql)
+	sql, _, err = depiq.From("test").Where(depiq.C("a").In(simplifiedDs.Select("a"))).ToSQL()
+	sds.NoError(err)
+	sds.Equal(`SELECT * FROM "test" WHERE ("a" IN ((SELECT "a" FROM "test" WHERE ("a" = 1))))`, sql)
+}
+
 func (sds *selectDatasetSuite) TestGetClauses() {
 	ds := depiq.From("test")
 	ce := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression(depiq.I("test")))
 	sds.Equal(ce, ds.GetClauses())
 }
 
+func (sds *selectDatasetSuite) TestWithClauses() {
+	ds := depiq.From("test").WithDialect("mock")
+	ce := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression(depiq.I("other")))
+	wds, ok := ds.WithClauses(ce).(*depiq.SelectDataset)
+	sds.True(ok)
+	sds.Equal(ce, wds.GetClauses())
+	sds.Equal(ds.Dialect(), wds.Dialect())
+	sds.NotEqual(ce, ds.GetClauses())
+}
+
+func (sds *selectDatasetSuite) TestRewrite() {
+	tenantRewriter := func(e exp.Expression) exp.Expression {
+		if sd, ok := e.(*depiq.SelectDataset); ok {
+			return sd.Where(depiq.C("tenant_id").Eq(1))
+		}
+		if i, ok := e.(exp.IdentifierExpression); ok && i.GetCol() == "old_col" {
+			return i.Col("new_col")
+		}
+		return e
+	}
+	ds := depiq.From("users").
+		With("cte", depiq.From("events").Where(depiq.C("old_col").IsNull())).
+		Join(depiq.From("profiles").As("p"), depiq.On(depiq.I("p.user_id").Eq(depiq.I("users.id")))).
+		CrossJoin(depiq.Lateral(depiq.From("orders").Select("old_col"))).
+		Where(depiq.C("id").In(depiq.From("admins").Select("user_id"))).
+		UnionAll(depiq.From("archived_users"))
+	rds, ok := exp.Rewrite(ds, tenantRewriter).(*depiq.SelectDataset)
+	sds.True(ok)
+	sds.assertCases(
+		selectTestCase{
+			ds: rds,
+			clauses: exp.NewSelectClauses().
+				CommonTablesAppend(exp.NewCommonTableExpression(false, "cte",
+					depiq.From("events").Where(depiq.C("new_col").IsNull(), depiq.C("tenant_id").Eq(1)),
+				)).
+				SetFrom(exp.NewColumnListExpression("users")).
+				JoinsAppend(exp.NewConditionedJoinExpression(exp.InnerJoinType,
+					depiq.From("profiles").Where(depiq.C("tenant_id").Eq(1)).As("p"),
+					depiq.On(depiq.I("p.user_id").Eq(depiq.I("users.id"))),
+				)).
+				JoinsAppend(exp.NewUnConditionedJoinExpression(exp.CrossJoinType,
+					depiq.Lateral(depiq.From("orders").Select("new_col").Where(depiq.C("tenant_id").Eq(1))),
+				)).
+				WhereAppend(
+					depiq.C("id").In(depiq.From("admins").Select("user_id").Where(depiq.C("tenant_id").Eq(1))),
+					depiq.C("tenant_id").Eq(1),
+				).
+				CompoundsAppend(exp.NewCompoundExpression(exp.UnionAllCompoundType,
+					depiq.From("archived_users").Where(depiq.C("tenant_id").Eq(1)),
+				)),
+		},
+		selectTestCase{
+			ds: ds,
+			clauses: exp.NewSelectClauses().
+				CommonTablesAppend(exp.NewCommonTableExpression(false, "cte",
+					depiq.From("events").Where(depiq.C("old_col").IsNull()),
+				)).
+				SetFrom(exp.NewColumnListExpression("users")).
+				JoinsAppend(exp.NewConditionedJoinExpression(exp.InnerJoinType,
+					depiq.From("profiles").As("p"),
+					depiq.On(depiq.I("p.user_id").Eq(depiq.I("users.id"))),
+				)).
+				JoinsAppend(exp.NewUnConditionedJoinExpression(exp.CrossJoinType,
+					depiq.Lateral(depiq.From("orders").Select("old_col")),
+				)).
+				WhereAppend(depiq.C("id").In(depiq.From("admins").Select("user_id"))).
+				CompoundsAppend(exp.NewCompoundExpression(exp.UnionAllCompoundType, depiq.From("archived_users"))),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestReferencedTables() {
+	ds := depiq.From("users", depiq.S("public").Table("accounts").As("a")).
+		With("cte", depiq.From("events")).
+		Join(depiq.T("cte"), depiq.On(depiq.I("cte.user_id").Eq(depiq.I("users.id")))).
+		LeftJoin(depiq.From("profiles").As("p"), depiq.On(depiq.I("p.user_id").Eq(depiq.I("users.id")))).
+		CrossJoin(depiq.Lateral(depiq.From("orders"))).
+		Where(
+			depiq.C("id").In(depiq.From("admins").Select("user_id")),
+			depiq.Exists(depiq.From("users").Where(depiq.C("active"))),
+		).
+		UnionAll(depiq.From("archive.users"))
+	sds.Equal([]exp.IdentifierExpression{
+		depiq.T("users"),
+		depiq.S("public").Table("accounts"),
+		depiq.T("events"),
+		depiq.T("profiles"),
+		depiq.T("orders"),
+		depiq.T("admins"),
+		depiq.S("archive").Table("users"),
+	}, exp.ReferencedTables(ds))
+
+	sds.Equal(
+		[]exp.IdentifierExpression{depiq.T("users"), depiq.T("orders")},
+		exp.ReferencedTables(depiq.Insert("users").FromQuery(depiq.From("orders"))),
+	)
+	sds.Equal(
+		[]exp.IdentifierExpression{depiq.T("users"), depiq.T("orders")},
+		exp.ReferencedTables(depiq.Update("users").From("orders").Set(depiq.Record{"a": 1})),
+	)
+	sds.Equal(
+		[]exp.IdentifierExpression{depiq.T("users"), depiq.T("banned")},
+		exp.ReferencedTables(depiq.Delete("users").Where(depiq.C("id").In(depiq.From("banned").Select("id")))),
+	)
+	sds.Equal(
+		[]exp.IdentifierExpression{depiq.T("users"), depiq.T("orders")},
+		exp.ReferencedTables(depiq.Truncate("users", "orders")),
+	)
+	sds.Empty(exp.ReferencedTables(depiq.C("a").Eq(1)))
+}
+
+func (sds *selectDatasetSuite) TestFingerprint() {
+	fingerprint := func(ds *depiq.SelectDataset) string {
+		f, err := exp.Fingerprint(ds)
+		sds.Require().NoError(err)
+		return f
+	}
+	ds := depiq.From("users").
+		Where(depiq.Ex{"name": "a", "age": depiq.Op{"gt": 10}}).
+		Where(depiq.C("id").In(depiq.From("admins").Select("user_id").Where(depiq.C("active").IsTrue()))).
+		Limit(10)
+	same := depiq.From("users").
+		Where(depiq.C("id").In(depiq.From("admins").Select("user_id").Where(depiq.C("active").IsTrue()))).
+		Where(depiq.C("age").Gt(20), depiq.C("name").Eq("b")).
+		Limit(20).
+		WithDialect("mock")
+	sds.Equal(fingerprint(ds), fingerprint(same))
+	sds.Equal(fingerprint(ds), fingerprint(exp.Canonicalize(same).(*depiq.SelectDataset)))
+	sds.True(exp.Equal(exp.Canonicalize(ds), exp.Canonicalize(ds.Where())))
+	sds.False(exp.Equal(exp.Canonicalize(ds), exp.Canonicalize(same)))
+
+	sds.NotEqual(fingerprint(ds), fingerprint(ds.Select("id")))
+	sds.NotEqual(fingerprint(ds), fingerprint(ds.ClearLimit()))
+	sds.NotEqual(fingerprint(ds), fingerprint(ds.Where(depiq.C("name").IsNull())))
+}
+
+func (sds *selectDatasetSuite) TestMarshalJSON() {
+	ds := depiq.From("users").
+		With("active", depiq.From("users").Where(depiq.C("active").IsTrue())).
+		Select("id", depiq.COUNT("*").Over(depiq.W().PartitionBy("team_id").OrderBy(depiq.C("id").Desc())).As("rank")).
+		Join(depiq.From("teams").As("t"), depiq.On(depiq.I("t.id").Eq(depiq.I("users.team_id")))).
+		Where(
+			depiq.Ex{"name": depiq.Op{"like": "a%"}, "role": []string{"admin", "owner"}},
+			depiq.C("id").NotIn(depiq.From("banned").Select("user_id")),
+		).
+		Window(depiq.W("w").OrderBy("id")).
+		UnionAll(depiq.From("archived_users")).
+		Order(depiq.C("id").Asc().NullsLast()).
+		Limit(10).
+		Offset(20).
+		ForUpdate(exp.SkipLocked)
+
+	data, err := json.Marshal(ds)
+	sds.NoError(err)
+	decoded, err := depiq.SelectFromJSON("mock", data)
+	sds.NoError(err)
+	sds.Equal(depiq.GetDialect("mock"), decoded.Dialect())
+
+	expectedSQL, expectedArgs, err := ds.WithDialect("mock").Prepared(true).ToSQL()
+	sds.NoError(err)
+	sql, args, err := decoded.Prepared(true).ToSQL()
+	sds.NoError(err)
+	sds.Equal(expectedSQL, sql)
+	sds.Equal(expectedArgs, args)
+
+	reencoded, err := json.Marshal(decoded)
+	sds.NoError(err)
+	sds.JSONEq(string(data), string(reencoded))
+}
+
+func (sds *selectDatasetSuite) TestMarshalJSON_withError() {
+	ds := depiq.From("test").SetError(errors.New("expected error"))
+	_, err := json.Marshal(ds)
+	sds.EqualError(err, "json: error calling MarshalJSON for type *depiq.SelectDataset: depiq: expected error")
+}
+
+func (sds *selectDatasetSuite) TestSelectFromJSON_invalid() {
+	ds, err := depiq.SelectFromJSON("mock", []byte(`{"version":1,"select":{"where":{"type":"column_list"}}}`))
+	sds.Nil(ds)
+	sds.EqualError(err, "depiq: invalid JSON at $.select.where: expected an expression_list got column_list")
+}
+
 func (sds *selectDatasetSuite) TestUpdate() {
 	where := depiq.Ex{"a": 1}
 	from := depiq.From("cte")
@@ -799,6 +1015,42 @@ func (sds *selectDatasetSuite) TestGroupBy() {
 	)
 }
 
+func (sds *selectDatasetSuite) TestGroupBy_groupingSets() {
+	bd := depiq.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.GroupBy(depiq.Rollup("a", depiq.GroupingSet("b", "c"))),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetGroupBy(exp.NewColumnListExpression(exp.NewGroupingExpression(
+					exp.RollupGroupingType,
+					exp.NewColumnListExpression("a"),
+					exp.NewColumnListExpression("b", "c"),
+				))),
+		},
+		selectTestCase{
+			ds: bd.GroupBy("a").GroupByAppend(depiq.Cube("b", "c")),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetGroupBy(exp.NewColumnListExpression("a", exp.NewGroupingExpression(
+					exp.CubeGroupingType,
+					exp.NewColumnListExpression("b"),
+					exp.NewColumnListExpression("c"),
+				))),
+		},
+		selectTestCase{
+			ds: bd.GroupBy(depiq.GroupingSets(depiq.GroupingSet("a", "b"), depiq.GroupingSet())),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetGroupBy(exp.NewColumnListExpression(exp.NewGroupingExpression(
+					exp.GroupingSetsGroupingType,
+					exp.NewColumnListExpression("a", "b"),
+					exp.NewColumnListExpression(),
+				))),
+		},
+	)
+}
+
 func (sds *selectDatasetSuite) TestWindow() {
 	w1 := depiq.W("w1").PartitionBy("a").OrderBy("b")
 	w2 := depiq.W("w2").PartitionBy("a").OrderBy("b")
@@ -1125,6 +1377,38 @@ func (sds *selectDatasetSuite) TestIntersectAll() {
 	)
 }
 
+func (sds *selectDatasetSuite) TestExcept() {
+	uds := depiq.From("union_test")
+	bd := depiq.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.Except(uds),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				CompoundsAppend(exp.NewCompoundExpression(exp.ExceptCompoundType, uds)),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestExceptAll() {
+	uds := depiq.From("union_test")
+	bd := depiq.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.ExceptAll(uds),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				CompoundsAppend(exp.NewCompoundExpression(exp.ExceptAllCompoundType, uds)),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
 func (sds *selectDatasetSuite) TestAs() {
 	bd := depiq.From("test")
 	sds.assertCases(
@@ -1512,6 +1796,73 @@ func (sds *selectDatasetSuite) TestScanVal_WithPreparedStatement() {
 	sds.EqualError(err, "depiq: type must be a pointer when scanning into val")
 }
 
+func (sds *selectDatasetSuite) TestScanMaps() {
+	mDB, sqlMock, err := sqlmock.New()
+	sds.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "name", "price" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(
+			sqlmock.NewColumn("name").OfType("VARCHAR", ""),
+			sqlmock.NewColumn("price").OfType("DECIMAL", ""),
+		).
+			AddRow([]byte("Bob"), []byte("10.50")).
+			AddRow([]byte("Sally"), []byte("20.00")))
+	sqlMock.ExpectQuery(`SELECT "name", "price" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name", "price"}).AddRow("Bob", 10))
+
+	db := depiq.New("mock", mDB)
+	var records []exp.Record
+	sds.NoError(db.From("items").Select("name", "price").ScanMaps(&records))
+	sds.Equal([]exp.Record{
+		{"name": "Bob", "price": exec.Decimal("10.50")},
+		{"name": "Sally", "price": exec.Decimal("20.00")},
+	}, records)
+
+	var ordered []exp.OrderedRecord
+	sds.NoError(db.From("items").Select("name", "price").ScanOrderedMaps(&ordered))
+	sds.Equal([]exp.OrderedRecord{{{Name: "name", Value: "Bob"}, {Name: "price", Value: int64(10)}}}, ordered)
+
+	sds.Equal(depiq.ErrQueryFactoryNotFoundError, depiq.From("items").ScanMaps(&records))
+	sds.Equal(depiq.ErrQueryFactoryNotFoundError, depiq.From("items").ScanOrderedMaps(&ordered))
+}
+
+func (sds *selectDatasetSuite) TestScanMap() {
+	mDB, sqlMock, err := sqlmock.New()
+	sds.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "name", "price" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name", "price"}).AddRow("Bob", 10))
+	sqlMock.ExpectQuery(`SELECT "name", "price" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name", "price"}).AddRow("Bob", 10))
+	sqlMock.ExpectQuery(`SELECT "name", "price" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name", "price"}))
+
+	db := depiq.New("mock", mDB)
+	var record exp.Record
+	found, err := db.From("items").Select("name", "price").ScanMap(&record)
+	sds.NoError(err)
+	sds.True(found)
+	sds.Equal(exp.Record{"name": "Bob", "price": int64(10)}, record)
+
+	var ordered exp.OrderedRecord
+	found, err = db.From("items").Select("name", "price").ScanOrderedMap(&ordered)
+	sds.NoError(err)
+	sds.True(found)
+	sds.Equal(exp.OrderedRecord{{Name: "name", Value: "Bob"}, {Name: "price", Value: int64(10)}}, ordered)
+
+	found, err = db.From("items").Select("name", "price").ScanMap(&record)
+	sds.NoError(err)
+	sds.False(found)
+
+	_, err = depiq.From("items").ScanMap(&record)
+	sds.Equal(depiq.ErrQueryFactoryNotFoundError, err)
+	_, err = depiq.From("items").ScanOrderedMap(&ordered)
+	sds.Equal(depiq.ErrQueryFactoryNotFoundError, err)
+}
+
 func (sds *selectDatasetSuite) TestCount() {
 	mDB, sqlMock, err := sqlmock.New()
 	sds.NoError(err)
@@ -1543,6 +1894,128 @@ func (sds *selectDatasetSuite) TestCount_WithPreparedStatement() {
 	sds.Equal(int64(10), count)
 }
 
+func (sds *selectDatasetSuite) TestFetchPage() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	sds.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "address", "name", COUNT(*) OVER () AS "depiq_total" FROM "items" ` +
+		`ORDER BY "name" ASC LIMIT 2 OFFSET 2`).
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name", "depiq_total"}).
+			AddRow("111 Test Addr", "Test1", 5).
+			AddRow("211 Test Addr", "Test2", 5))
+	// the page is after the last row
+	sqlMock.ExpectQuery(`SELECT "address", "name", COUNT(*) OVER () AS "depiq_total" FROM "items" ` +
+		`ORDER BY "name" ASC LIMIT 2 OFFSET 6`).
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name", "depiq_total"}))
+	sqlMock.ExpectQuery(`SELECT COUNT(*) AS "count" FROM "items" LIMIT 1`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	sqlMock.ExpectQuery(`SELECT "name", COUNT(*) OVER () AS "depiq_total" FROM "items" GROUP BY "name" LIMIT 10`).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "depiq_total"}).AddRow("Test1", 1))
+
+	db := depiq.New("mock", mDB)
+	ds := db.From("items").Order(depiq.C("name").Asc())
+	var items []dsTestActionItem
+	total, err := ds.FetchPage(context.Background(), &items, 2, 2)
+	sds.NoError(err)
+	sds.Equal(int64(5), total)
+	sds.Equal([]dsTestActionItem{
+		{Address: "111 Test Addr", Name: "Test1"},
+		{Address: "211 Test Addr", Name: "Test2"},
+	}, items)
+
+	items = nil
+	total, err = ds.FetchPage(context.Background(), &items, 4, 2)
+	sds.NoError(err)
+	sds.Equal(int64(5), total)
+	sds.Empty(items)
+
+	total, err = db.From("items").Select("name").GroupBy("name").FetchPage(context.Background(), &items, 1, 10)
+	sds.NoError(err)
+	sds.Equal(int64(1), total)
+	sds.Equal([]dsTestActionItem{{Name: "Test1"}}, items)
+	sds.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (sds *selectDatasetSuite) TestFetchPage_withCount() {
+	noWindow := depiq.DefaultDialectOptions()
+	noWindow.SupportsWindowFunction = false
+	depiq.RegisterDialect("no-window", noWindow)
+	defer depiq.DeregisterDialect("no-window")
+
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	sds.NoError(err)
+	sqlMock.ExpectQuery(`SELECT COUNT(*) AS "count" FROM "items" WHERE ("name" != 'Test3') LIMIT 1`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items" WHERE ("name" != 'Test3') LIMIT 2 OFFSET 2`).
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).AddRow("111 Test Addr", "Test1"))
+	// the page is after the last row
+	sqlMock.ExpectQuery(`SELECT COUNT(*) AS "count" FROM "items" WHERE ("name" != 'Test3') LIMIT 1`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	db := depiq.New("no-window", mDB)
+	ds := db.From("items").Where(depiq.C("name").Neq("Test3"))
+	var items []dsTestActionItem
+	total, err := ds.FetchPage(context.Background(), &items, 2, 2)
+	sds.NoError(err)
+	sds.Equal(int64(3), total)
+	sds.Equal([]dsTestActionItem{{Address: "111 Test Addr", Name: "Test1"}}, items)
+
+	items = nil
+	total, err = ds.FetchPage(context.Background(), &items, 3, 2)
+	sds.NoError(err)
+	sds.Equal(int64(3), total)
+	sds.Empty(items)
+	sds.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (sds *selectDatasetSuite) TestFetchPage_withDistinctOrCompounds() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	sds.NoError(err)
+	sqlMock.ExpectQuery(`SELECT COUNT(*) AS "count" FROM (SELECT DISTINCT "name" FROM "items") AS "t1" LIMIT 1`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	sqlMock.ExpectQuery(`SELECT DISTINCT "name" FROM "items" LIMIT 10`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("Test1"))
+	sqlMock.ExpectQuery(`SELECT COUNT(*) AS "count" FROM (SELECT "name" FROM "items" UNION ` +
+		`(SELECT "name" FROM "other_items")) AS "t1" LIMIT 1`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	db := depiq.New("mock", mDB)
+	var items []dsTestActionItem
+	total, err := db.From("items").Select("name").Distinct().FetchPage(context.Background(), &items, 1, 10)
+	sds.NoError(err)
+	sds.Equal(int64(1), total)
+	sds.Equal([]dsTestActionItem{{Name: "Test1"}}, items)
+
+	items = nil
+	total, err = db.From("items").Select("name").
+		Union(db.From("other_items").Select("name")).
+		FetchPage(context.Background(), &items, 1, 10)
+	sds.NoError(err)
+	sds.Equal(int64(0), total)
+	sds.Empty(items)
+	sds.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (sds *selectDatasetSuite) TestFetchPage_errors() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	sds.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "test", COUNT(*) OVER () AS "depiq_total" FROM "items" LIMIT 10`).
+		WillReturnRows(sqlmock.NewRows([]string{"test", "depiq_total"}).AddRow("test1", 1))
+
+	db := depiq.New("mock", mDB)
+	var items []dsTestActionItem
+	_, err = db.From("items").FetchPage(context.Background(), &items, 0, 10)
+	sds.EqualError(err, "depiq: page and page size must be greater than 0")
+	_, err = db.From("items").FetchPage(context.Background(), &items, 1, 0)
+	sds.EqualError(err, "depiq: page and page size must be greater than 0")
+	_, err = db.From("items").Select("test").FetchPage(context.Background(), &items, 1, 10)
+	sds.EqualError(err, `depiq: unable to find corresponding field to column "test" returned by query`)
+	_, err = db.From("items").FetchPage(context.Background(), items, 1, 10)
+	sds.EqualError(err, "depiq: type must be a pointer to a slice when scanning into structs")
+	_, err = depiq.From("items").FetchPage(context.Background(), &items, 1, 10)
+	sds.Equal(depiq.ErrQueryFactoryNotFoundError, err)
+	sds.NoError(sqlMock.ExpectationsWereMet())
+}
+
 func (sds *selectDatasetSuite) TestPluck() {
 	mDB, sqlMock, err := sqlmock.New()
 	sds.NoError(err)
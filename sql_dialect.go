@@ -78,6 +78,25 @@ func newDialect(dialect string, do *SQLDialectOptions) SQLDialect {
 	}
 }
 
+// returns the options of a dialect created by RegisterDialect or GetDialect, DefaultDialectOptions are used for other
+// SQLDialect implementations
+func dialectOptionsOf(d SQLDialect) *SQLDialectOptions {
+	if sd, ok := d.(*sqlDialect); ok {
+		return sd.dialectOptions
+	}
+	return DefaultDialectOptions()
+}
+
+// returns the Rewriter used to simplify the clauses of a dataset created with Simplify(true), nil is returned if the
+// clauses do not have to be simplified because the dialect simplifies every statement
+func datasetSimplifier(d SQLDialect, simplify bool) exp.Rewriter {
+	do := dialectOptionsOf(d)
+	if !simplify || do.SimplifyExpressions {
+		return nil
+	}
+	return sqlgen.Simplifier(do)
+}
+
 func (d *sqlDialect) Dialect() string {
 	return d.dialect
 }
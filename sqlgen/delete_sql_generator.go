@@ -28,6 +28,9 @@ func NewDeleteSQLGenerator(dialect string, do *SQLDialectOptions) DeleteSQLGener
 }
 
 func (dsg *deleteSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.DeleteClauses) {
+	if dsg.DialectOptions().SimplifyExpressions {
+		clauses = exp.RewriteDeleteClauses(clauses, Simplifier(dsg.DialectOptions()))
+	}
 	if !clauses.HasFrom() {
 		b.SetError(ErrNoSourceForDelete)
 		return
@@ -161,6 +161,20 @@ func (dsgs *deleteSQLGeneratorSuite) TestGenerate_withWhere() {
 	)
 }
 
+func (dsgs *deleteSQLGeneratorSuite) TestGenerate_withSimplifyExpressions() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SimplifyExpressions = true
+
+	dc := exp.NewDeleteClauses().
+		SetFrom(exp.NewIdentifierExpression("", "test", "")).
+		WhereAppend(exp.NewExpressionList(exp.OrType, exp.NewIdentifierExpression("", "", "a").In([]int{})))
+	dsgs.assertCases(
+		sqlgen.NewDeleteSQLGenerator("test", opts),
+		deleteTestCase{clause: dc, sql: `DELETE FROM "test" WHERE FALSE`},
+		deleteTestCase{clause: dc, sql: `DELETE FROM "test" WHERE FALSE`, isPrepared: true},
+	)
+}
+
 func (dsgs *deleteSQLGeneratorSuite) TestGenerate_withOrder() {
 	opts := sqlgen.DefaultDialectOptions()
 	opts.SupportsOrderByOnDelete = true
@@ -2,8 +2,11 @@ package sqlgen
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"reflect"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -20,6 +23,16 @@ type (
 		Dialect() string
 		Generate(b sb.SQLBuilder, val interface{})
 	}
+	// An Expression that writes its own SQL. Implement this interface to add custom expression types (e.g. PostGIS
+	// functions or vendor specific operators) without changing the generator. Nested values should be written with
+	// esg.Generate so they are quoted, interpolated or added as placeholders like any other value, esg.Dialect can be
+	// used to write dialect specific SQL.
+	SQLAppender interface {
+		exp.Expression
+		GenerateSQL(b SQLBuilder, esg ExpressionSQLGenerator)
+	}
+	// The builder SQL is written to. Exported so SQLAppender can be implemented outside of depiq
+	SQLBuilder = sb.SQLBuilder
 	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
 	// either override methods, or more typically update default values.
 	// See (github.com/orn-id/depiq/dialect/postgres)
@@ -30,15 +43,26 @@ type (
 )
 
 var (
+	// aggregates that ignore NULL values, so a FILTER clause can be rewritten as a CASE expression without
+	// changing the result
+	aggregateFilterRewriteFuncs = map[string]bool{"COUNT": true, "SUM": true, "AVG": true, "MIN": true, "MAX": true}
+
 	replacementRune = '?'
 	TrueLiteral     = exp.NewLiteralExpression("TRUE")
 	FalseLiteral    = exp.NewLiteralExpression("FALSE")
 
+	// unquoted collation names are written as is so they are limited to plain identifiers
+	unquotedCollationRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
 	ErrEmptyIdentifier = errors.New(
 		`a empty identifier was encountered, please specify a "schema", "table" or "column"`,
 	)
 	ErrUnexpectedNamedWindow = errors.New(`unexpected named window function`)
 	ErrEmptyCaseWhens        = errors.New(`when conditions not found for case statement`)
+
+	ErrWindowFrameStartRequired   = errors.New("window frame requires a start bound")
+	ErrTextSearchDocumentRequired = errors.New("full-text search expressions require at least one document")
+	ErrExistsSubqueryRequired     = errors.New("exists expressions require a subquery")
 )
 
 func errUnsupportedExpressionType(e exp.Expression) error {
@@ -57,10 +81,143 @@ func errUnsupportedBitwiseExpressionOperator(op exp.BitwiseOperation) error {
 	return errors.New("bitwise operator '%+v' not supported", op)
 }
 
+func errUnsupportedArithmeticExpressionOperator(op exp.ArithmeticOperation) error {
+	return errors.New("arithmetic operator '%+v' not supported", op)
+}
+
 func errUnsupportedRangeExpressionOperator(op exp.RangeOperation) error {
 	return errors.New("range operator %+v not supported", op)
 }
 
+func errCompoundNotSupported(ct exp.CompoundType, dialect string) error {
+	return errors.New("dialect does not support %s compound expressions [dialect=%s]", ct, dialect)
+}
+
+func errWindowFrameUnitNotSupported(unit exp.WindowFrameUnit, dialect string) error {
+	return errors.New("dialect does not support %s window frames [dialect=%s]", unit, dialect)
+}
+
+func errWindowFrameExclusionNotSupported(exclusion exp.WindowFrameExclusion, dialect string) error {
+	return errors.New("dialect does not support window frame EXCLUDE %s [dialect=%s]", exclusion, dialect)
+}
+
+func errUnsupportedWindowFrameBound(boundType exp.WindowFrameBoundType) error {
+	return errors.New("window frame bound '%s' not supported", boundType)
+}
+
+func errWindowFrameOffsetRequired(boundType exp.WindowFrameBoundType) error {
+	return errors.New("window frame bound '%s' requires an offset", boundType)
+}
+
+func errAggregateFilterNotSupported(name, dialect string) error {
+	return errors.New(
+		"dialect does not support FILTER clauses and %s cannot be safely rewritten as a CASE expression [dialect=%s]",
+		name, dialect,
+	)
+}
+
+func errAggregateOrderByNotSupported(dialect string) error {
+	return errors.New("dialect does not support ORDER BY in aggregate function calls [dialect=%s]", dialect)
+}
+
+func errWithinGroupNotSupported(dialect string) error {
+	return errors.New("dialect does not support WITHIN GROUP clauses [dialect=%s]", dialect)
+}
+
+func errUnsupportedExistsType(existsType exp.ExistsType) error {
+	return errors.New("exists type '%s' not supported", existsType)
+}
+
+func errQuantifierNotSupported(quantifier exp.QuantifierType, dialect string) error {
+	return errors.New("dialect does not support %s comparisons [dialect=%s]", quantifier, dialect)
+}
+
+func errGroupingNotSupported(groupingType exp.GroupingType, dialect string) error {
+	return errors.New("dialect does not support %s [dialect=%s]", groupingType, dialect)
+}
+
+func errWithRollupNotSupported(dialect string) error {
+	return errors.New(
+		"dialect only supports ROLLUP of plain columns as the only GROUP BY expression [dialect=%s]", dialect,
+	)
+}
+
+func errRowValueNotSupported(op exp.BooleanOperation, dialect string) error {
+	return errors.New("dialect does not support row value %s comparisons [dialect=%s]", op, dialect)
+}
+
+func errRowValueLengthMismatch(expected, actual int) error {
+	return errors.New("row value comparison expected %d values but got %d", expected, actual)
+}
+
+func errJSONOperationNotSupported(op exp.JSONOperation, dialect string) error {
+	return errors.New("dialect does not support JSON %s expressions [dialect=%s]", op, dialect)
+}
+
+func errIntervalNotSupported(dialect string) error {
+	return errors.New("dialect does not support INTERVAL literals, use DateAdd or DateSub [dialect=%s]", dialect)
+}
+
+func errDateArithmeticNotSupported(dialect string) error {
+	return errors.New("dialect does not support date arithmetic [dialect=%s]", dialect)
+}
+
+func errUnsupportedDateTimeStyle(style DateTimeStyle) error {
+	return errors.New("date time style '%d' not supported", style)
+}
+
+func errIntervalUnitNotSupported(unit exp.DateTimeUnit, dialect string) error {
+	return errors.New("dialect does not support %s intervals [dialect=%s]", unit, dialect)
+}
+
+func errExtractFieldNotSupported(field exp.DateTimeUnit, dialect string) error {
+	return errors.New("dialect does not support extracting %s [dialect=%s]", field, dialect)
+}
+
+func errDateTruncUnitNotSupported(unit exp.DateTimeUnit, dialect string) error {
+	return errors.New("dialect does not support truncating to %s [dialect=%s]", unit, dialect)
+}
+
+func errCurrentTimeNotSupported(currentTimeType exp.CurrentTimeType, dialect string) error {
+	return errors.New("dialect does not support %s [dialect=%s]", currentTimeType, dialect)
+}
+
+func errStringOperationNotSupported(op exp.StringOperation, dialect string) error {
+	return errors.New("dialect does not support the %s string function [dialect=%s]", op, dialect)
+}
+
+func errInvalidStringArgumentCount(op exp.StringOperation, count int) error {
+	return errors.New("invalid number of arguments for %s got %d", op, count)
+}
+
+func errTextSearchNotSupported(dialect string) error {
+	return errors.New("dialect does not support full-text search [dialect=%s]", dialect)
+}
+
+func errTextSearchModeNotSupported(mode exp.TextSearchMode, dialect string) error {
+	return errors.New("dialect does not support %s full-text search [dialect=%s]", mode, dialect)
+}
+
+func errTextSearchRankNotSupported(dialect string) error {
+	return errors.New("dialect does not support full-text search ranking [dialect=%s]", dialect)
+}
+
+func errTextSearchConfigNotSupported(dialect string) error {
+	return errors.New("dialect does not support full-text search configurations [dialect=%s]", dialect)
+}
+
+func errTextSearchSingleDocument(count int, dialect string) error {
+	return errors.New("dialect requires a single full-text search document got %d [dialect=%s]", count, dialect)
+}
+
+func errInvalidCollation(collation string) error {
+	return errors.New("invalid collation name %q, unquoted collations may only contain letters, digits and _", collation)
+}
+
+func errArraysNotSupported(dialect string) error {
+	return errors.New("dialect does not support arrays [dialect=%s]", dialect)
+}
+
 func errLateralNotSupported(dialect string) error {
 	return errors.New("dialect does not support lateral expressions [dialect=%s]", dialect)
 }
@@ -160,6 +317,8 @@ func (esg *expressionSQLGenerator) reflectSQL(b sb.SQLBuilder, val interface{})
 // nolint:gocyclo // not complex just long
 func (esg *expressionSQLGenerator) expressionSQL(b sb.SQLBuilder, expression exp.Expression) {
 	switch e := expression.(type) {
+	case SQLAppender:
+		e.GenerateSQL(b, esg)
 	case exp.ColumnListExpression:
 		esg.columnListSQL(b, e)
 	case exp.ExpressionList:
@@ -176,6 +335,8 @@ func (esg *expressionSQLGenerator) expressionSQL(b sb.SQLBuilder, expression exp
 		esg.booleanExpressionSQL(b, e)
 	case exp.BitwiseExpression:
 		esg.bitwiseExpressionSQL(b, e)
+	case exp.ArithmeticExpression:
+		esg.arithmeticExpressionSQL(b, e)
 	case exp.RangeExpression:
 		esg.rangeExpressionSQL(b, e)
 	case exp.OrderedExpression:
@@ -188,6 +349,38 @@ func (esg *expressionSQLGenerator) expressionSQL(b sb.SQLBuilder, expression exp
 		esg.sqlWindowFunctionExpression(b, e)
 	case exp.WindowExpression:
 		esg.windowExpressionSQL(b, e)
+	case exp.ExistsExpression:
+		esg.existsExpressionSQL(b, e)
+	case exp.QuantifiedExpression:
+		esg.quantifiedExpressionSQL(b, e)
+	case exp.GroupingExpression:
+		esg.groupingExpressionSQL(b, e)
+	case exp.WindowFrameExpression:
+		esg.windowFrameExpressionSQL(b, e)
+	case exp.WindowFrameBound:
+		esg.windowFrameBoundSQL(b, e)
+	case exp.TupleExpression:
+		esg.tupleExpressionSQL(b, e)
+	case exp.ArrayExpression:
+		esg.arrayExpressionSQL(b, e)
+	case exp.TextSearchExpression:
+		esg.textSearchExpressionSQL(b, e)
+	case exp.StringExpression:
+		esg.stringExpressionSQL(b, e)
+	case exp.CollateExpression:
+		esg.collateExpressionSQL(b, e)
+	case exp.IntervalExpression:
+		esg.intervalExpressionSQL(b, e)
+	case exp.DateArithmeticExpression:
+		esg.dateArithmeticExpressionSQL(b, e)
+	case exp.ExtractExpression:
+		esg.extractExpressionSQL(b, e)
+	case exp.DateTruncExpression:
+		esg.dateTruncExpressionSQL(b, e)
+	case exp.CurrentTimeExpression:
+		esg.currentTimeExpressionSQL(b, e)
+	case exp.JSONExpression:
+		esg.jsonExpressionSQL(b, e)
 	case exp.CastExpression:
 		esg.castExpressionSQL(b, e)
 	case exp.AppendableExpression:
@@ -332,6 +525,11 @@ func (esg *expressionSQLGenerator) literalString(b sb.SQLBuilder, s string) {
 		esg.placeHolderSQL(b, s)
 		return
 	}
+	esg.quotedStringSQL(b, s)
+}
+
+// Generates a quoted string, even when generating prepared SQL
+func (esg *expressionSQLGenerator) quotedStringSQL(b sb.SQLBuilder, s string) {
 	b.WriteRunes(esg.dialectOptions.StringQuote)
 	for _, char := range s {
 		if e, ok := esg.dialectOptions.EscapedRunes[char]; ok {
@@ -386,6 +584,22 @@ func (esg *expressionSQLGenerator) aliasedExpressionSQL(b sb.SQLBuilder, aliased
 
 // Generates SQL for a BooleanExpresion (e.g. I("a").Eq(2) -> "a" = 2)
 func (esg *expressionSQLGenerator) booleanExpressionSQL(b sb.SQLBuilder, operator exp.BooleanExpression) {
+	if t, ok := operator.LHS().(exp.TupleExpression); ok && esg.shouldExpandRowValue(operator) {
+		esg.expandedRowValueSQL(b, t, operator)
+		return
+	}
+	if operator.Op() == exp.IsDistinctFromOp || operator.Op() == exp.IsNotDistinctFromOp {
+		if _, ok := esg.dialectOptions.BooleanOperatorLookup[operator.Op()]; !ok {
+			esg.nullSafeComparisonSQL(b, operator)
+			return
+		}
+	}
+	if operator.Op() == exp.InOp || operator.Op() == exp.NotInOp {
+		if slice, ok := esg.bindableSlice(b, operator.RHS()); ok {
+			esg.boundInListSQL(b, operator, slice)
+			return
+		}
+	}
 	b.WriteRunes(esg.dialectOptions.LeftParenRune)
 	esg.Generate(b, operator.LHS())
 	b.WriteRunes(esg.dialectOptions.SpaceRune)
@@ -398,6 +612,10 @@ func (esg *expressionSQLGenerator) booleanExpressionSQL(b sb.SQLBuilder, operato
 	}
 	rhs := operator.RHS()
 
+	if isArrayComparison(operator) && isArraySlice(rhs) {
+		rhs = exp.NewArrayExpression(rhs)
+	}
+
 	if (operatorOp == exp.IsOp || operatorOp == exp.IsNotOp) && rhs != nil && !esg.dialectOptions.BooleanDataTypeSupported {
 		b.SetError(errors.New("boolean data type is not supported by dialect %q", esg.dialect))
 		return
@@ -426,6 +644,61 @@ func (esg *expressionSQLGenerator) booleanExpressionSQL(b sb.SQLBuilder, operato
 	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
+// Generates SQL for an IS [NOT] DISTINCT FROM comparison for dialects that do not support the operator natively.
+// If the inverse operator is supported it is negated (e.g. NOT ("a" <=> "b")), otherwise it is expanded
+//   ("a" IS DISTINCT FROM "b") -> ((("a" != "b") OR ("a" IS NULL) OR ("b" IS NULL)) AND (("a" IS NOT NULL) OR ("b" IS NOT NULL)))
+//   ("a" IS NOT DISTINCT FROM "b") -> ((("a" = "b") AND ("a" IS NOT NULL) AND ("b" IS NOT NULL)) OR (("a" IS NULL) AND ("b" IS NULL)))
+func (esg *expressionSQLGenerator) nullSafeComparisonSQL(b sb.SQLBuilder, operator exp.BooleanExpression) {
+	distinct := operator.Op() == exp.IsDistinctFromOp
+	inverse := exp.IsDistinctFromOp
+	if distinct {
+		inverse = exp.IsNotDistinctFromOp
+	}
+	if _, ok := esg.dialectOptions.BooleanOperatorLookup[inverse]; ok {
+		b.Write(esg.dialectOptions.NotFragment)
+		esg.Generate(b, exp.NewBooleanExpression(inverse, operator.LHS(), operator.RHS()))
+		return
+	}
+	lhs := operator.LHS()
+	if operator.RHS() == nil {
+		if distinct {
+			esg.Generate(b, exp.NewBooleanExpression(exp.IsNotOp, lhs, nil))
+		} else {
+			esg.Generate(b, exp.NewBooleanExpression(exp.IsOp, lhs, nil))
+		}
+		return
+	}
+	rhs, ok := operator.RHS().(exp.Expression)
+	if !ok {
+		rhs = exp.NewLiteralExpression("?", operator.RHS())
+	}
+	if distinct {
+		esg.Generate(b, exp.NewExpressionList(exp.AndType,
+			exp.NewExpressionList(exp.OrType,
+				exp.NewBooleanExpression(exp.NeqOp, lhs, rhs),
+				exp.NewBooleanExpression(exp.IsOp, lhs, nil),
+				exp.NewBooleanExpression(exp.IsOp, rhs, nil),
+			),
+			exp.NewExpressionList(exp.OrType,
+				exp.NewBooleanExpression(exp.IsNotOp, lhs, nil),
+				exp.NewBooleanExpression(exp.IsNotOp, rhs, nil),
+			),
+		))
+		return
+	}
+	esg.Generate(b, exp.NewExpressionList(exp.OrType,
+		exp.NewExpressionList(exp.AndType,
+			exp.NewBooleanExpression(exp.EqOp, lhs, rhs),
+			exp.NewBooleanExpression(exp.IsNotOp, lhs, nil),
+			exp.NewBooleanExpression(exp.IsNotOp, rhs, nil),
+		),
+		exp.NewExpressionList(exp.AndType,
+			exp.NewBooleanExpression(exp.IsOp, lhs, nil),
+			exp.NewBooleanExpression(exp.IsOp, rhs, nil),
+		),
+	))
+}
+
 // Generates SQL for a BitwiseExpresion (e.g. I("a").BitwiseOr(2) - > "a" | 2)
 func (esg *expressionSQLGenerator) bitwiseExpressionSQL(b sb.SQLBuilder, operator exp.BitwiseExpression) {
 	b.WriteRunes(esg.dialectOptions.LeftParenRune)
@@ -448,6 +721,28 @@ func (esg *expressionSQLGenerator) bitwiseExpressionSQL(b sb.SQLBuilder, operato
 	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
+// Generates SQL for an ArithmeticExpression (e.g. I("a").Add(2) - > "a" + 2)
+func (esg *expressionSQLGenerator) arithmeticExpressionSQL(b sb.SQLBuilder, operator exp.ArithmeticExpression) {
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+
+	if operator.LHS() != nil {
+		esg.Generate(b, operator.LHS())
+		b.WriteRunes(esg.dialectOptions.SpaceRune)
+	}
+
+	operatorOp := operator.Op()
+	if val, ok := esg.dialectOptions.ArithmeticOperatorLookup[operatorOp]; ok {
+		b.Write(val)
+	} else {
+		b.SetError(errUnsupportedArithmeticExpressionOperator(operatorOp))
+		return
+	}
+
+	b.WriteRunes(esg.dialectOptions.SpaceRune)
+	esg.Generate(b, operator.RHS())
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
 // Generates SQL for a RangeExpresion (e.g. I("a").Between(RangeVal{Start:2,End:5}) -> "a" BETWEEN 2 AND 5)
 func (esg *expressionSQLGenerator) rangeExpressionSQL(b sb.SQLBuilder, operator exp.RangeExpression) {
 	b.WriteRunes(esg.dialectOptions.LeftParenRune)
@@ -556,9 +851,96 @@ func (esg *expressionSQLGenerator) literalExpressionSQL(b sb.SQLBuilder, literal
 
 // Generates SQL for a SQLFunctionExpression
 //   COUNT(I("a")) -> COUNT("a")
+//   SUM(I("a")).Filter(I("b").Gt(1)) -> SUM("a") FILTER (WHERE ("b" > 1))
+//   Func("string_agg", I("a"), ",").OrderBy(I("a").Asc()) -> string_agg("a", ',' ORDER BY "a" ASC)
+//   Func("percentile_cont", 0.5).WithinGroup(I("a").Asc()) -> percentile_cont(0.5) WITHIN GROUP (ORDER BY "a" ASC)
 func (esg *expressionSQLGenerator) sqlFunctionExpressionSQL(b sb.SQLBuilder, sqlFunc exp.SQLFunctionExpression) {
+	if sqlFunc.HasFilter() && !esg.dialectOptions.SupportsAggregateFilter {
+		esg.aggregateFilterRewriteSQL(b, sqlFunc)
+		return
+	}
 	b.WriteStrings(sqlFunc.Name())
-	esg.Generate(b, sqlFunc.Args())
+	if sqlFunc.HasOrder() {
+		if !esg.dialectOptions.SupportsAggregateOrderBy {
+			b.SetError(errAggregateOrderByNotSupported(esg.dialect))
+			return
+		}
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		args := sqlFunc.Args()
+		for i, l := 0, len(args); i < l; i++ {
+			esg.Generate(b, args[i])
+			if i < l-1 {
+				b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+			}
+		}
+		b.Write(esg.dialectOptions.OrderByFragment)
+		esg.Generate(b, sqlFunc.OrderCols())
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	} else {
+		esg.Generate(b, sqlFunc.Args())
+	}
+	if sqlFunc.HasWithinGroup() {
+		if !esg.dialectOptions.SupportsWithinGroup {
+			b.SetError(errWithinGroupNotSupported(esg.dialect))
+			return
+		}
+		b.Write(esg.dialectOptions.WithinGroupFragment)
+		esg.Generate(b, sqlFunc.WithinGroupCols())
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	}
+	if sqlFunc.HasFilter() {
+		b.Write(esg.dialectOptions.AggregateFilterFragment)
+		esg.Generate(b, sqlFunc.FilterConditions())
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	}
+}
+
+// Generates SQL for an aggregate with a FILTER clause on dialects that do not support FILTER by moving the
+// condition into a CASE expression. This is only done for aggregates that ignore NULL values, any other
+// function results in an error.
+//   SUM(I("a")).Filter(I("b").Gt(1)) -> SUM(CASE WHEN ("b" > 1) THEN "a" END)
+//   COUNT(Star()).Filter(I("b").Gt(1)) -> COUNT(CASE WHEN ("b" > 1) THEN 1 END)
+//   COUNT(DISTINCT("a")).Filter(I("b").Gt(1)) -> COUNT(DISTINCT(CASE WHEN ("b" > 1) THEN "a" END))
+func (esg *expressionSQLGenerator) aggregateFilterRewriteSQL(b sb.SQLBuilder, sqlFunc exp.SQLFunctionExpression) {
+	args := sqlFunc.Args()
+	if !aggregateFilterRewriteFuncs[strings.ToUpper(sqlFunc.Name())] ||
+		len(args) != 1 || sqlFunc.HasOrder() || sqlFunc.HasWithinGroup() {
+		b.SetError(errAggregateFilterNotSupported(sqlFunc.Name(), esg.dialect))
+		return
+	}
+	arg := args[0]
+	var distinct exp.SQLFunctionExpression
+	if fn, ok := arg.(exp.SQLFunctionExpression); ok && strings.EqualFold(fn.Name(), "DISTINCT") {
+		if len(fn.Args()) != 1 || fn.HasFilter() || fn.HasOrder() || fn.HasWithinGroup() {
+			b.SetError(errAggregateFilterNotSupported(sqlFunc.Name(), esg.dialect))
+			return
+		}
+		distinct = fn
+		arg = fn.Args()[0]
+	}
+	if isStarExpression(arg) {
+		if distinct != nil {
+			b.SetError(errAggregateFilterNotSupported(sqlFunc.Name(), esg.dialect))
+			return
+		}
+		arg = exp.NewLiteralExpression("1")
+	}
+	var rewritten interface{} = exp.NewCaseExpression().When(sqlFunc.FilterConditions(), arg)
+	if distinct != nil {
+		rewritten = exp.NewSQLFunctionExpression(distinct.Name(), rewritten)
+	}
+	esg.Generate(b, exp.NewSQLFunctionExpression(sqlFunc.Name(), rewritten))
+}
+
+// returns true if the value is a * (e.g. COUNT(*))
+func isStarExpression(val interface{}) bool {
+	switch v := val.(type) {
+	case exp.IdentifierExpression:
+		return v.GetSchema() == "" && v.GetTable() == "" && v.GetCol() == "*"
+	case exp.LiteralExpression:
+		return v.Literal() == "*" && len(v.Args()) == 0
+	}
+	return false
 }
 
 func (esg *expressionSQLGenerator) sqlWindowFunctionExpression(b sb.SQLBuilder, sqlWinFunc exp.SQLWindowFunctionExpression) {
@@ -614,7 +996,1079 @@ func (esg *expressionSQLGenerator) windowExpressionSQL(b sb.SQLBuilder, we exp.W
 		b.Write(esg.dialectOptions.WindowOrderByFragment)
 		esg.Generate(b, we.OrderCols())
 	}
+	if we.HasFrame() {
+		if we.HasParent() || hasPartition || hasOrder {
+			b.WriteRunes(esg.dialectOptions.SpaceRune)
+		}
+		esg.Generate(b, we.Frame())
+	}
+
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a WindowFrameExpression
+//   ROWS UNBOUNDED PRECEDING
+//   ROWS BETWEEN 6 PRECEDING AND CURRENT ROW
+//   RANGE BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING EXCLUDE TIES
+func (esg *expressionSQLGenerator) windowFrameExpressionSQL(b sb.SQLBuilder, frame exp.WindowFrameExpression) {
+	unit, ok := esg.dialectOptions.WindowFrameUnitLookup[frame.Unit()]
+	if !ok {
+		b.SetError(errWindowFrameUnitNotSupported(frame.Unit(), esg.dialect))
+		return
+	}
+	if frame.Start() == nil {
+		b.SetError(ErrWindowFrameStartRequired)
+		return
+	}
+	b.Write(unit)
+	if frame.HasEnd() {
+		b.Write(esg.dialectOptions.WindowFrameBetweenFragment)
+		esg.Generate(b, frame.Start())
+		b.Write(esg.dialectOptions.WindowFrameAndFragment)
+		esg.Generate(b, frame.End())
+	} else {
+		b.WriteRunes(esg.dialectOptions.SpaceRune)
+		esg.Generate(b, frame.Start())
+	}
+	if frame.HasExclusion() {
+		exclusion, ok := esg.dialectOptions.WindowFrameExclusionLookup[frame.Exclusion()]
+		if !ok {
+			b.SetError(errWindowFrameExclusionNotSupported(frame.Exclusion(), esg.dialect))
+			return
+		}
+		b.Write(exclusion)
+	}
+}
+
+// Generates SQL for a WindowFrameBound
+//   UNBOUNDED PRECEDING
+//   6 PRECEDING
+//   CURRENT ROW
+func (esg *expressionSQLGenerator) windowFrameBoundSQL(b sb.SQLBuilder, bound exp.WindowFrameBound) {
+	fragment, ok := esg.dialectOptions.WindowFrameBoundLookup[bound.Type()]
+	if !ok {
+		b.SetError(errUnsupportedWindowFrameBound(bound.Type()))
+		return
+	}
+	switch bound.Type() {
+	case exp.PrecedingFrameBound, exp.FollowingFrameBound:
+		if bound.Offset() == nil {
+			b.SetError(errWindowFrameOffsetRequired(bound.Type()))
+			return
+		}
+		esg.Generate(b, bound.Offset())
+	}
+	b.Write(fragment)
+}
+
+// Generates SQL for an ExistsExpression
+//   EXISTS (SELECT * FROM "b" WHERE ("b"."a_id" = "a"."id"))
+//   NOT EXISTS (SELECT * FROM "b" WHERE ("b"."a_id" = "a"."id"))
+func (esg *expressionSQLGenerator) existsExpressionSQL(b sb.SQLBuilder, exists exp.ExistsExpression) {
+	subquery := exists.Subquery()
+	if subquery == nil {
+		b.SetError(ErrExistsSubqueryRequired)
+		return
+	}
+	if v := reflect.ValueOf(subquery); v.Kind() == reflect.Ptr && v.IsNil() {
+		b.SetError(ErrExistsSubqueryRequired)
+		return
+	}
+	switch exists.Type() {
+	case exp.ExistsSubqueryType:
+		b.Write(esg.dialectOptions.ExistsFragment)
+	case exp.NotExistsSubqueryType:
+		b.Write(esg.dialectOptions.NotExistsFragment)
+	default:
+		b.SetError(errUnsupportedExistsType(exists.Type()))
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	subquery.AppendSQL(b)
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a QuantifiedExpression
+//   ANY(SELECT "a" FROM "b")
+//   ALL(SELECT "a" FROM "b")
+func (esg *expressionSQLGenerator) quantifiedExpressionSQL(b sb.SQLBuilder, quantified exp.QuantifiedExpression) {
+	quantifier, ok := esg.dialectOptions.QuantifierLookup[quantified.Quantifier()]
+	if !ok {
+		b.SetError(errQuantifierNotSupported(quantified.Quantifier(), esg.dialect))
+		return
+	}
+	b.Write(quantifier).WriteRunes(esg.dialectOptions.LeftParenRune)
+	if ae, ok := quantified.Value().(exp.AppendableExpression); ok {
+		ae.AppendSQL(b)
+	} else if isArraySlice(quantified.Value()) {
+		esg.arrayExpressionSQL(b, exp.NewArrayExpression(quantified.Value()))
+	} else {
+		esg.Generate(b, quantified.Value())
+	}
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// the minimum and maximum number of arguments of each StringOperation, a maximum of -1 is unbounded
+var stringArgumentCounts = map[exp.StringOperation][2]int{
+	exp.ConcatOp:    {1, -1},
+	exp.LowerOp:     {1, 1},
+	exp.UpperOp:     {1, 1},
+	exp.TrimOp:      {1, 1},
+	exp.SubstringOp: {2, 3},
+	exp.LengthOp:    {1, 1},
+	exp.PositionOp:  {2, 2},
+	exp.ReplaceOp:   {3, 3},
+}
+
+// Generates SQL for a StringExpression
+//   ("first" || ' ' || "last")
+//   CONCAT(`first`, ' ', `last`)
+//   LOWER("name")
+//   POSITION('a' IN "name")
+func (esg *expressionSQLGenerator) stringExpressionSQL(b sb.SQLBuilder, s exp.StringExpression) {
+	op, args := s.Op(), s.Args()
+	counts, ok := stringArgumentCounts[op]
+	if !ok || len(args) < counts[0] || (counts[1] >= 0 && len(args) > counts[1]) {
+		b.SetError(errInvalidStringArgumentCount(op, len(args)))
+		return
+	}
+	if op == exp.ConcatOp && len(esg.dialectOptions.ConcatOperator) > 0 {
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		for i, arg := range args {
+			if i > 0 {
+				b.WriteRunes(esg.dialectOptions.SpaceRune).
+					Write(esg.dialectOptions.ConcatOperator).
+					WriteRunes(esg.dialectOptions.SpaceRune)
+			}
+			esg.Generate(b, arg)
+		}
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+		return
+	}
+	fn, ok := esg.dialectOptions.StringFunctionLookup[op]
+	if !ok {
+		b.SetError(errStringOperationNotSupported(op, esg.dialect))
+		return
+	}
+	switch op {
+	case exp.PositionOp:
+		target, substring := args[0], args[1]
+		switch esg.dialectOptions.PositionStyle {
+		case InPositionStyle:
+			b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune)
+			esg.Generate(b, substring)
+			b.Write(esg.dialectOptions.PositionInFragment)
+			esg.Generate(b, target)
+			b.WriteRunes(esg.dialectOptions.RightParenRune)
+			return
+		case StringFirstPositionStyle:
+			args = []interface{}{target, substring}
+		case SubstringFirstPositionStyle:
+			args = []interface{}{substring, target}
+		}
+	case exp.SubstringOp:
+		if len(args) == 2 && esg.dialectOptions.SubstringRequiresLength {
+			args = []interface{}{args[0], args[1], exp.NewStringExpression(exp.LengthOp, args[0])}
+		}
+	}
+	b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune)
+	for i, arg := range args {
+		if i > 0 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+		esg.Generate(b, arg)
+	}
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a CollateExpression
+//   "name" COLLATE "C"
+//   "name" COLLATE Latin1_General_CS_AS
+func (esg *expressionSQLGenerator) collateExpressionSQL(b sb.SQLBuilder, c exp.CollateExpression) {
+	esg.Generate(b, c.Collated())
+	b.Write(esg.dialectOptions.CollateFragment)
+	if esg.dialectOptions.QuoteCollations {
+		esg.Generate(b, exp.NewIdentifierExpression("", "", c.Collation()))
+		return
+	}
+	if !unquotedCollationRegexp.MatchString(c.Collation()) {
+		b.SetError(errInvalidCollation(c.Collation()))
+		return
+	}
+	b.WriteStrings(c.Collation())
+}
+
+// the units an interval is converted into when its unit is missing from the IntervalUnitLookup of a dialect
+var equivalentIntervalUnits = map[exp.DateTimeUnit]struct {
+	unit   exp.DateTimeUnit
+	factor int64
+}{
+	exp.WeekUnit:    {unit: exp.DayUnit, factor: 7},
+	exp.QuarterUnit: {unit: exp.MonthUnit, factor: 3},
+}
+
+// returns the amount and SQL unit of an interval, a unit missing from the IntervalUnitLookup of the dialect is
+// converted into an equivalent unit if there is one (e.g. 1 quarter -> 3 months and 1 week -> 7 days)
+func (esg *expressionSQLGenerator) intervalUnit(interval exp.IntervalExpression) (amount int64, unit []byte, ok bool) {
+	amount = interval.Amount()
+	if unit, ok = esg.dialectOptions.IntervalUnitLookup[interval.Unit()]; ok {
+		return amount, unit, true
+	}
+	if eq, hasEquivalent := equivalentIntervalUnits[interval.Unit()]; hasEquivalent {
+		if unit, ok = esg.dialectOptions.IntervalUnitLookup[eq.unit]; ok {
+			return amount * eq.factor, unit, true
+		}
+	}
+	return 0, nil, false
+}
+
+// Generates SQL for an IntervalExpression, the amount is always interpolated
+//   INTERVAL '7 days'
+//   INTERVAL 7 DAY
+func (esg *expressionSQLGenerator) intervalExpressionSQL(b sb.SQLBuilder, interval exp.IntervalExpression) {
+	amount, unit, ok := esg.intervalUnit(interval)
+	if !ok {
+		b.SetError(errIntervalUnitNotSupported(interval.Unit(), esg.dialect))
+		return
+	}
+	switch esg.dialectOptions.DateTimeStyle {
+	case IntervalDateTimeStyle:
+		b.Write(esg.dialectOptions.IntervalFragment)
+		esg.quotedStringSQL(b, strconv.FormatInt(amount, 10)+" "+string(unit))
+	case DateAddDateTimeStyle:
+		b.Write(esg.dialectOptions.IntervalFragment).
+			WriteStrings(strconv.FormatInt(amount, 10)).
+			WriteRunes(esg.dialectOptions.SpaceRune).
+			Write(unit)
+	default:
+		b.SetError(errIntervalNotSupported(esg.dialect))
+	}
+}
+
+// Generates SQL for a DateArithmeticExpression
+//   ("ts" - INTERVAL '7 days')
+//   DATE_SUB(`ts`, INTERVAL 7 DAY)
+//   DATEADD(day, -7, "ts")
+//   datetime(`ts`, '-7 days')
+func (esg *expressionSQLGenerator) dateArithmeticExpressionSQL(b sb.SQLBuilder, da exp.DateArithmeticExpression) {
+	interval := da.Interval()
+	amount, unit, ok := esg.intervalUnit(interval)
+	if !ok {
+		b.SetError(errIntervalUnitNotSupported(interval.Unit(), esg.dialect))
+		return
+	}
+	style := esg.dialectOptions.DateTimeStyle
+	if style == IntervalDateTimeStyle {
+		op := exp.AddOp
+		if da.Op() == exp.DateSubOp {
+			op = exp.SubOp
+		}
+		esg.Generate(b, exp.NewArithmeticExpression(op, da.LHS(), interval))
+		return
+	}
+	fn := esg.dialectOptions.DateAddFunction
+	if da.Op() == exp.DateSubOp {
+		if style == DateAddDateTimeStyle {
+			fn = esg.dialectOptions.DateSubFunction
+		}
+		amount = -amount
+	}
+	if len(fn) == 0 {
+		b.SetError(errDateArithmeticNotSupported(esg.dialect))
+		return
+	}
+	switch style {
+	case DateAddDateTimeStyle:
+		b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, da.LHS())
+		b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		esg.intervalExpressionSQL(b, interval)
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	case DatePartDateTimeStyle:
+		b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune).
+			Write(unit).
+			WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune).
+			WriteStrings(strconv.FormatInt(amount, 10)).
+			WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		esg.Generate(b, da.LHS())
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	case ModifierDateTimeStyle:
+		b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, da.LHS())
+		b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		modifier := strconv.FormatInt(amount, 10) + " " + string(unit)
+		if amount >= 0 {
+			modifier = "+" + modifier
+		}
+		esg.quotedStringSQL(b, modifier)
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	default:
+		b.SetError(errUnsupportedDateTimeStyle(style))
+	}
+}
+
+// Generates SQL for an ExtractExpression
+//   EXTRACT(YEAR FROM "ts")
+//   DATEPART(year, "ts")
+//   CAST(strftime('%Y', `ts`) AS INTEGER)
+func (esg *expressionSQLGenerator) extractExpressionSQL(b sb.SQLBuilder, extract exp.ExtractExpression) {
+	field, ok := esg.dialectOptions.ExtractFieldLookup[extract.Field()]
+	if !ok {
+		b.SetError(errExtractFieldNotSupported(extract.Field(), esg.dialect))
+		return
+	}
+	style := esg.dialectOptions.DateTimeStyle
+	castType := esg.dialectOptions.ExtractCastType
+	esg.castStartSQL(b, castType)
+	b.Write(esg.dialectOptions.ExtractFunction).WriteRunes(esg.dialectOptions.LeftParenRune)
+	switch style {
+	case IntervalDateTimeStyle, DateAddDateTimeStyle:
+		b.Write(field).Write(esg.dialectOptions.FromFragment).WriteRunes(esg.dialectOptions.SpaceRune)
+	case DatePartDateTimeStyle:
+		b.Write(field).WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+	case ModifierDateTimeStyle:
+		esg.quotedStringSQL(b, string(field))
+		b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+	default:
+		b.SetError(errUnsupportedDateTimeStyle(style))
+		return
+	}
+	esg.Generate(b, extract.Source())
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+	esg.castEndSQL(b, castType)
+}
+
+// Generates SQL for a DateTruncExpression
+//   date_trunc('month', "ts")
+//   CAST(DATE_FORMAT(`ts`, '%Y-%m-01 00:00:00') AS DATETIME)
+//   DATETRUNC(month, "ts")
+//   strftime('%Y-%m-01 00:00:00', `ts`)
+func (esg *expressionSQLGenerator) dateTruncExpressionSQL(b sb.SQLBuilder, dt exp.DateTruncExpression) {
+	unit, ok := esg.dialectOptions.DateTruncLookup[dt.Unit()]
+	if !ok {
+		b.SetError(errDateTruncUnitNotSupported(dt.Unit(), esg.dialect))
+		return
+	}
+	style := esg.dialectOptions.DateTimeStyle
+	castType := esg.dialectOptions.DateTruncCastType
+	esg.castStartSQL(b, castType)
+	b.Write(esg.dialectOptions.DateTruncFunction).WriteRunes(esg.dialectOptions.LeftParenRune)
+	switch style {
+	case IntervalDateTimeStyle, ModifierDateTimeStyle:
+		esg.quotedStringSQL(b, string(unit))
+		b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		esg.Generate(b, dt.Source())
+	case DateAddDateTimeStyle:
+		esg.Generate(b, dt.Source())
+		b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		esg.quotedStringSQL(b, string(unit))
+	case DatePartDateTimeStyle:
+		b.Write(unit).WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		esg.Generate(b, dt.Source())
+	default:
+		b.SetError(errUnsupportedDateTimeStyle(style))
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+	esg.castEndSQL(b, castType)
+}
+
+// Starts the CAST of a date or time function when a cast type is set
+//   CAST(strftime('%Y', `ts`) AS INTEGER)
+func (esg *expressionSQLGenerator) castStartSQL(b sb.SQLBuilder, castType []byte) {
+	if len(castType) > 0 {
+		b.Write(esg.dialectOptions.CastFragment).WriteRunes(esg.dialectOptions.LeftParenRune)
+	}
+}
+
+// Ends the CAST started with castStartSQL
+func (esg *expressionSQLGenerator) castEndSQL(b sb.SQLBuilder, castType []byte) {
+	if len(castType) > 0 {
+		b.Write(esg.dialectOptions.AsFragment).Write(castType).WriteRunes(esg.dialectOptions.RightParenRune)
+	}
+}
+
+// Generates SQL for a CurrentTimeExpression
+//   NOW()
+//   CURRENT_TIMESTAMP
+func (esg *expressionSQLGenerator) currentTimeExpressionSQL(b sb.SQLBuilder, ct exp.CurrentTimeExpression) {
+	if val, ok := esg.dialectOptions.CurrentTimeLookup[ct.Type()]; ok {
+		b.Write(val)
+		return
+	}
+	b.SetError(errCurrentTimeNotSupported(ct.Type(), esg.dialect))
+}
+
+// Generates SQL for a TextSearchExpression using the TextSearchStyle of the dialect
+//   (to_tsvector('english', "body") @@ websearch_to_tsquery('english', 'cats'))
+//   MATCH (`title`, `body`) AGAINST ('cats' IN NATURAL LANGUAGE MODE)
+//   ("docs" MATCH 'cats')
+//   FREETEXT(("title", "body"), 'cats', LANGUAGE 'english')
+func (esg *expressionSQLGenerator) textSearchExpressionSQL(b sb.SQLBuilder, search exp.TextSearchExpression) {
+	style := esg.dialectOptions.TextSearchStyle
+	if style == NoTextSearchStyle {
+		b.SetError(errTextSearchNotSupported(esg.dialect))
+		return
+	}
+	mode, ok := esg.dialectOptions.TextSearchModeLookup[search.Mode()]
+	if !ok {
+		b.SetError(errTextSearchModeNotSupported(search.Mode(), esg.dialect))
+		return
+	}
+	if search.Type() == exp.TextSearchRankType && style != MatchAgainstTextSearchStyle &&
+		len(esg.dialectOptions.TextSearchRankFunction) == 0 {
+		b.SetError(errTextSearchRankNotSupported(esg.dialect))
+		return
+	}
+	if len(search.Documents()) == 0 {
+		b.SetError(ErrTextSearchDocumentRequired)
+		return
+	}
+	switch style {
+	case TSVectorTextSearchStyle:
+		esg.tsVectorTextSearchSQL(b, search, mode)
+	case MatchAgainstTextSearchStyle:
+		esg.matchAgainstTextSearchSQL(b, search, mode)
+	case FTS5TextSearchStyle:
+		esg.fts5TextSearchSQL(b, search, mode)
+	case ContainsTextSearchStyle:
+		esg.containsTextSearchSQL(b, search, mode)
+	default:
+		b.SetError(errTextSearchNotSupported(esg.dialect))
+	}
+}
+
+// Generates SQL for a postgres full-text search, multiple documents are concatenated
+//   (to_tsvector('english', "body") @@ websearch_to_tsquery('english', 'cats'))
+//   ts_rank((to_tsvector("title") || to_tsvector("body")), websearch_to_tsquery('cats'))
+func (esg *expressionSQLGenerator) tsVectorTextSearchSQL(
+	b sb.SQLBuilder,
+	search exp.TextSearchExpression,
+	queryFunction []byte,
+) {
+	rank := search.Type() == exp.TextSearchRankType
+	if rank {
+		b.Write(esg.dialectOptions.TextSearchRankFunction)
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	documents := search.Documents()
+	if len(documents) > 1 {
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	}
+	for i, document := range documents {
+		if i > 0 {
+			b.Write(esg.dialectOptions.TSVectorConcatFragment)
+		}
+		esg.tsFunctionSQL(b, esg.dialectOptions.TSVectorFunction, search.Config(), document)
+	}
+	if len(documents) > 1 {
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	}
+	if rank {
+		b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+	} else {
+		b.Write(esg.dialectOptions.TSMatchFragment)
+	}
+	esg.tsFunctionSQL(b, queryFunction, search.Config(), search.Query())
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a postgres text search function, the config is always interpolated so it is typed as a regconfig
+//   to_tsvector('english', "body")
+func (esg *expressionSQLGenerator) tsFunctionSQL(b sb.SQLBuilder, name []byte, config string, val interface{}) {
+	b.Write(name).WriteRunes(esg.dialectOptions.LeftParenRune)
+	if config != "" {
+		esg.quotedStringSQL(b, config)
+		b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+	}
+	esg.Generate(b, val)
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a mysql full-text search, the MATCH expression is also the rank
+//   MATCH (`title`, `body`) AGAINST ('cats' IN NATURAL LANGUAGE MODE)
+func (esg *expressionSQLGenerator) matchAgainstTextSearchSQL(
+	b sb.SQLBuilder,
+	search exp.TextSearchExpression,
+	modifier []byte,
+) {
+	if len(esg.dialectOptions.TextSearchMatchFragment) == 0 || len(esg.dialectOptions.TextSearchAgainstFragment) == 0 {
+		b.SetError(errTextSearchNotSupported(esg.dialect))
+		return
+	}
+	if search.Config() != "" {
+		b.SetError(errTextSearchConfigNotSupported(esg.dialect))
+		return
+	}
+	b.Write(esg.dialectOptions.TextSearchMatchFragment).WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.textSearchDocumentsSQL(b, search.Documents())
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+	b.Write(esg.dialectOptions.TextSearchAgainstFragment).WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, search.Query())
+	b.Write(modifier).WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a sqlite FTS5 full-text search, the document is the FTS5 table or one of its columns
+//   ("docs" MATCH 'cats')
+//   bm25("docs")
+func (esg *expressionSQLGenerator) fts5TextSearchSQL(b sb.SQLBuilder, search exp.TextSearchExpression, operator []byte) {
+	if search.Config() != "" {
+		b.SetError(errTextSearchConfigNotSupported(esg.dialect))
+		return
+	}
+	if l := len(search.Documents()); l != 1 {
+		b.SetError(errTextSearchSingleDocument(l, esg.dialect))
+		return
+	}
+	if search.Type() == exp.TextSearchRankType {
+		b.Write(esg.dialectOptions.TextSearchRankFunction).WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, search.Documents()[0])
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, search.Documents()[0])
+	b.WriteRunes(esg.dialectOptions.SpaceRune)
+	b.Write(operator)
+	b.WriteRunes(esg.dialectOptions.SpaceRune)
+	esg.Generate(b, search.Query())
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
 
+// Generates SQL for a sqlserver full-text search predicate, ranking requires CONTAINSTABLE and is not supported
+//   CONTAINS("body", 'cats')
+//   FREETEXT(("title", "body"), 'cats', LANGUAGE 'english')
+func (esg *expressionSQLGenerator) containsTextSearchSQL(
+	b sb.SQLBuilder,
+	search exp.TextSearchExpression,
+	predicate []byte,
+) {
+	if search.Type() == exp.TextSearchRankType {
+		b.SetError(errTextSearchRankNotSupported(esg.dialect))
+		return
+	}
+	if search.Config() != "" && len(esg.dialectOptions.TextSearchLanguageFragment) == 0 {
+		b.SetError(errTextSearchConfigNotSupported(esg.dialect))
+		return
+	}
+	documents := search.Documents()
+	b.Write(predicate).WriteRunes(esg.dialectOptions.LeftParenRune)
+	if len(documents) > 1 {
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.textSearchDocumentsSQL(b, documents)
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	} else {
+		esg.textSearchDocumentsSQL(b, documents)
+	}
+	b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+	esg.Generate(b, search.Query())
+	if search.Config() != "" {
+		b.Write(esg.dialectOptions.TextSearchLanguageFragment)
+		esg.quotedStringSQL(b, search.Config())
+	}
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a comma separated list of full-text search documents
+func (esg *expressionSQLGenerator) textSearchDocumentsSQL(b sb.SQLBuilder, documents []exp.Expression) {
+	for i, document := range documents {
+		if i > 0 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+		esg.Generate(b, document)
+	}
+}
+
+// Generates SQL for an ArrayExpression, when BindSliceAsArray is true the elements are bound as a single
+// parameter in prepared statements
+//   ARRAY[1, 2, 3]
+//   ? [[1 2 3]]
+func (esg *expressionSQLGenerator) arrayExpressionSQL(b sb.SQLBuilder, array exp.ArrayExpression) {
+	if !esg.dialectOptions.SupportsArrays {
+		b.SetError(errArraysNotSupported(esg.dialect))
+		return
+	}
+	if slice, ok := esg.bindableSlice(b, array.Elements()); ok {
+		esg.placeHolderSQL(b, esg.arrayParameter(slice))
+		return
+	}
+	b.Write(esg.dialectOptions.ArrayFragment)
+	elements := reflect.ValueOf(array.Elements())
+	for i, l := 0, elements.Len(); i < l; i++ {
+		esg.Generate(b, elements.Index(i).Interface())
+		if i < l-1 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+	}
+	b.Write(esg.dialectOptions.ArrayEndFragment)
+}
+
+// Generates SQL for an IN or NOT IN list bound as a single array parameter
+//   ("a" IN (1, 2)) -> ("a" = ANY(?)) [[1 2]]
+//   ("a" NOT IN (1, 2)) -> ("a" != ALL(?)) [[1 2]]
+func (esg *expressionSQLGenerator) boundInListSQL(b sb.SQLBuilder, operator exp.BooleanExpression, slice interface{}) {
+	if operator.Op() == exp.InOp {
+		esg.Generate(b, exp.NewBooleanExpression(
+			exp.EqOp, operator.LHS(), exp.NewQuantifiedExpression(exp.AnyQuantifierType, exp.NewArrayExpression(slice)),
+		))
+		return
+	}
+	esg.Generate(b, exp.NewBooleanExpression(
+		exp.NeqOp, operator.LHS(), exp.NewQuantifiedExpression(exp.AllQuantifierType, exp.NewArrayExpression(slice)),
+	))
+}
+
+// Returns the slice and true if val should be bound as a single array parameter. Slices are only bound in prepared
+// statements when BindSliceAsArray is true and none of the elements are expressions
+func (esg *expressionSQLGenerator) bindableSlice(b sb.SQLBuilder, val interface{}) (interface{}, bool) {
+	if !b.IsPrepared() || !esg.dialectOptions.BindSliceAsArray || !esg.dialectOptions.SupportsArrays {
+		return nil, false
+	}
+	if !isArraySlice(val) {
+		return nil, false
+	}
+	slice := reflect.Indirect(reflect.ValueOf(val))
+	for i, l := 0, slice.Len(); i < l; i++ {
+		if _, ok := slice.Index(i).Interface().(exp.Expression); ok {
+			return nil, false
+		}
+	}
+	return slice.Interface(), true
+}
+
+// Returns the argument used to bind a slice as an array parameter
+func (esg *expressionSQLGenerator) arrayParameter(slice interface{}) interface{} {
+	if esg.dialectOptions.ArrayValuer != nil {
+		return esg.dialectOptions.ArrayValuer(slice)
+	}
+	return slice
+}
+
+// Returns true if val is a slice that can be rendered as an array, []byte is treated as a single value
+func isArraySlice(val interface{}) bool {
+	if _, ok := val.([]byte); ok {
+		return false
+	}
+	return reflect.Indirect(reflect.ValueOf(val)).Kind() == reflect.Slice
+}
+
+// Returns true if the right hand side of the BooleanExpression is compared as an array
+func isArrayComparison(operator exp.BooleanExpression) bool {
+	switch operator.Op() {
+	case exp.ArrayContainsOp, exp.ArrayContainedByOp, exp.ArrayOverlapsOp:
+		return true
+	case exp.EqOp, exp.NeqOp:
+		_, ok := operator.LHS().(exp.ArrayExpression)
+		return ok
+	}
+	return false
+}
+
+// Generates SQL for a TupleExpression
+//   ("a", "b")
+func (esg *expressionSQLGenerator) tupleExpressionSQL(b sb.SQLBuilder, tuple exp.TupleExpression) {
+	esg.sliceValueSQL(b, reflect.ValueOf(tuple.Values()))
+}
+
+// Returns true if a BooleanExpression with a TupleExpression LHS has to be expanded into an AND/OR chain
+func (esg *expressionSQLGenerator) shouldExpandRowValue(operator exp.BooleanExpression) bool {
+	switch operator.Op() {
+	case exp.InOp, exp.NotInOp:
+		if isSubqueryList(operator.RHS()) {
+			return !esg.dialectOptions.SupportsRowValueComparison
+		}
+		return !esg.dialectOptions.SupportsRowValueInList
+	default:
+		return !esg.dialectOptions.SupportsRowValueComparison
+	}
+}
+
+// Generates the equivalent AND/OR chain for a row value comparison
+//   ("a", "b") = (1, 2) -> (("a" = 1) AND ("b" = 2))
+//   ("a", "b") > (1, 2) -> (("a" > 1) OR (("a" = 1) AND ("b" > 2)))
+//   ("a", "b") IN ((1, 2), (1, 3)) -> ((("a" = 1) AND ("b" = 2)) OR (("a" = 1) AND ("b" = 3)))
+// NULL elements are compared with = and the ordering operators like the row value would be, e.g.
+//   ("a", "b") >= (1, NULL) -> (("a" > 1) OR (("a" = 1) AND ("b" >= NULL)))
+func (esg *expressionSQLGenerator) expandedRowValueSQL(
+	b sb.SQLBuilder,
+	tuple exp.TupleExpression,
+	operator exp.BooleanExpression,
+) {
+	lhs := make([]exp.Expression, 0, len(tuple.Values()))
+	for _, v := range tuple.Values() {
+		if e, ok := v.(exp.Expression); ok {
+			lhs = append(lhs, e)
+		} else {
+			lhs = append(lhs, exp.NewLiteralExpression("?", v))
+		}
+	}
+	var (
+		expanded exp.Expression
+		err      error
+	)
+	switch op := operator.Op(); op {
+	case exp.InOp, exp.NotInOp:
+		rows, ok := rowValue(operator.RHS())
+		if !ok || len(rows) == 0 || isSubqueryList(operator.RHS()) {
+			b.SetError(errRowValueNotSupported(op, esg.dialect))
+			return
+		}
+		listType, rowOp := exp.OrType, exp.EqOp
+		if op == exp.NotInOp {
+			listType, rowOp = exp.AndType, exp.NeqOp
+		}
+		list := exp.NewExpressionList(listType)
+		for _, row := range rows {
+			var rowExp exp.Expression
+			if rowExp, err = expandRowValueComparison(rowOp, lhs, row); err != nil {
+				b.SetError(err)
+				return
+			}
+			list = list.Append(rowExp)
+		}
+		expanded = list
+	case exp.EqOp, exp.NeqOp, exp.GtOp, exp.GteOp, exp.LtOp, exp.LteOp:
+		if expanded, err = expandRowValueComparison(op, lhs, operator.RHS()); err != nil {
+			b.SetError(err)
+			return
+		}
+	default:
+		b.SetError(errRowValueNotSupported(op, esg.dialect))
+		return
+	}
+	esg.Generate(b, expanded)
+}
+
+func expandRowValueComparison(op exp.BooleanOperation, lhs []exp.Expression, rhs interface{}) (exp.Expression, error) {
+	vals, ok := rowValue(rhs)
+	if !ok {
+		return nil, errors.New("unable to expand row value comparison with %T", rhs)
+	}
+	if len(vals) != len(lhs) {
+		return nil, errRowValueLengthMismatch(len(lhs), len(vals))
+	}
+	switch op {
+	case exp.EqOp:
+		list := exp.NewExpressionList(exp.AndType)
+		for i, l := range lhs {
+			list = list.Append(exp.NewBooleanExpression(exp.EqOp, l, vals[i]))
+		}
+		return list, nil
+	case exp.NeqOp:
+		list := exp.NewExpressionList(exp.OrType)
+		for i, l := range lhs {
+			list = list.Append(exp.NewBooleanExpression(exp.NeqOp, l, vals[i]))
+		}
+		return list, nil
+	}
+	// only the last column uses the inclusive operator, e.g. (a, b) >= (1, 2) -> (a > 1) OR ((a = 1) AND (b >= 2))
+	strictOp := op
+	switch op {
+	case exp.GteOp:
+		strictOp = exp.GtOp
+	case exp.LteOp:
+		strictOp = exp.LtOp
+	}
+	list := exp.NewExpressionList(exp.OrType)
+	for i, l := range lhs {
+		colOp := strictOp
+		if i == len(lhs)-1 {
+			colOp = op
+		}
+		cmp := exp.NewBooleanExpression(colOp, l, vals[i])
+		if i == 0 {
+			list = list.Append(cmp)
+			continue
+		}
+		and := exp.NewExpressionList(exp.AndType)
+		for j := 0; j < i; j++ {
+			and = and.Append(exp.NewBooleanExpression(exp.EqOp, lhs[j], vals[j]))
+		}
+		list = list.Append(and.Append(cmp))
+	}
+	return list, nil
+}
+
+// Returns the values of a single row value, either a TupleExpression or a slice
+func rowValue(val interface{}) ([]interface{}, bool) {
+	if t, ok := val.(exp.TupleExpression); ok {
+		return t.Values(), true
+	}
+	if _, ok := val.([]byte); ok {
+		return nil, false
+	}
+	v := reflect.Indirect(reflect.ValueOf(val))
+	if v.Kind() != reflect.Slice {
+		return nil, false
+	}
+	vals := make([]interface{}, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		vals = append(vals, v.Index(i).Interface())
+	}
+	return vals, true
+}
+
+// Returns true if the RHS of an IN is a subquery, In(ds) results in a single element slice containing the subquery
+func isSubqueryList(val interface{}) bool {
+	if _, ok := val.(exp.AppendableExpression); ok {
+		return true
+	}
+	if vals, ok := val.([]interface{}); ok && len(vals) == 1 {
+		_, ok = vals[0].(exp.AppendableExpression)
+		return ok
+	}
+	return false
+}
+
+// Generates SQL for a JSONExpression
+//   ("data"->'a'->>'b')
+//   JSON_EXTRACT("data", '$.a.b')
+//   ("data" @> '{"a":1}')
+//   jsonb_array_length("data")
+func (esg *expressionSQLGenerator) jsonExpressionSQL(b sb.SQLBuilder, je exp.JSONExpression) {
+	switch je.Op() {
+	case exp.JSONExtractOp, exp.JSONExtractTextOp:
+		esg.jsonPathSQL(b, je)
+	case exp.JSONHasKeyOp:
+		esg.jsonHasKeySQL(b, je)
+	case exp.JSONContainsOp:
+		candidate := je.RHS()
+		switch candidate.(type) {
+		case exp.Expression, string, []byte:
+		default:
+			encoded, err := json.Marshal(candidate)
+			if err != nil {
+				b.SetError(errors.New("unable to encode JSON candidate: %s", err.Error()))
+				return
+			}
+			candidate = string(encoded)
+		}
+		if operator, ok := esg.dialectOptions.JSONOperatorLookup[je.Op()]; ok {
+			b.WriteRunes(esg.dialectOptions.LeftParenRune)
+			esg.Generate(b, je.LHS())
+			b.WriteRunes(esg.dialectOptions.SpaceRune).Write(operator).WriteRunes(esg.dialectOptions.SpaceRune)
+			esg.Generate(b, candidate)
+			b.WriteRunes(esg.dialectOptions.RightParenRune)
+			return
+		}
+		if fn, ok := esg.dialectOptions.JSONFunctionLookup[je.Op()]; ok {
+			b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune)
+			esg.Generate(b, je.LHS())
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+			esg.Generate(b, candidate)
+			b.WriteRunes(esg.dialectOptions.RightParenRune)
+			return
+		}
+		b.SetError(errJSONOperationNotSupported(je.Op(), esg.dialect))
+	case exp.JSONArrayLengthOp:
+		fn, ok := esg.dialectOptions.JSONFunctionLookup[je.Op()]
+		if !ok {
+			b.SetError(errJSONOperationNotSupported(je.Op(), esg.dialect))
+			return
+		}
+		b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, je.LHS())
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	default:
+		b.SetError(errors.New("unsupported JSON operation %s", je.Op()))
+	}
+}
+
+// Generates SQL for a JSONExpression extracting a path
+//   ("data"->'a'->>'b')
+//   ("data"->>'$.a.b')
+//   JSON_VALUE("data", '$.a.b')
+func (esg *expressionSQLGenerator) jsonPathSQL(b sb.SQLBuilder, je exp.JSONExpression) {
+	path, _ := je.RHS().([]interface{})
+	if len(path) == 0 {
+		b.SetError(errors.New("JSON %s expressions require a path", je.Op()))
+		return
+	}
+	if operator, ok := esg.dialectOptions.JSONOperatorLookup[je.Op()]; ok {
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, je.LHS())
+		if esg.dialectOptions.UseJSONPathString {
+			b.Write(operator)
+			esg.jsonPathStringSQL(b, path)
+		} else {
+			for i, el := range path {
+				elOperator := operator
+				if i < len(path)-1 {
+					if elOperator, ok = esg.dialectOptions.JSONOperatorLookup[exp.JSONExtractOp]; !ok {
+						b.SetError(errJSONOperationNotSupported(exp.JSONExtractOp, esg.dialect))
+						return
+					}
+				}
+				b.Write(elOperator)
+				esg.jsonPathElementSQL(b, el)
+			}
+		}
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+		return
+	}
+	fn, ok := esg.dialectOptions.JSONFunctionLookup[je.Op()]
+	if !ok {
+		b.SetError(errJSONOperationNotSupported(je.Op(), esg.dialect))
+		return
+	}
+	b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, je.LHS())
+	if esg.dialectOptions.UseJSONPathString {
+		b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		esg.jsonPathStringSQL(b, path)
+	} else {
+		for _, el := range path {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+			esg.jsonPathElementSQL(b, el)
+		}
+	}
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a JSONExpression checking if a key exists
+//   jsonb_exists("data", 'a')
+//   (json_type("data", '$.a') IS NOT NULL)
+//   ("data" ? 'a')
+func (esg *expressionSQLGenerator) jsonHasKeySQL(b sb.SQLBuilder, je exp.JSONExpression) {
+	key, ok := je.RHS().(string)
+	if !ok {
+		b.SetError(errors.New("JSON %s expressions require a string key got %T", je.Op(), je.RHS()))
+		return
+	}
+	writeKey := func() {
+		if esg.dialectOptions.UseJSONPathString {
+			esg.jsonPathStringSQL(b, []interface{}{key})
+		} else {
+			esg.quotedStringSQL(b, key)
+		}
+	}
+	if operator, ok := esg.dialectOptions.JSONOperatorLookup[je.Op()]; ok {
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, je.LHS())
+		b.WriteRunes(esg.dialectOptions.SpaceRune).Write(operator).WriteRunes(esg.dialectOptions.SpaceRune)
+		writeKey()
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+		return
+	}
+	fn, ok := esg.dialectOptions.JSONFunctionLookup[je.Op()]
+	if !ok {
+		b.SetError(errJSONOperationNotSupported(je.Op(), esg.dialect))
+		return
+	}
+	if !esg.dialectOptions.JSONHasKeyIsNotNull {
+		b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, je.LHS())
+		b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		writeKey()
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, je.LHS())
+	b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+	writeKey()
+	b.WriteRunes(esg.dialectOptions.RightParenRune, esg.dialectOptions.SpaceRune)
+	b.Write(esg.dialectOptions.BooleanOperatorLookup[exp.IsNotOp]).WriteRunes(esg.dialectOptions.SpaceRune)
+	b.Write(esg.dialectOptions.Null)
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates a single JSON path element, keys are quoted and indexes are written as is. Path elements are never
+// replaced with placeholders because some dialects require them to be literals
+func (esg *expressionSQLGenerator) jsonPathElementSQL(b sb.SQLBuilder, el interface{}) {
+	if t, ok := el.(string); ok {
+		esg.quotedStringSQL(b, t)
+		return
+	}
+	if index, ok := jsonPathIndex(el); ok {
+		b.WriteStrings(index)
+		return
+	}
+	b.SetError(errors.New("unsupported JSON path element %T", el))
+}
+
+// Returns the array index of a JSON path element, any integer kind can be used as an index
+func jsonPathIndex(el interface{}) (string, bool) {
+	v := reflect.ValueOf(el)
+	switch kind := v.Kind(); {
+	case util.IsInt(kind):
+		return strconv.FormatInt(v.Int(), 10), true
+	case util.IsUint(kind):
+		return strconv.FormatUint(v.Uint(), 10), true
+	}
+	return "", false
+}
+
+// Generates a JSONPath string (e.g. '$.a."b c"[0]')
+func (esg *expressionSQLGenerator) jsonPathStringSQL(b sb.SQLBuilder, path []interface{}) {
+	var pathBuilder strings.Builder
+	pathBuilder.WriteString("$")
+	for _, el := range path {
+		switch t := el.(type) {
+		case string:
+			pathBuilder.WriteString(".")
+			if isJSONPathIdentifier(t) {
+				pathBuilder.WriteString(t)
+			} else {
+				// keys that are not identifiers are quoted as JSON strings
+				quoted, _ := json.Marshal(t)
+				pathBuilder.Write(quoted)
+			}
+		default:
+			index, ok := jsonPathIndex(el)
+			if !ok {
+				b.SetError(errors.New("unsupported JSON path element %T", el))
+				return
+			}
+			pathBuilder.WriteString("[" + index + "]")
+		}
+	}
+	esg.quotedStringSQL(b, pathBuilder.String())
+}
+
+func isJSONPathIdentifier(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i, r := range key {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (i > 0 && r >= '0' && r <= '9') {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// Generates SQL for a GroupingExpression
+//   ROLLUP("a", ("b", "c"))
+//   CUBE("a", "b")
+//   GROUPING SETS(("a", "b"), ("a"), ())
+func (esg *expressionSQLGenerator) groupingExpressionSQL(b sb.SQLBuilder, grouping exp.GroupingExpression) {
+	if grouping.Type() == exp.RollupGroupingType && len(esg.dialectOptions.WithRollupFragment) > 0 {
+		// WITH ROLLUP dialects can only render a ROLLUP directly in the GROUP BY clause
+		b.SetError(errWithRollupNotSupported(esg.dialect))
+		return
+	}
+	groupingType, ok := esg.dialectOptions.GroupingTypeLookup[grouping.Type()]
+	if !ok {
+		b.SetError(errGroupingNotSupported(grouping.Type(), esg.dialect))
+		return
+	}
+	b.Write(groupingType).WriteRunes(esg.dialectOptions.LeftParenRune)
+	sets := grouping.Sets()
+	for i, set := range sets {
+		if grouping.Type() == exp.GroupingSetsGroupingType || len(set.Columns()) != 1 {
+			b.WriteRunes(esg.dialectOptions.LeftParenRune)
+			esg.Generate(b, set)
+			b.WriteRunes(esg.dialectOptions.RightParenRune)
+		} else {
+			esg.Generate(b, set)
+		}
+		if i < len(sets)-1 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+	}
 	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
@@ -668,16 +2122,26 @@ func (esg *expressionSQLGenerator) commonTableExpressionSQL(b sb.SQLBuilder, cte
 
 // Generates SQL for a CompoundExpression
 func (esg *expressionSQLGenerator) compoundExpressionSQL(b sb.SQLBuilder, compound exp.CompoundExpression) {
+	var fragment []byte
 	switch compound.Type() {
 	case exp.UnionCompoundType:
-		b.Write(esg.dialectOptions.UnionFragment)
+		fragment = esg.dialectOptions.UnionFragment
 	case exp.UnionAllCompoundType:
-		b.Write(esg.dialectOptions.UnionAllFragment)
+		fragment = esg.dialectOptions.UnionAllFragment
 	case exp.IntersectCompoundType:
-		b.Write(esg.dialectOptions.IntersectFragment)
+		fragment = esg.dialectOptions.IntersectFragment
 	case exp.IntersectAllCompoundType:
-		b.Write(esg.dialectOptions.IntersectAllFragment)
+		fragment = esg.dialectOptions.IntersectAllFragment
+	case exp.ExceptCompoundType:
+		fragment = esg.dialectOptions.ExceptFragment
+	case exp.ExceptAllCompoundType:
+		fragment = esg.dialectOptions.ExceptAllFragment
 	}
+	if len(fragment) == 0 {
+		b.SetError(errCompoundNotSupported(compound.Type(), esg.dialect))
+		return
+	}
+	b.Write(fragment)
 	if esg.dialectOptions.WrapCompoundsInParens {
 		b.WriteRunes(esg.dialectOptions.LeftParenRune)
 		compound.RHS().AppendSQL(b)
@@ -731,3 +2195,4 @@ func (esg *expressionSQLGenerator) expressionOrMapSQL(b sb.SQLBuilder, ex exp.Ex
 	}
 	esg.Generate(b, expressionList)
 }
+
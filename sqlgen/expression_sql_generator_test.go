@@ -353,6 +353,62 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerateUnsupportedExpression() {
 	)
 }
 
+// a custom expression rendering ST_Distance, or ST_Distance_Sphere for the "sphere" dialect
+type stDistanceExpression struct {
+	from exp.Expression
+	to   interface{}
+}
+
+func (sde stDistanceExpression) Expression() exp.Expression {
+	return sde
+}
+
+func (sde stDistanceExpression) Clone() exp.Expression {
+	return sde
+}
+
+func (sde stDistanceExpression) GenerateSQL(b sqlgen.SQLBuilder, esg sqlgen.ExpressionSQLGenerator) {
+	if esg.Dialect() == "sphere" {
+		b.WriteStrings("ST_Distance_Sphere(")
+	} else {
+		b.WriteStrings("ST_Distance(")
+	}
+	esg.Generate(b, sde.from)
+	b.WriteStrings(", ")
+	esg.Generate(b, sde.to)
+	b.WriteRunes(')')
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLAppender() {
+	ident := exp.NewIdentifierExpression("", "", "location")
+	point := exp.NewSQLFunctionExpression("ST_MakePoint", 1.5, 2.5)
+	sde := stDistanceExpression{from: ident, to: point}
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: sde, sql: `ST_Distance("location", ST_MakePoint(1.5, 2.5))`},
+		expressionTestCase{
+			val:        sde,
+			sql:        `ST_Distance("location", ST_MakePoint(?, ?))`,
+			isPrepared: true,
+			args:       []interface{}{1.5, 2.5},
+		},
+		expressionTestCase{
+			val: exp.NewAliasExpression(sde, "distance"),
+			sql: `ST_Distance("location", ST_MakePoint(1.5, 2.5)) AS "distance"`,
+		},
+	)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("sphere", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: sde, sql: `ST_Distance_Sphere("location", ST_MakePoint(1.5, 2.5))`},
+		expressionTestCase{
+			val: exp.NewExpressionList(exp.AndType, exp.NewBooleanExpression(exp.LtOp, sde, 100)),
+			sql: `(ST_Distance_Sphere("location", ST_MakePoint(1.5, 2.5)) < 100)`,
+		},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_AppendableExpression() {
 	ti := exp.NewIdentifierExpression("", "b", "")
 	a := newTestAppendableExpression(`select * from "a"`, []interface{}{}, nil, nil)
@@ -626,6 +682,62 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_BooleanExpression() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_BooleanExpressionDistinctFrom() {
+	a := exp.NewIdentifierExpression("", "", "a")
+	b := exp.NewIdentifierExpression("", "", "b")
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: a.IsDistinctFrom(b), sql: `("a" IS DISTINCT FROM "b")`},
+		expressionTestCase{val: a.IsDistinctFrom(1), sql: `("a" IS DISTINCT FROM ?)`, isPrepared: true, args: []interface{}{int64(1)}},
+		expressionTestCase{val: a.IsNotDistinctFrom(nil), sql: `("a" IS NOT DISTINCT FROM NULL)`},
+		expressionTestCase{val: a.IsNotDistinctFrom(b), sql: `("a" IS NOT DISTINCT FROM "b")`, isPrepared: true},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
+		exp.EqOp:                []byte("="),
+		exp.NeqOp:               []byte("!="),
+		exp.IsOp:                []byte("IS"),
+		exp.IsNotOp:             []byte("IS NOT"),
+		exp.IsNotDistinctFromOp: []byte("<=>"),
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: a.IsNotDistinctFrom(b), sql: `("a" <=> "b")`},
+		expressionTestCase{val: a.IsDistinctFrom(b), sql: `NOT ("a" <=> "b")`},
+		expressionTestCase{val: a.IsDistinctFrom(1), sql: `NOT ("a" <=> ?)`, isPrepared: true, args: []interface{}{int64(1)}},
+	)
+
+	delete(opts.BooleanOperatorLookup, exp.IsNotDistinctFromOp)
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{
+			val: a.IsDistinctFrom(b),
+			sql: `((("a" != "b") OR ("a" IS NULL) OR ("b" IS NULL)) AND (("a" IS NOT NULL) OR ("b" IS NOT NULL)))`,
+		},
+		expressionTestCase{
+			val: a.IsNotDistinctFrom(b),
+			sql: `((("a" = "b") AND ("a" IS NOT NULL) AND ("b" IS NOT NULL)) OR (("a" IS NULL) AND ("b" IS NULL)))`,
+		},
+		expressionTestCase{
+			val:        a.IsNotDistinctFrom(1),
+			sql:        `((("a" = ?) AND ("a" IS NOT NULL) AND (? IS NOT NULL)) OR (("a" IS NULL) AND (? IS NULL)))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(1), int64(1)},
+		},
+		expressionTestCase{val: a.IsDistinctFrom(nil), sql: `("a" IS NOT NULL)`},
+		expressionTestCase{val: a.IsNotDistinctFrom(nil), sql: `("a" IS NULL)`},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: a.IsDistinctFrom(b), err: "depiq: boolean operator 'neq' not supported"},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_BitwiseExpression() {
 	ident := exp.NewIdentifierExpression("", "", "a")
 	esgs.assertCases(
@@ -661,6 +773,59 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_BitwiseExpression() {
 		expressionTestCase{val: ident.BitwiseRightShift(1), err: "depiq: bitwise operator 'Right Shift' not supported"},
 	)
 }
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_ArithmeticExpression() {
+	ident := exp.NewIdentifierExpression("", "", "a")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: ident.Add(1), sql: `("a" + 1)`},
+		expressionTestCase{val: ident.Add(1), sql: `("a" + ?)`, isPrepared: true, args: []interface{}{int64(1)}},
+
+		expressionTestCase{val: ident.Sub(1), sql: `("a" - 1)`},
+		expressionTestCase{val: ident.Sub(1), sql: `("a" - ?)`, isPrepared: true, args: []interface{}{int64(1)}},
+
+		expressionTestCase{val: ident.Mul(2), sql: `("a" * 2)`},
+		expressionTestCase{val: ident.Mul(2), sql: `("a" * ?)`, isPrepared: true, args: []interface{}{int64(2)}},
+
+		expressionTestCase{val: ident.Div(2), sql: `("a" / 2)`},
+		expressionTestCase{val: ident.Div(2), sql: `("a" / ?)`, isPrepared: true, args: []interface{}{int64(2)}},
+
+		expressionTestCase{val: ident.Mod(2), sql: `("a" % 2)`},
+		expressionTestCase{val: ident.Mod(2), sql: `("a" % ?)`, isPrepared: true, args: []interface{}{int64(2)}},
+
+		expressionTestCase{val: ident.Neg(), sql: `(- "a")`},
+		expressionTestCase{val: ident.Neg(), sql: `(- "a")`, isPrepared: true},
+
+		expressionTestCase{val: ident.Add(exp.NewIdentifierExpression("", "", "b")).Mul(2), sql: `(("a" + "b") * 2)`},
+		expressionTestCase{
+			val:        ident.Add(exp.NewIdentifierExpression("", "", "b")).Mul(2),
+			sql:        `(("a" + "b") * ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(2)},
+		},
+
+		expressionTestCase{val: exp.NewSQLFunctionExpression("SUM", ident).Div(10), sql: `(SUM("a") / 10)`},
+		expressionTestCase{val: exp.NewLiteralExpression("1").Sub(ident), sql: `(1 - "a")`},
+		expressionTestCase{val: ident.Cast("NUMERIC").Mul(1.5), sql: `(CAST("a" AS NUMERIC) * 1.5)`},
+		expressionTestCase{val: ident.Add(1).Gt(10), sql: `(("a" + 1) > 10)`},
+		expressionTestCase{val: ident.Add(1).Gt(10), sql: `(("a" + ?) > ?)`, isPrepared: true, args: []interface{}{
+			int64(1),
+			int64(10),
+		}},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ArithmeticOperatorLookup = map[exp.ArithmeticOperation][]byte{}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: ident.Add(1), err: "depiq: arithmetic operator 'Add' not supported"},
+		expressionTestCase{val: ident.Sub(1), err: "depiq: arithmetic operator 'Subtract' not supported"},
+		expressionTestCase{val: ident.Mul(1), err: "depiq: arithmetic operator 'Multiply' not supported"},
+		expressionTestCase{val: ident.Div(1), err: "depiq: arithmetic operator 'Divide' not supported"},
+		expressionTestCase{val: ident.Mod(1), err: "depiq: arithmetic operator 'Modulo' not supported"},
+		expressionTestCase{val: ident.Neg(), err: "depiq: arithmetic operator 'Negate' not supported"},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_RangeExpression() {
 	betweenNum := exp.NewIdentifierExpression("", "", "a").
 		Between(exp.NewRangeVal(1, 2))
@@ -769,6 +934,85 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLFunctionExpression() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLFunctionExpressionAggregateClauses() {
+	a := exp.NewIdentifierExpression("", "", "a")
+	cond := exp.NewIdentifierExpression("", "", "b").Gt(10)
+	sum := exp.NewSQLFunctionExpression("SUM", a).Filter(cond)
+	countStar := exp.NewSQLFunctionExpression("COUNT", exp.Star()).Filter(cond)
+	countDistinct := exp.NewSQLFunctionExpression("COUNT", exp.NewSQLFunctionExpression("DISTINCT", a)).Filter(cond)
+	stringAgg := exp.NewSQLFunctionExpression("string_agg", a, ",").OrderBy(a.Desc())
+	arrayAgg := exp.NewSQLFunctionExpression("array_agg", a).Filter(cond)
+	percentile := exp.NewSQLFunctionExpression("percentile_cont", 0.5).WithinGroup(a.Asc())
+	percentileFilter := percentile.Filter(cond)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: sum, sql: `SUM("a") FILTER (WHERE ("b" > 10))`},
+		expressionTestCase{
+			val:        sum,
+			sql:        `SUM("a") FILTER (WHERE ("b" > ?))`,
+			isPrepared: true,
+			args:       []interface{}{int64(10)},
+		},
+
+		expressionTestCase{val: countStar, sql: `COUNT(*) FILTER (WHERE ("b" > 10))`},
+		expressionTestCase{val: stringAgg, sql: `string_agg("a", ',' ORDER BY "a" DESC)`},
+		expressionTestCase{
+			val:        stringAgg,
+			sql:        `string_agg("a", ? ORDER BY "a" DESC)`,
+			isPrepared: true,
+			args:       []interface{}{","},
+		},
+
+		expressionTestCase{val: percentile, sql: `percentile_cont(0.5) WITHIN GROUP (ORDER BY "a" ASC)`},
+		expressionTestCase{
+			val:        percentile,
+			sql:        `percentile_cont(?) WITHIN GROUP (ORDER BY "a" ASC)`,
+			isPrepared: true,
+			args:       []interface{}{0.5},
+		},
+		expressionTestCase{
+			val: percentileFilter,
+			sql: `percentile_cont(0.5) WITHIN GROUP (ORDER BY "a" ASC) FILTER (WHERE ("b" > 10))`,
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsAggregateFilter = false
+	opts.SupportsAggregateOrderBy = false
+	opts.SupportsWithinGroup = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: sum, sql: `SUM(CASE  WHEN ("b" > 10) THEN "a" END)`},
+		expressionTestCase{
+			val:        sum,
+			sql:        `SUM(CASE  WHEN ("b" > ?) THEN "a" END)`,
+			isPrepared: true,
+			args:       []interface{}{int64(10)},
+		},
+		expressionTestCase{val: countStar, sql: `COUNT(CASE  WHEN ("b" > 10) THEN 1 END)`},
+		expressionTestCase{val: countDistinct, sql: `COUNT(DISTINCT(CASE  WHEN ("b" > 10) THEN "a" END))`},
+		expressionTestCase{
+			val: arrayAgg,
+			err: "depiq: dialect does not support FILTER clauses and array_agg cannot be safely rewritten " +
+				"as a CASE expression [dialect=test]",
+		},
+		expressionTestCase{
+			val: percentileFilter,
+			err: "depiq: dialect does not support FILTER clauses and percentile_cont cannot be safely rewritten " +
+				"as a CASE expression [dialect=test]",
+		},
+		expressionTestCase{
+			val: stringAgg,
+			err: "depiq: dialect does not support ORDER BY in aggregate function calls [dialect=test]",
+		},
+		expressionTestCase{
+			val: percentile,
+			err: "depiq: dialect does not support WITHIN GROUP clauses [dialect=test]",
+		},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLWindowFunctionExpression() {
 	sqlWinFunc := exp.NewSQLWindowFunctionExpression(
 		exp.NewSQLFunctionExpression("some_func"),
@@ -893,6 +1137,178 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_WindowExpression() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_WindowFrameExpression() {
+	orderBy := exp.NewOrderedColumnList(exp.NewIdentifierExpression("", "", "ts").Asc())
+	w := exp.NewWindowExpression(nil, nil, nil, orderBy)
+
+	unboundedPreceding := exp.NewWindowFrameBound(exp.UnboundedPrecedingFrameBound, nil)
+	currentRow := exp.NewWindowFrameBound(exp.CurrentRowFrameBound, nil)
+	unboundedFollowing := exp.NewWindowFrameBound(exp.UnboundedFollowingFrameBound, nil)
+
+	rowsStart := w.Rows(unboundedPreceding)
+	rowsBetween := w.RowsBetween(exp.NewWindowFrameBound(exp.PrecedingFrameBound, 6), currentRow)
+	rangeBetween := w.RangeBetween(currentRow, unboundedFollowing)
+	groupsBetween := w.GroupsBetween(
+		exp.NewWindowFrameBound(exp.PrecedingFrameBound, 1),
+		exp.NewWindowFrameBound(exp.FollowingFrameBound, 1),
+	)
+	inheritFrame := exp.NewWindowExpression(nil, exp.NewIdentifierExpression("", "", "w"), nil, nil).
+		Rows(unboundedPreceding)
+	onlyFrame := exp.NewWindowExpression(nil, nil, nil, nil).Rows(unboundedPreceding)
+
+	excludeCurrentRow := rowsBetween.Exclude(exp.CurrentRowFrameExclusion)
+	excludeGroup := rangeBetween.Exclude(exp.GroupFrameExclusion)
+	excludeTies := groupsBetween.Exclude(exp.TiesFrameExclusion)
+	excludeNoOthers := w.Exclude(exp.NoOthersFrameExclusion).Rows(unboundedPreceding)
+
+	excludeWithoutFrame := w.Exclude(exp.TiesFrameExclusion)
+	missingOffset := w.Rows(exp.NewWindowFrameBound(exp.PrecedingFrameBound, nil))
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: rowsStart, sql: `(ORDER BY "ts" ASC ROWS UNBOUNDED PRECEDING)`},
+		expressionTestCase{val: rowsStart, sql: `(ORDER BY "ts" ASC ROWS UNBOUNDED PRECEDING)`, isPrepared: true},
+
+		expressionTestCase{val: rowsBetween, sql: `(ORDER BY "ts" ASC ROWS BETWEEN 6 PRECEDING AND CURRENT ROW)`},
+		expressionTestCase{
+			val:        rowsBetween,
+			sql:        `(ORDER BY "ts" ASC ROWS BETWEEN ? PRECEDING AND CURRENT ROW)`,
+			isPrepared: true,
+			args:       []interface{}{int64(6)},
+		},
+
+		expressionTestCase{
+			val: rangeBetween,
+			sql: `(ORDER BY "ts" ASC RANGE BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)`,
+		},
+		expressionTestCase{
+			val:        rangeBetween,
+			sql:        `(ORDER BY "ts" ASC RANGE BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)`,
+			isPrepared: true,
+		},
+
+		expressionTestCase{val: groupsBetween, sql: `(ORDER BY "ts" ASC GROUPS BETWEEN 1 PRECEDING AND 1 FOLLOWING)`},
+		expressionTestCase{
+			val:        groupsBetween,
+			sql:        `(ORDER BY "ts" ASC GROUPS BETWEEN ? PRECEDING AND ? FOLLOWING)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(1)},
+		},
+
+		expressionTestCase{val: inheritFrame, sql: `("w" ROWS UNBOUNDED PRECEDING)`},
+		expressionTestCase{val: inheritFrame, sql: `("w" ROWS UNBOUNDED PRECEDING)`, isPrepared: true},
+
+		expressionTestCase{val: onlyFrame, sql: `(ROWS UNBOUNDED PRECEDING)`},
+		expressionTestCase{val: onlyFrame, sql: `(ROWS UNBOUNDED PRECEDING)`, isPrepared: true},
+
+		expressionTestCase{
+			val: excludeCurrentRow,
+			sql: `(ORDER BY "ts" ASC ROWS BETWEEN 6 PRECEDING AND CURRENT ROW EXCLUDE CURRENT ROW)`,
+		},
+		expressionTestCase{
+			val: excludeGroup,
+			sql: `(ORDER BY "ts" ASC RANGE BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING EXCLUDE GROUP)`,
+		},
+		expressionTestCase{
+			val: excludeTies,
+			sql: `(ORDER BY "ts" ASC GROUPS BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE TIES)`,
+		},
+		expressionTestCase{
+			val: excludeNoOthers,
+			sql: `(ORDER BY "ts" ASC ROWS UNBOUNDED PRECEDING EXCLUDE NO OTHERS)`,
+		},
+
+		expressionTestCase{val: excludeWithoutFrame, err: sqlgen.ErrWindowFrameStartRequired.Error()},
+		expressionTestCase{
+			val:        excludeWithoutFrame,
+			err:        sqlgen.ErrWindowFrameStartRequired.Error(),
+			isPrepared: true,
+		},
+
+		expressionTestCase{val: missingOffset, err: "depiq: window frame bound 'PRECEDING' requires an offset"},
+		expressionTestCase{
+			val:        missingOffset,
+			err:        "depiq: window frame bound 'PRECEDING' requires an offset",
+			isPrepared: true,
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.WindowFrameUnitLookup = map[exp.WindowFrameUnit][]byte{
+		exp.RowsFrameUnit: []byte("ROWS"),
+	}
+	opts.WindowFrameExclusionLookup = map[exp.WindowFrameExclusion][]byte{}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: rowsBetween, sql: `(ORDER BY "ts" ASC ROWS BETWEEN 6 PRECEDING AND CURRENT ROW)`},
+		expressionTestCase{
+			val: rangeBetween,
+			err: "depiq: dialect does not support RANGE window frames [dialect=test]",
+		},
+		expressionTestCase{
+			val: groupsBetween,
+			err: "depiq: dialect does not support GROUPS window frames [dialect=test]",
+		},
+		expressionTestCase{
+			val: excludeCurrentRow,
+			err: "depiq: dialect does not support window frame EXCLUDE CURRENT ROW [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.WindowFrameBoundLookup = map[exp.WindowFrameBoundType][]byte{}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: rowsStart, err: "depiq: window frame bound 'UNBOUNDED PRECEDING' not supported"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_GroupingExpression() {
+	a := exp.NewColumnListExpression("a")
+	b := exp.NewColumnListExpression("b")
+	ab := exp.NewColumnListExpression("a", "b")
+	empty := exp.NewColumnListExpression()
+
+	rollup := exp.NewGroupingExpression(exp.RollupGroupingType, a, b)
+	compositeRollup := exp.NewGroupingExpression(exp.RollupGroupingType, ab, b)
+	cube := exp.NewGroupingExpression(exp.CubeGroupingType, a, b)
+	groupingSets := exp.NewGroupingExpression(exp.GroupingSetsGroupingType, ab, a, empty)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: rollup, sql: `ROLLUP("a", "b")`},
+		expressionTestCase{val: rollup, sql: `ROLLUP("a", "b")`, isPrepared: true},
+
+		expressionTestCase{val: compositeRollup, sql: `ROLLUP(("a", "b"), "b")`},
+		expressionTestCase{val: compositeRollup, sql: `ROLLUP(("a", "b"), "b")`, isPrepared: true},
+
+		expressionTestCase{val: cube, sql: `CUBE("a", "b")`},
+		expressionTestCase{val: cube, sql: `CUBE("a", "b")`, isPrepared: true},
+
+		expressionTestCase{val: groupingSets, sql: `GROUPING SETS(("a", "b"), ("a"), ())`},
+		expressionTestCase{val: groupingSets, sql: `GROUPING SETS(("a", "b"), ("a"), ())`, isPrepared: true},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.GroupingTypeLookup = map[exp.GroupingType][]byte{exp.RollupGroupingType: []byte("ROLLUP")}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: rollup, sql: `ROLLUP("a", "b")`},
+		expressionTestCase{val: cube, err: "depiq: dialect does not support CUBE [dialect=test]"},
+		expressionTestCase{val: groupingSets, err: "depiq: dialect does not support GROUPING SETS [dialect=test]"},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.WithRollupFragment = []byte(" WITH ROLLUP")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{
+			val: rollup,
+			err: "depiq: dialect only supports ROLLUP of plain columns as the only GROUP BY expression [dialect=test]",
+		},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_CastExpression() {
 	cast := exp.NewIdentifierExpression("", "", "a").Cast("DATE")
 	esgs.assertCases(
@@ -1036,6 +1452,9 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_CompoundExpression() {
 	i := exp.NewCompoundExpression(exp.IntersectCompoundType, ae)
 	ia := exp.NewCompoundExpression(exp.IntersectAllCompoundType, ae)
 
+	e := exp.NewCompoundExpression(exp.ExceptCompoundType, ae)
+	ea := exp.NewCompoundExpression(exp.ExceptAllCompoundType, ae)
+
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
 		expressionTestCase{val: u, sql: ` UNION (SELECT * FROM "b")`},
@@ -1049,6 +1468,12 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_CompoundExpression() {
 
 		expressionTestCase{val: ia, sql: ` INTERSECT ALL (SELECT * FROM "b")`},
 		expressionTestCase{val: ia, sql: ` INTERSECT ALL (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: e, sql: ` EXCEPT (SELECT * FROM "b")`},
+		expressionTestCase{val: e, sql: ` EXCEPT (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL (SELECT * FROM "b")`},
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL (SELECT * FROM "b")`, isPrepared: true},
 	)
 
 	opts := sqlgen.DefaultDialectOptions()
@@ -1066,6 +1491,28 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_CompoundExpression() {
 
 		expressionTestCase{val: ia, sql: ` INTERSECT ALL SELECT * FROM "b"`},
 		expressionTestCase{val: ia, sql: ` INTERSECT ALL SELECT * FROM "b"`, isPrepared: true},
+
+		expressionTestCase{val: e, sql: ` EXCEPT SELECT * FROM "b"`},
+		expressionTestCase{val: e, sql: ` EXCEPT SELECT * FROM "b"`, isPrepared: true},
+
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL SELECT * FROM "b"`},
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL SELECT * FROM "b"`, isPrepared: true},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.ExceptFragment = []byte(" MINUS ")
+	opts.ExceptAllFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: e, sql: ` MINUS (SELECT * FROM "b")`},
+		expressionTestCase{val: e, sql: ` MINUS (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: ea, err: "depiq: dialect does not support EXCEPT ALL compound expressions [dialect=test]"},
+		expressionTestCase{
+			val:        ea,
+			err:        "depiq: dialect does not support EXCEPT ALL compound expressions [dialect=test]",
+			isPrepared: true,
+		},
 	)
 }
 
@@ -1187,6 +1634,766 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_LateralExpression() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_ExistsExpression() {
+	ae := newTestAppendableExpression(`SELECT * FROM "b" WHERE ("b"."a_id" = "a"."id")`, emptyArgs, nil, nil)
+	aliasedAe := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, exp.NewIdentifierExpression("", "x", ""))
+	errAe := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, errors.New("expected error"), nil)
+
+	exists := exp.NewExistsExpression(exp.ExistsSubqueryType, ae)
+	notExists := exp.NewExistsExpression(exp.NotExistsSubqueryType, ae)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: exists, sql: `EXISTS (SELECT * FROM "b" WHERE ("b"."a_id" = "a"."id"))`},
+		expressionTestCase{val: exists, sql: `EXISTS (SELECT * FROM "b" WHERE ("b"."a_id" = "a"."id"))`, isPrepared: true},
+
+		expressionTestCase{val: notExists, sql: `NOT EXISTS (SELECT * FROM "b" WHERE ("b"."a_id" = "a"."id"))`},
+		expressionTestCase{
+			val:        notExists,
+			sql:        `NOT EXISTS (SELECT * FROM "b" WHERE ("b"."a_id" = "a"."id"))`,
+			isPrepared: true,
+		},
+
+		// the alias of the subquery is not used
+		expressionTestCase{val: exp.NewExistsExpression(exp.ExistsSubqueryType, aliasedAe), sql: `EXISTS (SELECT * FROM "b")`},
+
+		expressionTestCase{val: exp.NewExistsExpression(exp.ExistsSubqueryType, errAe), err: "depiq: expected error"},
+		expressionTestCase{val: exp.NewExistsExpression(exp.ExistsType(-1), ae), err: "depiq: exists type '-1' not supported"},
+		expressionTestCase{
+			val: exp.NewExistsExpression(exp.ExistsSubqueryType, nil),
+			err: "depiq: exists expressions require a subquery",
+		},
+		expressionTestCase{
+			val: exp.NewExistsExpression(exp.NotExistsSubqueryType, (*testAppendableExpression)(nil)),
+			err: "depiq: exists expressions require a subquery",
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_TupleExpression() {
+	t := exp.NewTupleExpression(exp.NewIdentifierExpression("", "", "a"), exp.NewIdentifierExpression("", "", "b"))
+	ae := newTestAppendableExpression(`SELECT "a", "b" FROM "test"`, emptyArgs, nil, nil)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: t, sql: `("a", "b")`},
+		expressionTestCase{val: exp.NewTupleExpression(1, "a"), sql: `(1, 'a')`},
+		expressionTestCase{val: exp.NewTupleExpression(1, "a"), sql: `(?, ?)`, isPrepared: true, args: []interface{}{int64(1), "a"}},
+
+		expressionTestCase{val: t.Eq([]interface{}{1, 2}), sql: `(("a", "b") = (1, 2))`},
+		expressionTestCase{val: t.Neq(exp.NewTupleExpression(1, 2)), sql: `(("a", "b") != (1, 2))`},
+		expressionTestCase{
+			val:        t.Gt([]interface{}{1, 2}),
+			sql:        `(("a", "b") > (?, ?))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(2)},
+		},
+		expressionTestCase{val: t.Gte([]int{1, 2}), sql: `(("a", "b") >= (1, 2))`},
+		expressionTestCase{val: t.Lt([]int{1, 2}), sql: `(("a", "b") < (1, 2))`},
+		expressionTestCase{val: t.Lte([]int{1, 2}), sql: `(("a", "b") <= (1, 2))`},
+		expressionTestCase{val: t.In([][]int{{1, 2}, {1, 3}}), sql: `(("a", "b") IN ((1, 2), (1, 3)))`},
+		expressionTestCase{
+			val:        t.NotIn([]int{1, 2}, []int{1, 3}),
+			sql:        `(("a", "b") NOT IN ((?, ?), (?, ?)))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(2), int64(1), int64(3)},
+		},
+		expressionTestCase{val: t.In(ae), sql: `(("a", "b") IN ((SELECT "a", "b" FROM "test")))`},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsRowValueInList = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: t.Gt([]int{1, 2}), sql: `(("a", "b") > (1, 2))`},
+		expressionTestCase{val: t.In(ae), sql: `(("a", "b") IN ((SELECT "a", "b" FROM "test")))`},
+		expressionTestCase{
+			val: t.In([][]int{{1, 2}, {1, 3}}),
+			sql: `((("a" = 1) AND ("b" = 2)) OR (("a" = 1) AND ("b" = 3)))`,
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SupportsRowValueComparison = false
+	opts.SupportsRowValueInList = false
+	three := exp.NewTupleExpression(
+		exp.NewIdentifierExpression("", "", "a"),
+		exp.NewIdentifierExpression("", "", "b"),
+		exp.NewIdentifierExpression("", "", "c"),
+	)
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: t, sql: `("a", "b")`},
+		expressionTestCase{val: t.Eq([]int{1, 2}), sql: `(("a" = 1) AND ("b" = 2))`},
+		expressionTestCase{val: t.Neq(exp.NewTupleExpression(1, 2)), sql: `(("a" != 1) OR ("b" != 2))`},
+		expressionTestCase{val: t.Gt([]int{1, 2}), sql: `(("a" > 1) OR (("a" = 1) AND ("b" > 2)))`},
+		expressionTestCase{val: t.Gte([]int{1, 2}), sql: `(("a" > 1) OR (("a" = 1) AND ("b" >= 2)))`},
+		expressionTestCase{val: t.Lt([]int{1, 2}), sql: `(("a" < 1) OR (("a" = 1) AND ("b" < 2)))`},
+		expressionTestCase{
+			val: three.Lte([]int{1, 2, 3}),
+			sql: `(("a" < 1) OR (("a" = 1) AND ("b" < 2)) OR (("a" = 1) AND ("b" = 2) AND ("c" <= 3)))`,
+		},
+		expressionTestCase{
+			val:        t.Gt([]int{1, 2}),
+			sql:        `(("a" > ?) OR (("a" = ?) AND ("b" > ?)))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(1), int64(2)},
+		},
+		expressionTestCase{
+			val: t.In([][]int{{1, 2}, {1, 3}}),
+			sql: `((("a" = 1) AND ("b" = 2)) OR (("a" = 1) AND ("b" = 3)))`,
+		},
+		expressionTestCase{
+			val: t.NotIn(exp.NewTupleExpression(1, 2), exp.NewTupleExpression(1, 3)),
+			sql: `((("a" != 1) OR ("b" != 2)) AND (("a" != 1) OR ("b" != 3)))`,
+		},
+		expressionTestCase{
+			val: exp.NewTupleExpression(exp.NewIdentifierExpression("", "", "a"), 1).Eq([]int{2, 3}),
+			sql: `(("a" = 2) AND (1 = 3))`,
+		},
+		expressionTestCase{val: t.In(ae), err: "depiq: dialect does not support row value in comparisons [dialect=test]"},
+		expressionTestCase{val: t.Gt([]int{1}), err: "depiq: row value comparison expected 2 values but got 1"},
+		expressionTestCase{val: t.Gt(1), err: "depiq: unable to expand row value comparison with int"},
+		// NULL elements are compared like any other value so the result is unknown just like the row value
+		expressionTestCase{val: t.Eq([]interface{}{1, nil}), sql: `(("a" = 1) AND ("b" = NULL))`},
+		expressionTestCase{
+			val: t.Gte([]interface{}{1, nil}),
+			sql: `(("a" > 1) OR (("a" = 1) AND ("b" >= NULL)))`,
+		},
+		expressionTestCase{
+			val: exp.NewBooleanExpression(exp.LikeOp, t, []int{1, 2}),
+			err: "depiq: dialect does not support row value like comparisons [dialect=test]",
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_JSONExpression() {
+	data := exp.NewIdentifierExpression("", "", "data")
+	extract := exp.NewJSONExpression(exp.JSONExtractOp, data, []interface{}{"a", "b c", 0})
+	extractText := exp.NewJSONExpression(exp.JSONExtractTextOp, data, []interface{}{"a", "b"})
+	contains := exp.NewJSONExpression(exp.JSONContainsOp, data, map[string]int{"a": 1})
+	hasKey := exp.NewJSONExpression(exp.JSONHasKeyOp, data, "a")
+	arrayLength := exp.NewJSONExpression(exp.JSONArrayLengthOp, extract, nil)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: extract, sql: `("data"->'a'->'b c'->0)`},
+		expressionTestCase{val: extract, sql: `("data"->'a'->'b c'->0)`, isPrepared: true},
+		expressionTestCase{val: extractText, sql: `("data"->'a'->>'b')`},
+		expressionTestCase{val: extractText.Eq("x"), sql: `(("data"->'a'->>'b') = ?)`, isPrepared: true, args: []interface{}{"x"}},
+		expressionTestCase{val: extractText.Desc(), sql: `("data"->'a'->>'b') DESC`},
+		expressionTestCase{val: contains, sql: `("data" @> '{"a":1}')`},
+		expressionTestCase{val: contains, sql: `("data" @> ?)`, isPrepared: true, args: []interface{}{`{"a":1}`}},
+		expressionTestCase{
+			val: exp.NewJSONExpression(exp.JSONContainsOp, data, exp.NewIdentifierExpression("", "", "other")),
+			sql: `("data" @> "other")`,
+		},
+		expressionTestCase{val: hasKey, sql: `jsonb_exists("data", 'a')`},
+		expressionTestCase{val: hasKey, sql: `jsonb_exists("data", 'a')`, isPrepared: true},
+		expressionTestCase{val: arrayLength, sql: `jsonb_array_length(("data"->'a'->'b c'->0))`},
+		expressionTestCase{
+			val: exp.NewJSONExpression(exp.JSONExtractOp, data, []interface{}{int64(1), uint8(2), int32(-1)}),
+			sql: `("data"->1->2->-1)`,
+		},
+
+		expressionTestCase{
+			val: exp.NewJSONExpression(exp.JSONExtractOp, data, []interface{}{}),
+			err: "depiq: JSON extract expressions require a path",
+		},
+		expressionTestCase{
+			val: exp.NewJSONExpression(exp.JSONExtractOp, data, []interface{}{1.5}),
+			err: "depiq: unsupported JSON path element float64",
+		},
+		expressionTestCase{
+			val: exp.NewJSONExpression(exp.JSONHasKeyOp, data, 1),
+			err: "depiq: JSON has key expressions require a string key got int",
+		},
+		expressionTestCase{
+			val: exp.NewJSONExpression(exp.JSONContainsOp, data, make(chan int)),
+			err: "depiq: unable to encode JSON candidate: json: unsupported type: chan int",
+		},
+		expressionTestCase{
+			val: exp.NewJSONExpression(exp.JSONOperation(-1), data, nil),
+			err: "depiq: unsupported JSON operation -1",
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.UseJSONPathString = true
+	opts.JSONHasKeyIsNotNull = true
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{exp.JSONExtractTextOp: []byte("->>")}
+	opts.JSONFunctionLookup = map[exp.JSONOperation][]byte{
+		exp.JSONExtractOp:     []byte("JSON_EXTRACT"),
+		exp.JSONContainsOp:    []byte("JSON_CONTAINS"),
+		exp.JSONHasKeyOp:      []byte("JSON_EXTRACT"),
+		exp.JSONArrayLengthOp: []byte("JSON_LENGTH"),
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: extract, sql: `JSON_EXTRACT("data", '$.a."b c"[0]')`},
+		expressionTestCase{val: extract, sql: `JSON_EXTRACT("data", '$.a."b c"[0]')`, isPrepared: true},
+		expressionTestCase{val: extractText, sql: `("data"->>'$.a.b')`},
+		expressionTestCase{val: contains, sql: `JSON_CONTAINS("data", '{"a":1}')`},
+		expressionTestCase{val: hasKey, sql: `(JSON_EXTRACT("data", '$.a') IS NOT NULL)`},
+		expressionTestCase{val: arrayLength, sql: `JSON_LENGTH(JSON_EXTRACT("data", '$.a."b c"[0]'))`},
+		expressionTestCase{
+			val: exp.NewJSONExpression(exp.JSONExtractOp, data, []interface{}{"a", int64(1), uint(2)}),
+			sql: `JSON_EXTRACT("data", '$.a[1][2]')`,
+		},
+		expressionTestCase{
+			val: exp.NewJSONExpression(exp.JSONExtractOp, data, []interface{}{"a", 1.5}),
+			err: "depiq: unsupported JSON path element float64",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{
+		exp.JSONExtractTextOp: []byte("#>>"),
+		exp.JSONHasKeyOp:      []byte("?"),
+	}
+	opts.JSONFunctionLookup = map[exp.JSONOperation][]byte{exp.JSONExtractOp: []byte("jsonb_extract_path")}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: hasKey, sql: `("data" ? 'a')`},
+		expressionTestCase{val: extract, sql: `jsonb_extract_path("data", 'a', 'b c', 0)`},
+		expressionTestCase{val: exp.NewJSONExpression(exp.JSONExtractTextOp, data, []interface{}{"a"}), sql: `("data"#>>'a')`},
+		expressionTestCase{val: extractText, err: "depiq: dialect does not support JSON extract expressions [dialect=test]"},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{}
+	opts.JSONFunctionLookup = map[exp.JSONOperation][]byte{}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: extract, err: "depiq: dialect does not support JSON extract expressions [dialect=test]"},
+		expressionTestCase{val: extractText, err: "depiq: dialect does not support JSON extract text expressions [dialect=test]"},
+		expressionTestCase{val: contains, err: "depiq: dialect does not support JSON contains expressions [dialect=test]"},
+		expressionTestCase{val: hasKey, err: "depiq: dialect does not support JSON has key expressions [dialect=test]"},
+		expressionTestCase{val: arrayLength, err: "depiq: dialect does not support JSON array length expressions [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_QuantifiedExpression() {
+	ae := newTestAppendableExpression(`SELECT "a" FROM "b" WHERE ("c" = ?)`, []interface{}{1}, nil, nil)
+	anyExp := exp.NewQuantifiedExpression(exp.AnyQuantifierType, ae)
+	allExp := exp.NewQuantifiedExpression(exp.AllQuantifierType, ae)
+	col := exp.NewIdentifierExpression("", "", "a")
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: anyExp, sql: `ANY(SELECT "a" FROM "b" WHERE ("c" = ?))`, args: []interface{}{1}},
+		expressionTestCase{val: allExp, sql: `ALL(SELECT "a" FROM "b" WHERE ("c" = ?))`, args: []interface{}{1}},
+		expressionTestCase{
+			val:        col.Gt(anyExp),
+			sql:        `("a" > ANY(SELECT "a" FROM "b" WHERE ("c" = ?)))`,
+			isPrepared: true,
+			args:       []interface{}{1},
+		},
+		expressionTestCase{
+			val:        col.Neq(allExp),
+			sql:        `("a" != ALL(SELECT "a" FROM "b" WHERE ("c" = ?)))`,
+			isPrepared: true,
+			args:       []interface{}{1},
+		},
+		expressionTestCase{val: exp.NewQuantifiedExpression(exp.AnyQuantifierType, col), sql: `ANY("a")`},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.QuantifierLookup = map[exp.QuantifierType][]byte{exp.AnyQuantifierType: []byte("SOME")}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: anyExp, sql: `SOME(SELECT "a" FROM "b" WHERE ("c" = ?))`, args: []interface{}{1}},
+		expressionTestCase{val: allExp, err: "depiq: dialect does not support ALL comparisons [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_ArrayExpression() {
+	col := exp.NewIdentifierExpression("", "", "a")
+	arr := exp.NewArrayExpression("a", col)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: arr, sql: `ARRAY['a', "a"]`},
+		expressionTestCase{val: arr, sql: `ARRAY[?, "a"]`, isPrepared: true, args: []interface{}{"a"}},
+		expressionTestCase{val: exp.NewArrayExpression([]int{1, 2}), sql: `ARRAY[1, 2]`},
+		expressionTestCase{val: exp.NewArrayExpression(), sql: `ARRAY[]`},
+		expressionTestCase{val: exp.NewArrayExpression(1, 2).Cast("int[]"), sql: `CAST(ARRAY[1, 2] AS int[])`},
+
+		expressionTestCase{val: col.Eq(exp.NewArrayExpression(1)), sql: `("a" = ARRAY[1])`},
+		expressionTestCase{val: exp.NewArrayExpression(1, 2).Eq([]int{1, 2}), sql: `(ARRAY[1, 2] = ARRAY[1, 2])`},
+		expressionTestCase{val: exp.NewArrayExpression(1, 2).Neq(arr), sql: `(ARRAY[1, 2] != ARRAY['a', "a"])`},
+		expressionTestCase{val: arr.Contains([]string{"a"}), sql: `(ARRAY['a', "a"] @> ARRAY['a'])`},
+		expressionTestCase{
+			val: exp.NewBooleanExpression(exp.ArrayContainsOp, col, []string{"a", "b"}),
+			sql: `("a" @> ARRAY['a', 'b'])`,
+		},
+		expressionTestCase{
+			val:        exp.NewBooleanExpression(exp.ArrayContainedByOp, col, []string{"a", "b"}),
+			sql:        `("a" <@ ARRAY[?, ?])`,
+			isPrepared: true,
+			args:       []interface{}{"a", "b"},
+		},
+		expressionTestCase{
+			val: exp.NewBooleanExpression(exp.ArrayOverlapsOp, col, exp.NewArrayExpression("a")),
+			sql: `("a" && ARRAY['a'])`,
+		},
+		expressionTestCase{
+			val: col.Eq(exp.NewQuantifiedExpression(exp.AnyQuantifierType, []int{1, 2})),
+			sql: `("a" = ANY(ARRAY[1, 2]))`,
+		},
+		expressionTestCase{val: col.In([]int{1, 2}), sql: `("a" IN (?, ?))`, isPrepared: true, args: []interface{}{
+			int64(1), int64(2),
+		}},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.BindSliceAsArray = true
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: exp.NewArrayExpression([]int{1, 2}), sql: `ARRAY[1, 2]`},
+		expressionTestCase{
+			val:        exp.NewArrayExpression([]int{1, 2}),
+			sql:        `?`,
+			isPrepared: true,
+			args:       []interface{}{[]int{1, 2}},
+		},
+		expressionTestCase{val: arr, sql: `ARRAY[?, "a"]`, isPrepared: true, args: []interface{}{"a"}},
+		expressionTestCase{val: col.In([]int{1, 2}), sql: `("a" IN (1, 2))`},
+		expressionTestCase{
+			val:        col.In([]int{1, 2}),
+			sql:        `("a" = ANY(?))`,
+			isPrepared: true,
+			args:       []interface{}{[]int{1, 2}},
+		},
+		expressionTestCase{
+			val:        col.NotIn("a", "b"),
+			sql:        `("a" != ALL(?))`,
+			isPrepared: true,
+			args:       []interface{}{[]interface{}{"a", "b"}},
+		},
+		expressionTestCase{
+			val:        col.In(1, col),
+			sql:        `("a" IN (?, "a"))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+		expressionTestCase{
+			val:        exp.NewBooleanExpression(exp.ArrayContainsOp, col, []string{"a", "b"}),
+			sql:        `("a" @> ?)`,
+			isPrepared: true,
+			args:       []interface{}{[]string{"a", "b"}},
+		},
+		expressionTestCase{
+			val:        col.Eq(exp.NewQuantifiedExpression(exp.AnyQuantifierType, []int{1, 2})),
+			sql:        `("a" = ANY(?))`,
+			isPrepared: true,
+			args:       []interface{}{[]int{1, 2}},
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.BindSliceAsArray = true
+	opts.ArrayValuer = func(slice interface{}) interface{} { return fmt.Sprintf("%v", slice) }
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: col.In([]int{1, 2}), sql: `("a" = ANY(?))`, isPrepared: true, args: []interface{}{"[1 2]"}},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SupportsArrays = false
+	opts.BindSliceAsArray = true
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: arr, err: "depiq: dialect does not support arrays [dialect=test]"},
+		expressionTestCase{
+			val: col.Eq(exp.NewQuantifiedExpression(exp.AnyQuantifierType, []int{1, 2})),
+			err: "depiq: dialect does not support arrays [dialect=test]",
+		},
+		expressionTestCase{
+			val:        col.In([]int{1, 2}),
+			sql:        `("a" IN (?, ?))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(2)},
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_TextSearchExpression() {
+	title := exp.NewIdentifierExpression("", "", "title")
+	body := exp.NewIdentifierExpression("", "", "body")
+	match := exp.NewTextSearchExpression(exp.TextSearchMatchType, []exp.Expression{body}, "cats")
+	multiMatch := exp.NewTextSearchExpression(exp.TextSearchMatchType, []exp.Expression{title, body}, "cats")
+	noDocuments := exp.NewTextSearchExpression(exp.TextSearchMatchType, nil, "cats")
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: match, sql: `(to_tsvector("body") @@ websearch_to_tsquery('cats'))`},
+		expressionTestCase{
+			val:        match,
+			sql:        `(to_tsvector("body") @@ websearch_to_tsquery(?))`,
+			isPrepared: true,
+			args:       []interface{}{"cats"},
+		},
+		expressionTestCase{
+			val: match.WithConfig("english").WithMode(exp.BooleanTextSearchMode),
+			sql: `(to_tsvector('english', "body") @@ to_tsquery('english', 'cats'))`,
+		},
+		expressionTestCase{
+			val:        match.WithConfig("english"),
+			sql:        `(to_tsvector('english', "body") @@ websearch_to_tsquery('english', ?))`,
+			isPrepared: true,
+			args:       []interface{}{"cats"},
+		},
+		expressionTestCase{
+			val: multiMatch,
+			sql: `((to_tsvector("title") || to_tsvector("body")) @@ websearch_to_tsquery('cats'))`,
+		},
+		expressionTestCase{val: match.Rank(), sql: `ts_rank(to_tsvector("body"), websearch_to_tsquery('cats'))`},
+		expressionTestCase{
+			val: multiMatch.Rank().Desc(),
+			sql: `ts_rank((to_tsvector("title") || to_tsvector("body")), websearch_to_tsquery('cats')) DESC`,
+		},
+		expressionTestCase{val: noDocuments, err: "depiq: full-text search expressions require at least one document"},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.TextSearchStyle = sqlgen.MatchAgainstTextSearchStyle
+	opts.TextSearchModeLookup = map[exp.TextSearchMode][]byte{
+		exp.NaturalLanguageTextSearchMode: []byte(" IN NATURAL LANGUAGE MODE"),
+	}
+	opts.TextSearchRankFunction = nil
+	opts.TextSearchMatchFragment = []byte("MATCH ")
+	opts.TextSearchAgainstFragment = []byte(" AGAINST ")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: match, sql: `MATCH ("body") AGAINST ('cats' IN NATURAL LANGUAGE MODE)`},
+		expressionTestCase{
+			val:        multiMatch.Rank(),
+			sql:        `MATCH ("title", "body") AGAINST (? IN NATURAL LANGUAGE MODE)`,
+			isPrepared: true,
+			args:       []interface{}{"cats"},
+		},
+		expressionTestCase{
+			val: match.WithMode(exp.BooleanTextSearchMode),
+			err: "depiq: dialect does not support boolean full-text search [dialect=test]",
+		},
+		expressionTestCase{
+			val: match.WithConfig("english"),
+			err: "depiq: dialect does not support full-text search configurations [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.TextSearchStyle = sqlgen.FTS5TextSearchStyle
+	opts.TextSearchModeLookup = map[exp.TextSearchMode][]byte{exp.NaturalLanguageTextSearchMode: []byte("MATCH")}
+	opts.TextSearchRankFunction = []byte("bm25")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: match, sql: `("body" MATCH 'cats')`},
+		expressionTestCase{val: match, sql: `("body" MATCH ?)`, isPrepared: true, args: []interface{}{"cats"}},
+		expressionTestCase{val: match.Rank().Asc(), sql: `bm25("body") ASC`},
+		expressionTestCase{
+			val: multiMatch,
+			err: "depiq: dialect requires a single full-text search document got 2 [dialect=test]",
+		},
+		expressionTestCase{
+			val: match.WithConfig("english"),
+			err: "depiq: dialect does not support full-text search configurations [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.TextSearchStyle = sqlgen.ContainsTextSearchStyle
+	opts.TextSearchModeLookup = map[exp.TextSearchMode][]byte{
+		exp.NaturalLanguageTextSearchMode: []byte("FREETEXT"),
+		exp.BooleanTextSearchMode:         []byte("CONTAINS"),
+	}
+	opts.TextSearchRankFunction = nil
+	opts.TextSearchLanguageFragment = []byte(", LANGUAGE ")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: match, sql: `FREETEXT("body", 'cats')`},
+		expressionTestCase{
+			val:        multiMatch.WithMode(exp.BooleanTextSearchMode).WithConfig("english"),
+			sql:        `CONTAINS(("title", "body"), ?, LANGUAGE 'english')`,
+			isPrepared: true,
+			args:       []interface{}{"cats"},
+		},
+		expressionTestCase{
+			val: match.Rank(),
+			err: "depiq: dialect does not support full-text search ranking [dialect=test]",
+		},
+	)
+
+	opts.TextSearchLanguageFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: match, sql: `FREETEXT("body", 'cats')`},
+		expressionTestCase{
+			val: match.WithConfig("english"),
+			err: "depiq: dialect does not support full-text search configurations [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.TextSearchStyle = sqlgen.MatchAgainstTextSearchStyle
+	opts.TextSearchModeLookup = map[exp.TextSearchMode][]byte{
+		exp.NaturalLanguageTextSearchMode: []byte(" IN NATURAL LANGUAGE MODE"),
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: match, err: "depiq: dialect does not support full-text search [dialect=test]"},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.TextSearchStyle = sqlgen.NoTextSearchStyle
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: match, err: "depiq: dialect does not support full-text search [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_DateTimeExpressions() {
+	ts := exp.NewIdentifierExpression("", "", "ts")
+	week := exp.NewIntervalExpression(7, exp.DayUnit)
+	millis := exp.NewIntervalExpression(5, exp.MillisecondUnit)
+	add := exp.NewDateArithmeticExpression(exp.DateAddOp, ts, week)
+	sub := exp.NewDateArithmeticExpression(exp.DateSubOp, ts, week)
+	year := exp.NewExtractExpression(exp.YearUnit, ts)
+	dow := exp.NewExtractExpression(exp.DayOfWeekUnit, ts)
+	month := exp.NewDateTruncExpression(exp.MonthUnit, ts)
+	quarter := exp.NewDateTruncExpression(exp.QuarterUnit, ts)
+	now := exp.NewCurrentTimeExpression(exp.NowCurrentTimeType)
+	today := exp.NewCurrentTimeExpression(exp.DateCurrentTimeType)
+	twoQuarters := exp.NewIntervalExpression(2, exp.QuarterUnit)
+	twoWeeks := exp.NewIntervalExpression(2, exp.WeekUnit)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: week, sql: `INTERVAL '7 days'`},
+		expressionTestCase{val: twoWeeks, sql: `INTERVAL '2 weeks'`},
+		expressionTestCase{val: twoQuarters, sql: `INTERVAL '6 months'`},
+		expressionTestCase{
+			val: exp.NewDateArithmeticExpression(exp.DateSubOp, ts, twoQuarters),
+			sql: `("ts" - INTERVAL '6 months')`,
+		},
+		expressionTestCase{val: week, sql: `INTERVAL '7 days'`, isPrepared: true},
+		expressionTestCase{val: add, sql: `("ts" + INTERVAL '7 days')`},
+		expressionTestCase{val: sub, sql: `("ts" - INTERVAL '7 days')`},
+		expressionTestCase{val: sub, sql: `("ts" - INTERVAL '7 days')`, isPrepared: true},
+		expressionTestCase{val: year, sql: `EXTRACT(YEAR FROM "ts")`},
+		expressionTestCase{val: dow, sql: `EXTRACT(DOW FROM "ts")`},
+		expressionTestCase{val: month, sql: `date_trunc('month', "ts")`},
+		expressionTestCase{val: now, sql: `NOW()`},
+		expressionTestCase{val: today, sql: `CURRENT_DATE`},
+		expressionTestCase{
+			val: exp.NewIdentifierExpression("", "", "a").Gt(exp.NewDateArithmeticExpression(exp.DateSubOp, now, week)),
+			sql: `("a" > (NOW() - INTERVAL '7 days'))`,
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.DateTimeStyle = sqlgen.DateAddDateTimeStyle
+	opts.DateAddFunction = []byte("DATE_ADD")
+	opts.DateSubFunction = []byte("DATE_SUB")
+	opts.DateTruncFunction = []byte("DATE_FORMAT")
+	opts.DateTruncCastType = []byte("DATETIME")
+	opts.IntervalUnitLookup = map[exp.DateTimeUnit][]byte{exp.DayUnit: []byte("DAY")}
+	opts.ExtractFieldLookup = map[exp.DateTimeUnit][]byte{exp.YearUnit: []byte("YEAR")}
+	opts.DateTruncLookup = map[exp.DateTimeUnit][]byte{exp.MonthUnit: []byte("%Y-%m-01 00:00:00")}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: week, sql: `INTERVAL 7 DAY`},
+		expressionTestCase{val: add, sql: `DATE_ADD("ts", INTERVAL 7 DAY)`},
+		expressionTestCase{val: sub, sql: `DATE_SUB("ts", INTERVAL 7 DAY)`, isPrepared: true},
+		expressionTestCase{val: year, sql: `EXTRACT(YEAR FROM "ts")`},
+		expressionTestCase{val: month, sql: `CAST(DATE_FORMAT("ts", '%Y-%m-01 00:00:00') AS DATETIME)`},
+		expressionTestCase{val: twoWeeks, sql: `INTERVAL 14 DAY`},
+		expressionTestCase{val: millis, err: "depiq: dialect does not support millisecond intervals [dialect=test]"},
+		expressionTestCase{val: twoQuarters, err: "depiq: dialect does not support quarter intervals [dialect=test]"},
+		expressionTestCase{val: dow, err: "depiq: dialect does not support extracting day of week [dialect=test]"},
+		expressionTestCase{val: quarter, err: "depiq: dialect does not support truncating to quarter [dialect=test]"},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.DateTimeStyle = sqlgen.DatePartDateTimeStyle
+	opts.DateAddFunction = []byte("DATEADD")
+	opts.ExtractFunction = []byte("DATEPART")
+	opts.DateTruncFunction = []byte("DATETRUNC")
+	opts.IntervalUnitLookup = map[exp.DateTimeUnit][]byte{exp.DayUnit: []byte("day")}
+	opts.ExtractFieldLookup = map[exp.DateTimeUnit][]byte{exp.YearUnit: []byte("year")}
+	opts.DateTruncLookup = map[exp.DateTimeUnit][]byte{exp.MonthUnit: []byte("month")}
+	opts.CurrentTimeLookup = map[exp.CurrentTimeType][]byte{exp.NowCurrentTimeType: []byte("SYSDATETIME()")}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: add, sql: `DATEADD(day, 7, "ts")`},
+		expressionTestCase{val: sub, sql: `DATEADD(day, -7, "ts")`, isPrepared: true},
+		expressionTestCase{val: exp.NewDateArithmeticExpression(exp.DateSubOp, ts, twoWeeks), sql: `DATEADD(day, -14, "ts")`},
+		expressionTestCase{val: year, sql: `DATEPART(year, "ts")`},
+		expressionTestCase{val: month, sql: `DATETRUNC(month, "ts")`},
+		expressionTestCase{val: now, sql: `SYSDATETIME()`},
+		expressionTestCase{
+			val: week,
+			err: "depiq: dialect does not support INTERVAL literals, use DateAdd or DateSub [dialect=test]",
+		},
+		expressionTestCase{val: today, err: "depiq: dialect does not support CURRENT_DATE [dialect=test]"},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.DateTimeStyle = sqlgen.ModifierDateTimeStyle
+	opts.DateAddFunction = []byte("datetime")
+	opts.ExtractFunction = []byte("strftime")
+	opts.ExtractCastType = []byte("INTEGER")
+	opts.DateTruncFunction = []byte("strftime")
+	opts.IntervalUnitLookup = map[exp.DateTimeUnit][]byte{exp.DayUnit: []byte("days")}
+	opts.ExtractFieldLookup = map[exp.DateTimeUnit][]byte{exp.YearUnit: []byte("%Y")}
+	opts.DateTruncLookup = map[exp.DateTimeUnit][]byte{exp.MonthUnit: []byte("%Y-%m-01 00:00:00")}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: add, sql: `datetime("ts", '+7 days')`},
+		expressionTestCase{val: sub, sql: `datetime("ts", '-7 days')`, isPrepared: true},
+		expressionTestCase{
+			val: exp.NewDateArithmeticExpression(exp.DateAddOp, ts, exp.NewIntervalExpression(-1, exp.DayUnit)),
+			sql: `datetime("ts", '-1 days')`,
+		},
+		expressionTestCase{
+			val: exp.NewDateArithmeticExpression(exp.DateAddOp, ts, twoWeeks),
+			sql: `datetime("ts", '+14 days')`,
+		},
+		expressionTestCase{val: year, sql: `CAST(strftime('%Y', "ts") AS INTEGER)`},
+		expressionTestCase{val: month, sql: `strftime('%Y-%m-01 00:00:00', "ts")`},
+		expressionTestCase{
+			val: week,
+			err: "depiq: dialect does not support INTERVAL literals, use DateAdd or DateSub [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.DateTimeStyle = sqlgen.DateAddDateTimeStyle
+	opts.DateAddFunction = []byte("DATE_ADD")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: add, sql: `DATE_ADD("ts", INTERVAL 7 days)`},
+		expressionTestCase{val: sub, err: "depiq: dialect does not support date arithmetic [dialect=test]"},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.DateTimeStyle = sqlgen.ModifierDateTimeStyle
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: add, err: "depiq: dialect does not support date arithmetic [dialect=test]"},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.DateTimeStyle = sqlgen.DateTimeStyle(-1)
+	opts.DateAddFunction = []byte("DATE_ADD")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: add, err: "depiq: date time style '-1' not supported"},
+		expressionTestCase{val: year, err: "depiq: date time style '-1' not supported"},
+		expressionTestCase{val: month, err: "depiq: date time style '-1' not supported"},
+		expressionTestCase{
+			val: week,
+			err: "depiq: dialect does not support INTERVAL literals, use DateAdd or DateSub [dialect=test]",
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_StringExpressions() {
+	name := exp.NewIdentifierExpression("", "", "name")
+	concat := exp.NewStringExpression(exp.ConcatOp, exp.NewIdentifierExpression("", "", "first"), " ", name)
+	substring := exp.NewStringExpression(exp.SubstringOp, name, 2)
+	position := exp.NewStringExpression(exp.PositionOp, name, "a")
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: concat, sql: `("first" || ' ' || "name")`},
+		expressionTestCase{val: concat, sql: `("first" || ? || "name")`, isPrepared: true, args: []interface{}{" "}},
+		expressionTestCase{val: exp.NewStringExpression(exp.LowerOp, name), sql: `LOWER("name")`},
+		expressionTestCase{val: exp.NewStringExpression(exp.UpperOp, name), sql: `UPPER("name")`},
+		expressionTestCase{val: exp.NewStringExpression(exp.TrimOp, name), sql: `TRIM("name")`},
+		expressionTestCase{val: exp.NewStringExpression(exp.LengthOp, name), sql: `LENGTH("name")`},
+		expressionTestCase{val: substring, sql: `SUBSTRING("name", 2)`},
+		expressionTestCase{
+			val: exp.NewStringExpression(exp.SubstringOp, name, 2, 3),
+			sql: `SUBSTRING("name", ?, ?)`, isPrepared: true, args: []interface{}{int64(2), int64(3)},
+		},
+		expressionTestCase{val: position, sql: `POSITION('a' IN "name")`},
+		expressionTestCase{
+			val: exp.NewStringExpression(exp.ReplaceOp, name, "a", "b"),
+			sql: `REPLACE("name", 'a', 'b')`,
+		},
+		expressionTestCase{
+			val: exp.NewStringExpression(exp.LowerOp, name).Eq("a"),
+			sql: `(LOWER("name") = 'a')`,
+		},
+		expressionTestCase{val: name.Collate("C"), sql: `"name" COLLATE "C"`},
+		expressionTestCase{val: name.Collate("C").Gt("a"), sql: `("name" COLLATE "C" > 'a')`},
+		expressionTestCase{val: name.Collate("C").Desc(), sql: `"name" COLLATE "C" DESC`},
+		expressionTestCase{
+			val: exp.NewStringExpression(exp.LowerOp),
+			err: "depiq: invalid number of arguments for lower got 0",
+		},
+		expressionTestCase{
+			val: exp.NewStringExpression(exp.PositionOp, name),
+			err: "depiq: invalid number of arguments for position got 1",
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ConcatOperator = nil
+	opts.PositionStyle = sqlgen.StringFirstPositionStyle
+	opts.StringFunctionLookup = map[exp.StringOperation][]byte{
+		exp.ConcatOp:   []byte("CONCAT"),
+		exp.PositionOp: []byte("INSTR"),
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: concat, sql: `CONCAT("first", ' ', "name")`},
+		expressionTestCase{val: position, sql: `INSTR("name", 'a')`},
+		expressionTestCase{
+			val: substring,
+			err: "depiq: dialect does not support the substring string function [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.PositionInFragment = []byte(" in ")
+	opts.CollateFragment = []byte(" collate ")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: position, sql: `POSITION('a' in "name")`},
+		expressionTestCase{val: name.Collate("C"), sql: `"name" collate "C"`},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.ConcatOperator = []byte("+")
+	opts.PositionStyle = sqlgen.SubstringFirstPositionStyle
+	opts.SubstringRequiresLength = true
+	opts.QuoteCollations = false
+	opts.StringFunctionLookup = map[exp.StringOperation][]byte{
+		exp.SubstringOp: []byte("SUBSTRING"),
+		exp.LengthOp:    []byte("LEN"),
+		exp.PositionOp:  []byte("CHARINDEX"),
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: concat, sql: `("first" + ' ' + "name")`},
+		expressionTestCase{val: position, sql: `CHARINDEX('a', "name")`},
+		expressionTestCase{val: substring, sql: `SUBSTRING("name", 2, LEN("name"))`},
+		expressionTestCase{val: name.Collate("Latin1_General_CS_AS"), sql: `"name" COLLATE Latin1_General_CS_AS`},
+		expressionTestCase{
+			val: name.Collate("Latin1_General_CS_AS; DROP TABLE users --"),
+			err: `depiq: invalid collation name "Latin1_General_CS_AS; DROP TABLE users --", ` +
+				`unquoted collations may only contain letters, digits and _`,
+		},
+		expressionTestCase{
+			val: name.Collate(""),
+			err: `depiq: invalid collation name "", unquoted collations may only contain letters, digits and _`,
+		},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_CaseExpression() {
 	ident := exp.NewIdentifierExpression("", "", "col")
 	valueCase := exp.NewCaseExpression().
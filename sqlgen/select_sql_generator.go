@@ -44,6 +44,9 @@ func NewSelectSQLGenerator(dialect string, do *SQLDialectOptions) SelectSQLGener
 }
 
 func (ssg *selectSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.SelectClauses) {
+	if ssg.DialectOptions().SimplifyExpressions {
+		clauses = exp.RewriteSelectClauses(clauses, Simplifier(ssg.DialectOptions()))
+	}
 	for _, f := range ssg.DialectOptions().SelectSQLOrder {
 		if b.Error() != nil {
 			return
@@ -152,10 +155,38 @@ func (ssg *selectSQLGenerator) JoinSQL(b sb.SQLBuilder, joins exp.JoinExpression
 func (ssg *selectSQLGenerator) GroupBySQL(b sb.SQLBuilder, groupBy exp.ColumnListExpression) {
 	if groupBy != nil && len(groupBy.Columns()) > 0 {
 		b.Write(ssg.DialectOptions().GroupByFragment)
+		cols := groupBy.Columns()
+		if len(cols) == 1 && len(ssg.DialectOptions().WithRollupFragment) > 0 {
+			if rollup, ok := cols[0].(exp.GroupingExpression); ok && rollup.Type() == exp.RollupGroupingType {
+				ssg.withRollupSQL(b, rollup)
+				return
+			}
+		}
 		ssg.ExpressionSQLGenerator().Generate(b, groupBy)
 	}
 }
 
+// Generates a ROLLUP using the WITH ROLLUP modifier for dialects that do not support ROLLUP(...)
+//
+//	GROUP BY "a", "b" WITH ROLLUP
+func (ssg *selectSQLGenerator) withRollupSQL(b sb.SQLBuilder, rollup exp.GroupingExpression) {
+	sets := rollup.Sets()
+	cols := make([]interface{}, 0, len(sets))
+	for _, set := range sets {
+		if len(set.Columns()) != 1 {
+			b.SetError(errWithRollupNotSupported(ssg.Dialect()))
+			return
+		}
+		cols = append(cols, set.Columns()[0])
+	}
+	if len(cols) == 0 {
+		b.SetError(errWithRollupNotSupported(ssg.Dialect()))
+		return
+	}
+	ssg.ExpressionSQLGenerator().Generate(b, exp.NewColumnListExpression(cols...))
+	b.Write(ssg.DialectOptions().WithRollupFragment)
+}
+
 // Generates the HAVING clause for an SQL statement
 func (ssg *selectSQLGenerator) HavingSQL(b sb.SQLBuilder, having exp.ExpressionList) {
 	if having != nil && len(having.Expressions()) > 0 {
@@ -288,6 +288,33 @@ func (ssgs *selectSQLGeneratorSuite) TestGenerate_withWhere() {
 	)
 }
 
+func (ssgs *selectSQLGeneratorSuite) TestGenerate_withSimplifyExpressions() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SimplifyExpressions = true
+
+	a := exp.NewIdentifierExpression("", "", "a").Eq("b")
+	sc := exp.NewSelectClauses().
+		SetFrom(exp.NewColumnListExpression("test")).
+		WhereAppend(exp.NewExpressionList(exp.AndType, exp.Ex{}, exp.NewExpressionList(exp.OrType), a, a))
+	scIn := sc.WhereAppend(exp.NewIdentifierExpression("", "", "c").In([]int{}))
+
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT * FROM "test" WHERE ("a" = 'b')`},
+		selectTestCase{clause: sc, sql: `SELECT * FROM "test" WHERE ("a" = ?)`, isPrepared: true, args: []interface{}{"b"}},
+
+		selectTestCase{clause: scIn, sql: `SELECT * FROM "test" WHERE FALSE`},
+		selectTestCase{clause: scIn, sql: `SELECT * FROM "test" WHERE FALSE`, isPrepared: true},
+	)
+
+	opts.SimplifyExpressions = false
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT * FROM "test" WHERE (("a" = 'b') AND ("a" = 'b'))`},
+		selectTestCase{clause: scIn, sql: `SELECT * FROM "test" WHERE ((("a" = 'b') AND ("a" = 'b')) AND ("c" IN ()))`},
+	)
+}
+
 func (ssgs *selectSQLGeneratorSuite) TestGenerate_withGroupBy() {
 	opts := sqlgen.DefaultDialectOptions()
 	opts.GroupByFragment = []byte(" group by ")
@@ -306,6 +333,40 @@ func (ssgs *selectSQLGeneratorSuite) TestGenerate_withGroupBy() {
 	)
 }
 
+func (ssgs *selectSQLGeneratorSuite) TestGenerate_withGroupByRollup() {
+	rollup := exp.NewGroupingExpression(
+		exp.RollupGroupingType, exp.NewColumnListExpression("a"), exp.NewColumnListExpression("b"),
+	)
+	compositeRollup := exp.NewGroupingExpression(
+		exp.RollupGroupingType, exp.NewColumnListExpression("a"), exp.NewColumnListExpression("b", "c"),
+	)
+
+	sc := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test"))
+	scRollup := sc.SetGroupBy(exp.NewColumnListExpression(rollup))
+	scCompositeRollup := sc.SetGroupBy(exp.NewColumnListExpression(compositeRollup))
+	scColAndRollup := sc.SetGroupBy(exp.NewColumnListExpression("x", rollup))
+	scEmptyRollup := sc.SetGroupBy(exp.NewColumnListExpression(exp.NewGroupingExpression(exp.RollupGroupingType)))
+
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		selectTestCase{clause: scRollup, sql: `SELECT * FROM "test" GROUP BY ROLLUP("a", "b")`},
+		selectTestCase{clause: scCompositeRollup, sql: `SELECT * FROM "test" GROUP BY ROLLUP("a", ("b", "c"))`},
+		selectTestCase{clause: scColAndRollup, sql: `SELECT * FROM "test" GROUP BY "x", ROLLUP("a", "b")`},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.WithRollupFragment = []byte(" WITH ROLLUP")
+	errMsg := "depiq: dialect only supports ROLLUP of plain columns as the only GROUP BY expression [dialect=test]"
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scRollup, sql: `SELECT * FROM "test" GROUP BY "a", "b" WITH ROLLUP`},
+		selectTestCase{clause: scRollup, sql: `SELECT * FROM "test" GROUP BY "a", "b" WITH ROLLUP`, isPrepared: true},
+		selectTestCase{clause: scCompositeRollup, err: errMsg},
+		selectTestCase{clause: scColAndRollup, err: errMsg},
+		selectTestCase{clause: scEmptyRollup, err: errMsg},
+	)
+}
+
 func (ssgs *selectSQLGeneratorSuite) TestGenerate_withHaving() {
 	opts := sqlgen.DefaultDialectOptions()
 	opts.HavingFragment = []byte(" having ")
@@ -0,0 +1,161 @@
+package sqlgen
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/orn-id/depiq/exp"
+)
+
+type simplifier struct {
+	trueExpression  exp.Expression
+	falseExpression exp.Expression
+}
+
+// Returns an exp.Rewriter that simplifies the boolean expressions of a statement before it is rendered, it is used
+// when SQLDialectOptions.SimplifyExpressions is set or a dataset is created with Simplify(true).
+//
+//   - empty ExpressionLists are removed, e.g. And(Ex{}, Or(), a) -> a
+//   - lists with a single expression and nested lists of the same type are flattened, e.g. a AND (b AND c) -> a AND b
+//     AND c
+//   - identical predicates in a list are removed, e.g. a AND a -> a
+//   - x IN () is folded into FALSE and x NOT IN () into TRUE
+//   - TRUE and FALSE (including the literals 1=1 and 1=0) are folded into the list they are in, e.g. a AND TRUE -> a,
+//     a AND FALSE -> FALSE and a OR FALSE -> a
+//
+// Folded values are rendered as TRUE and FALSE, or as (1 = 1) and (1 = 0) if the dialect does not support a boolean
+// data type. Use exp.RewriteSelectClauses (or the equivalent function for other statements) to simplify clauses.
+func Simplifier(do *SQLDialectOptions) exp.Rewriter {
+	s := simplifier{trueExpression: TrueLiteral, falseExpression: FalseLiteral}
+	if !do.BooleanDataTypeSupported {
+		s.trueExpression = exp.NewLiteralExpression("(1 = 1)")
+		s.falseExpression = exp.NewLiteralExpression("(1 = 0)")
+	}
+	return s.simplify
+}
+
+func (s simplifier) simplify(e exp.Expression) exp.Expression {
+	switch t := e.(type) {
+	case exp.ExpressionList:
+		return s.expressionList(t)
+	case exp.BooleanExpression:
+		if !isEmptySlice(t.RHS()) {
+			return e
+		}
+		switch t.Op() {
+		case exp.InOp:
+			return s.falseExpression
+		case exp.NotInOp:
+			return s.trueExpression
+		}
+	}
+	return e
+}
+
+// the simplified list is always an ExpressionList so it can still be used as a WHERE, HAVING or join condition
+func (s simplifier) expressionList(el exp.ExpressionList) exp.ExpressionList {
+	listType := el.Type()
+	// TRUE in an AND and FALSE in an OR do not change the result of the list
+	identity := listType == exp.AndType
+	flattened := flattenExpressionList(nil, listType, el.Expressions())
+	exps := make([]exp.Expression, 0, len(flattened))
+	seen := newExpressionSet(len(flattened))
+	removedIdentity := false
+	for _, e := range flattened {
+		if val, ok := constantValue(e); ok {
+			if val == identity {
+				removedIdentity = true
+				continue
+			}
+			// FALSE in an AND and TRUE in an OR decide the result of the list
+			return exp.NewExpressionList(listType, s.constant(val))
+		}
+		if seen.add(e) {
+			exps = append(exps, e)
+		}
+	}
+	if len(exps) == 0 && removedIdentity && !identity {
+		// an OR of FALSE values is FALSE, an empty list would not be rendered
+		return exp.NewExpressionList(listType, s.falseExpression)
+	}
+	return exp.NewExpressionList(listType, exps...)
+}
+
+func (s simplifier) constant(val bool) exp.Expression {
+	if val {
+		return s.trueExpression
+	}
+	return s.falseExpression
+}
+
+// appends the expressions to exps, inlining the expressions of ExpressionLists with the same type or a single
+// expression and removing empty ExpressionLists
+func flattenExpressionList(
+	exps []exp.Expression,
+	listType exp.ExpressionListType,
+	es []exp.Expression,
+) []exp.Expression {
+	for _, e := range es {
+		el, ok := e.(exp.ExpressionList)
+		if ok && (el.Type() == listType || len(el.Expressions()) <= 1) {
+			exps = flattenExpressionList(exps, listType, el.Expressions())
+			continue
+		}
+		exps = append(exps, e)
+	}
+	return exps
+}
+
+// a set of expressions compared with exp.Equal, the key of every expression is only computed once
+type expressionSet struct {
+	keys map[string]bool
+	// expressions that can not be compared structurally, compared with reflect.DeepEqual like exp.Equal
+	others []exp.Expression
+}
+
+func newExpressionSet(size int) *expressionSet {
+	return &expressionSet{keys: make(map[string]bool, size)}
+}
+
+// adds the expression to the set, returns false if an equal expression was already added
+func (es *expressionSet) add(e exp.Expression) bool {
+	if key, ok := exp.StructureKey(e); ok {
+		if es.keys[key] {
+			return false
+		}
+		es.keys[key] = true
+		return true
+	}
+	for _, o := range es.others {
+		if reflect.DeepEqual(o, e) {
+			return false
+		}
+	}
+	es.others = append(es.others, e)
+	return true
+}
+
+// returns the value of a literal TRUE, FALSE, 1=1 or 1=0 expression
+func constantValue(e exp.Expression) (value, ok bool) {
+	le, isLiteral := e.(exp.LiteralExpression)
+	if !isLiteral || len(le.Args()) > 0 {
+		return false, false
+	}
+	literal := strings.ToUpper(strings.Join(strings.Fields(le.Literal()), ""))
+	for len(literal) > 1 && literal[0] == '(' && literal[len(literal)-1] == ')' {
+		literal = literal[1 : len(literal)-1]
+	}
+	switch literal {
+	case "TRUE", "1=1":
+		return true, true
+	case "FALSE", "1=0":
+		return false, true
+	}
+	return false, false
+}
+
+// reports whether the value is an empty slice (e.g. the values of C("a").In([]int{}))
+func isEmptySlice(val interface{}) bool {
+	v := reflect.Indirect(reflect.ValueOf(val))
+	return v.IsValid() && v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 && v.Len() == 0
+}
@@ -0,0 +1,78 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/orn-id/depiq/internal/sb"
+	"github.com/orn-id/depiq/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type simplifySuite struct {
+	baseSQLGeneratorSuite
+}
+
+func TestSimplifySuite(t *testing.T) {
+	suite.Run(t, new(simplifySuite))
+}
+
+func (ss *simplifySuite) assertSimplified(opts *sqlgen.SQLDialectOptions, e exp.Expression, expectedSQL string) {
+	b := sb.NewSQLBuilder(false)
+	sqlgen.NewExpressionSQLGenerator("test", opts).Generate(b, exp.Rewrite(e, sqlgen.Simplifier(opts)))
+	ss.assertNotPreparedSQL(b, expectedSQL)
+}
+
+func (ss *simplifySuite) TestSimplifier() {
+	opts := sqlgen.DefaultDialectOptions()
+	a := exp.NewIdentifierExpression("", "", "a").Eq(1)
+	b := exp.NewIdentifierExpression("", "", "b").Gt(2)
+	and := func(exps ...exp.Expression) exp.ExpressionList { return exp.NewExpressionList(exp.AndType, exps...) }
+	or := func(exps ...exp.Expression) exp.ExpressionList { return exp.NewExpressionList(exp.OrType, exps...) }
+	in := exp.NewIdentifierExpression("", "", "c").In([]int{})
+	notIn := exp.NewIdentifierExpression("", "", "c").NotIn([]string{})
+
+	ss.assertSimplified(opts, and(exp.Ex{}, or(), a), `("a" = 1)`)
+	ss.assertSimplified(opts, and(a, and(b, and(a))), `(("a" = 1) AND ("b" > 2))`)
+	ss.assertSimplified(opts, or(a, and(b)), `(("a" = 1) OR ("b" > 2))`)
+	ss.assertSimplified(opts, or(a, and(a, b)), `(("a" = 1) OR (("a" = 1) AND ("b" > 2)))`)
+	ss.assertSimplified(opts, and(a, a, exp.Ex{"a": 1}), `("a" = 1)`)
+	ss.assertSimplified(opts, and(a, exp.NewLiteralExpression("1 = 1")), `("a" = 1)`)
+	ss.assertSimplified(opts, and(a, exp.NewLiteralExpression("(1=0)")), `FALSE`)
+	ss.assertSimplified(opts, or(a, sqlgen.TrueLiteral), `TRUE`)
+	ss.assertSimplified(opts, or(a, sqlgen.FalseLiteral), `("a" = 1)`)
+	ss.assertSimplified(opts, or(sqlgen.FalseLiteral), `FALSE`)
+	ss.assertSimplified(opts, and(sqlgen.TrueLiteral), ``)
+	ss.assertSimplified(opts, and(a, exp.NewLiteralExpression("1 = ?", 1)), `(("a" = 1) AND 1 = 1)`)
+	ss.assertSimplified(opts, in, `FALSE`)
+	ss.assertSimplified(opts, notIn, `TRUE`)
+	ss.assertSimplified(opts, and(a, in), `FALSE`)
+	ss.assertSimplified(opts, or(a, in), `("a" = 1)`)
+	ss.assertSimplified(opts, and(a, notIn), `("a" = 1)`)
+	ss.assertSimplified(opts, and(a, or(in, notIn)), `("a" = 1)`)
+
+	// expressions that cannot be compared structurally are compared with reflect.DeepEqual
+	dist := func(to int) exp.Expression {
+		distance := stDistanceExpression{from: exp.NewIdentifierExpression("", "", "p"), to: to}
+		return exp.NewIdentifierExpression("", "", "loc").Lt(distance)
+	}
+	ss.assertSimplified(opts, and(dist(1), a, dist(1), dist(2), a),
+		`(("loc" < ST_Distance("p", 1)) AND ("a" = 1) AND ("loc" < ST_Distance("p", 2)))`)
+
+	opts.BooleanDataTypeSupported = false
+	ss.assertSimplified(opts, and(a, in), `(1 = 0)`)
+	ss.assertSimplified(opts, or(a, notIn), `(1 = 1)`)
+}
+
+func (ss *simplifySuite) TestSimplifier_clauses() {
+	opts := sqlgen.DefaultDialectOptions()
+	a := exp.NewIdentifierExpression("", "", "a").Eq(1)
+	c := exp.NewSelectClauses().
+		SetFrom(exp.NewColumnListExpression("test")).
+		WhereAppend(exp.NewExpressionList(exp.AndType, a, a), exp.NewExpressionList(exp.OrType)).
+		HavingAppend(exp.NewIdentifierExpression("", "", "b").In([]int{}))
+
+	b := sb.NewSQLBuilder(false)
+	sqlgen.NewSelectSQLGenerator("test", opts).Generate(b, exp.RewriteSelectClauses(c, sqlgen.Simplifier(opts)))
+	ss.assertNotPreparedSQL(b, `SELECT * FROM "test" WHERE ("a" = 1) HAVING FALSE`)
+}
@@ -9,6 +9,9 @@ import (
 
 type (
 	SQLFragmentType   int
+	TextSearchStyle   int
+	DateTimeStyle     int
+	PositionStyle     int
 	SQLDialectOptions struct {
 		// Set to true if the dialect supports ORDER BY expressions in DELETE statements (DEFAULT=false)
 		SupportsOrderByOnDelete bool
@@ -43,6 +46,72 @@ type (
 
 		// Set to true if window function are supported in SELECT statement. (DEFAULT=true)
 		SupportsWindowFunction bool
+		// Set to true if aggregates support a FILTER (WHERE ...) clause. When false FILTER clauses on COUNT, SUM,
+		// AVG, MIN and MAX are rewritten as CASE expressions, any other aggregate results in an error (DEFAULT=true)
+		SupportsAggregateFilter bool
+		// Set to true if an ORDER BY is supported inside aggregate function calls (DEFAULT=true)
+		SupportsAggregateOrderBy bool
+		// Set to true if WITHIN GROUP (ORDER BY ...) is supported for ordered-set aggregates (DEFAULT=true)
+		SupportsWithinGroup bool
+		// Set to true if row values can be compared (e.g. ("a", "b") > (1, 2)). When false comparisons are expanded
+		// into the equivalent AND/OR chain (DEFAULT=true)
+		SupportsRowValueComparison bool
+		// Set to true if row values can be used with an IN list (e.g. ("a", "b") IN ((1, 2), (1, 3))). When false
+		// the list is expanded into the equivalent AND/OR chain, subqueries are still used as is if
+		// SupportsRowValueComparison is true (DEFAULT=true)
+		SupportsRowValueInList bool
+		// Set to true if JSON paths are rendered as a single JSONPath string (e.g. '$.a.b[0]'), when false each path
+		// element is rendered separately (e.g. "data"->'a'->'b'->0) (DEFAULT=false)
+		UseJSONPathString bool
+		// Set to true if the JSONHasKeyOp function returns NULL for missing keys and is rendered as
+		// (json_type("data", '$.a') IS NOT NULL), when false the function returns a boolean and is rendered as is
+		// (e.g. jsonb_exists("data", 'a')) (DEFAULT=false)
+		JSONHasKeyIsNotNull bool
+		// Set to true if the dialect supports arrays (e.g. ARRAY[1, 2]). Go slices used with the array operators or
+		// ANY/ALL are rendered as arrays (DEFAULT=true)
+		SupportsArrays bool
+		// Set to true to bind go slices as a single array parameter in prepared statements, IN lists are rendered
+		// as = ANY(?), NOT IN lists as != ALL(?) and arrays as ?. Slices containing expressions are rendered as usual.
+		// Requires SupportsArrays and a driver that accepts slices, see ArrayValuer (DEFAULT=false)
+		BindSliceAsArray bool
+		// Set to true to simplify the boolean expressions of SELECT, UPDATE and DELETE statements before they are
+		// rendered, e.g. empty expression lists are removed and x IN () is folded into FALSE. See Simplifier
+		// (DEFAULT=false)
+		SimplifyExpressions bool
+		// The syntax used to render full-text search expressions, NoTextSearchStyle reports full-text search as
+		// unsupported (DEFAULT=TSVectorTextSearchStyle)
+		//   TSVectorTextSearchStyle: (to_tsvector('english', "body") @@ websearch_to_tsquery('english', 'cats'))
+		//   MatchAgainstTextSearchStyle: MATCH (`title`, `body`) AGAINST ('cats' IN NATURAL LANGUAGE MODE)
+		//   FTS5TextSearchStyle: ("docs" MATCH 'cats')
+		//   ContainsTextSearchStyle: FREETEXT(("title", "body"), 'cats', LANGUAGE 'english')
+		TextSearchStyle TextSearchStyle
+		// The syntax used to render date and time expressions (DEFAULT=IntervalDateTimeStyle)
+		//   IntervalDateTimeStyle: ("ts" - INTERVAL '7 days'), EXTRACT(YEAR FROM "ts"), date_trunc('month', "ts")
+		//   DateAddDateTimeStyle: DATE_SUB(`ts`, INTERVAL 7 DAY), EXTRACT(YEAR FROM `ts`),
+		//     CAST(DATE_FORMAT(`ts`, '%Y-%m-01 00:00:00') AS DATETIME)
+		//   DatePartDateTimeStyle: DATEADD(day, -7, "ts"), DATEPART(year, "ts"), DATETRUNC(month, "ts")
+		//   ModifierDateTimeStyle: datetime(`ts`, '-7 days'), CAST(strftime('%Y', `ts`) AS INTEGER),
+		//     strftime('%Y-%m-01 00:00:00', `ts`)
+		DateTimeStyle DateTimeStyle
+		// The argument shape used to render PositionOp string expressions (DEFAULT=InPositionStyle)
+		//   InPositionStyle: POSITION('a' IN "name")
+		//   StringFirstPositionStyle: INSTR(`name`, 'a')
+		//   SubstringFirstPositionStyle: CHARINDEX('a', "name")
+		PositionStyle PositionStyle
+		// The fragment used between the substring and the string with the InPositionStyle
+		// (DEFAULT=[]byte(" IN "))
+		PositionInFragment []byte
+		// Set to true if the dialect requires a length when taking a substring, when no length is given the LengthOp
+		// function of the string is used (e.g. SUBSTRING("name", 2, LEN("name"))) (DEFAULT=false)
+		SubstringRequiresLength bool
+		// Set to false if the dialect does not allow quoted collation names (e.g. "name" COLLATE Latin1_General_CS_AS),
+		// unquoted collation names may only contain letters, digits and _ (DEFAULT=true)
+		QuoteCollations bool
+		// The fragment used before the collation name of a CollateExpression (DEFAULT=[]byte(" COLLATE "))
+		CollateFragment []byte
+		// Used to wrap slices bound as an array parameter when BindSliceAsArray is true (e.g. pq.Array), when nil
+		// the slice is passed to the driver as is (DEFAULT=nil)
+		ArrayValuer func(slice interface{}) interface{}
 
 		// Set to true if the dialect requires join tables in UPDATE to be in a FROM clause (DEFAULT=true).
 		UseFromClauseForMultipleUpdateTables bool
@@ -105,6 +174,27 @@ type (
 		WindowOrderByFragment []byte
 		// The SQL WINDOW clause OVER fragment(DEFAULT=[]byte(" OVER "))
 		WindowOverFragment []byte
+		// The SQL window frame BETWEEN fragment(DEFAULT=[]byte(" BETWEEN "))
+		WindowFrameBetweenFragment []byte
+		// The SQL window frame AND fragment(DEFAULT=[]byte(" AND "))
+		WindowFrameAndFragment []byte
+		// The SQL EXISTS fragment(DEFAULT=[]byte("EXISTS "))
+		ExistsFragment []byte
+		// The SQL NOT EXISTS fragment(DEFAULT=[]byte("NOT EXISTS "))
+		NotExistsFragment []byte
+		// The SQL NOT fragment used to negate a NULL safe comparison(DEFAULT=[]byte("NOT "))
+		NotFragment []byte
+		// The SQL ARRAY constructor fragment(DEFAULT=[]byte("ARRAY["))
+		ArrayFragment []byte
+		// The SQL fragment used to close an ARRAY constructor(DEFAULT=[]byte("]"))
+		ArrayEndFragment []byte
+		// The SQL WITH ROLLUP fragment used by dialects that do not support ROLLUP(...) (DEFAULT=nil).
+		// When set a ROLLUP is rendered as GROUP BY "a", "b" WITH ROLLUP and must be the only GROUP BY expression
+		WithRollupFragment []byte
+		// The SQL aggregate FILTER fragment(DEFAULT=[]byte(" FILTER (WHERE "))
+		AggregateFilterFragment []byte
+		// The SQL ordered-set aggregate WITHIN GROUP fragment(DEFAULT=[]byte(" WITHIN GROUP (ORDER BY "))
+		WithinGroupFragment []byte
 		// The SQL ORDER BY clause fragment(DEFAULT=[]byte(" ORDER BY "))
 		OrderByFragment []byte
 		// The SQL FETCH fragment(DEFAULT=[]byte(" "))
@@ -159,6 +249,12 @@ type (
 		IntersectFragment []byte
 		// The INTERSECT ALL keyword used when creating compound statements (DEFAULT=[]byte(" INTERSECT ALL "))
 		IntersectAllFragment []byte
+		// The EXCEPT keyword used when creating compound statements (DEFAULT=[]byte(" EXCEPT ")).
+		// Set to []byte(" MINUS ") for dialects that use MINUS, or to nil if EXCEPT is not supported.
+		ExceptFragment []byte
+		// The EXCEPT ALL keyword used when creating compound statements (DEFAULT=[]byte(" EXCEPT ALL ")).
+		// Set to nil if EXCEPT ALL is not supported.
+		ExceptAllFragment []byte
 		// The CAST keyword to use when casting a value (DEFAULT=[]byte("CAST"))
 		CastFragment []byte
 		// The CASE keyword to use when when creating a CASE statement (DEFAULT=[]byte("CASE "))
@@ -215,7 +311,14 @@ type (
 		// 		exp.RegexpNotLikeOp:  []byte("!~"),
 		// 		exp.RegexpILikeOp:    []byte("~*"),
 		// 		exp.RegexpNotILikeOp: []byte("!~*"),
+		// 		exp.IsDistinctFromOp:    []byte("IS DISTINCT FROM"),
+		// 		exp.IsNotDistinctFromOp: []byte("IS NOT DISTINCT FROM"),
+		// 		exp.ArrayContainsOp:     []byte("@>"),
+		// 		exp.ArrayContainedByOp:  []byte("<@"),
+		// 		exp.ArrayOverlapsOp:     []byte("&&"),
 		// })
+		// When IsDistinctFromOp or IsNotDistinctFromOp is missing, NOT of the other operator is used if present,
+		// otherwise the comparison is expanded into an equivalent NULL safe AND/OR chain
 		BooleanOperatorLookup map[exp.BooleanOperation][]byte
 		// A map used to look up BitwiseOperations and their SQL equivalents
 		// (Default=map[exp.BitwiseOperation][]byte{
@@ -227,6 +330,204 @@ type (
 		// 		exp.BitwiseRightShiftOp: []byte(">>"),
 		// }),
 		BitwiseOperatorLookup map[exp.BitwiseOperation][]byte
+		// A map used to look up ArithmeticOperations and their SQL equivalents
+		// (Default=map[exp.ArithmeticOperation][]byte{
+		// 		exp.AddOp: []byte("+"),
+		// 		exp.SubOp: []byte("-"),
+		// 		exp.MulOp: []byte("*"),
+		// 		exp.DivOp: []byte("/"),
+		// 		exp.ModOp: []byte("%"),
+		// 		exp.NegOp: []byte("-"),
+		// }),
+		ArithmeticOperatorLookup map[exp.ArithmeticOperation][]byte
+		// A map used to look up the window frame units supported by the dialect and their SQL equivalents.
+		// Frame units not in the map are reported as unsupported
+		// (Default=map[exp.WindowFrameUnit][]byte{
+		// 		exp.RowsFrameUnit:   []byte("ROWS"),
+		// 		exp.RangeFrameUnit:  []byte("RANGE"),
+		// 		exp.GroupsFrameUnit: []byte("GROUPS"),
+		// }),
+		WindowFrameUnitLookup map[exp.WindowFrameUnit][]byte
+		// A map used to look up window frame bounds and their SQL equivalents. The offset of n PRECEDING
+		// and n FOLLOWING bounds is written before the fragment
+		// (Default=map[exp.WindowFrameBoundType][]byte{
+		// 		exp.UnboundedPrecedingFrameBound: []byte("UNBOUNDED PRECEDING"),
+		// 		exp.PrecedingFrameBound:          []byte(" PRECEDING"),
+		// 		exp.CurrentRowFrameBound:         []byte("CURRENT ROW"),
+		// 		exp.FollowingFrameBound:          []byte(" FOLLOWING"),
+		// 		exp.UnboundedFollowingFrameBound: []byte("UNBOUNDED FOLLOWING"),
+		// }),
+		WindowFrameBoundLookup map[exp.WindowFrameBoundType][]byte
+		// A map used to look up the window frame EXCLUDE options supported by the dialect and their SQL
+		// equivalents. Exclusions not in the map are reported as unsupported
+		// (Default=map[exp.WindowFrameExclusion][]byte{
+		// 		exp.CurrentRowFrameExclusion: []byte(" EXCLUDE CURRENT ROW"),
+		// 		exp.GroupFrameExclusion:      []byte(" EXCLUDE GROUP"),
+		// 		exp.TiesFrameExclusion:       []byte(" EXCLUDE TIES"),
+		// 		exp.NoOthersFrameExclusion:   []byte(" EXCLUDE NO OTHERS"),
+		// }),
+		WindowFrameExclusionLookup map[exp.WindowFrameExclusion][]byte
+		// A map used to look up the GROUP BY grouping constructs supported by the dialect and their SQL
+		// equivalents. Grouping types not in the map are reported as unsupported
+		// (Default=map[exp.GroupingType][]byte{
+		// 		exp.RollupGroupingType:       []byte("ROLLUP"),
+		// 		exp.CubeGroupingType:         []byte("CUBE"),
+		// 		exp.GroupingSetsGroupingType: []byte("GROUPING SETS"),
+		// }),
+		GroupingTypeLookup map[exp.GroupingType][]byte
+		// A map used to look up the quantifiers supported by the dialect for quantified comparisons and their SQL
+		// equivalents. Quantifiers not in the map are reported as unsupported
+		// (Default=map[exp.QuantifierType][]byte{
+		// 		exp.AnyQuantifierType: []byte("ANY"),
+		// 		exp.AllQuantifierType: []byte("ALL"),
+		// }),
+		QuantifierLookup map[exp.QuantifierType][]byte
+		// A map used to look up the full-text search modes supported by the dialect. Depending on the
+		// TextSearchStyle the value is the query function (TSVectorTextSearchStyle), the search modifier
+		// (MatchAgainstTextSearchStyle), the operator (FTS5TextSearchStyle) or the predicate (ContainsTextSearchStyle).
+		// Modes not in the map are reported as unsupported
+		// (Default=map[exp.TextSearchMode][]byte{
+		// 		exp.NaturalLanguageTextSearchMode: []byte("websearch_to_tsquery"),
+		// 		exp.BooleanTextSearchMode:         []byte("to_tsquery"),
+		// }),
+		TextSearchModeLookup map[exp.TextSearchMode][]byte
+		// A map used to look up the interval units supported by the dialect and their SQL equivalents. Week and
+		// quarter intervals are converted into days and months if they are not in the map, other units not in the map
+		// are reported as unsupported
+		// (Default=map[exp.DateTimeUnit][]byte{
+		// 		exp.MicrosecondUnit: []byte("microseconds"),
+		// 		exp.MillisecondUnit: []byte("milliseconds"),
+		// 		exp.SecondUnit:      []byte("seconds"),
+		// 		exp.MinuteUnit:      []byte("minutes"),
+		// 		exp.HourUnit:        []byte("hours"),
+		// 		exp.DayUnit:         []byte("days"),
+		// 		exp.WeekUnit:        []byte("weeks"),
+		// 		exp.MonthUnit:       []byte("months"),
+		// 		exp.YearUnit:        []byte("years"),
+		// }),
+		IntervalUnitLookup map[exp.DateTimeUnit][]byte
+		// A map used to look up the fields that can be extracted from a date or time, with the
+		// ModifierDateTimeStyle the value is a strftime format. Fields not in the map are reported as unsupported
+		// (Default=map[exp.DateTimeUnit][]byte{
+		// 		exp.MicrosecondUnit: []byte("MICROSECONDS"),
+		// 		exp.MillisecondUnit: []byte("MILLISECONDS"),
+		// 		exp.SecondUnit:      []byte("SECOND"),
+		// 		exp.MinuteUnit:      []byte("MINUTE"),
+		// 		exp.HourUnit:        []byte("HOUR"),
+		// 		exp.DayUnit:         []byte("DAY"),
+		// 		exp.WeekUnit:        []byte("WEEK"),
+		// 		exp.MonthUnit:       []byte("MONTH"),
+		// 		exp.QuarterUnit:     []byte("QUARTER"),
+		// 		exp.YearUnit:        []byte("YEAR"),
+		// 		exp.DayOfWeekUnit:   []byte("DOW"),
+		// 		exp.DayOfYearUnit:   []byte("DOY"),
+		// 		exp.EpochUnit:       []byte("EPOCH"),
+		// }),
+		ExtractFieldLookup map[exp.DateTimeUnit][]byte
+		// A map used to look up the units a date or time can be truncated to, with the DateAddDateTimeStyle and
+		// ModifierDateTimeStyle the value is the format used to truncate. Units not in the map are reported as
+		// unsupported
+		// (Default=map[exp.DateTimeUnit][]byte{
+		// 		exp.MicrosecondUnit: []byte("microseconds"),
+		// 		exp.MillisecondUnit: []byte("milliseconds"),
+		// 		exp.SecondUnit:      []byte("second"),
+		// 		exp.MinuteUnit:      []byte("minute"),
+		// 		exp.HourUnit:        []byte("hour"),
+		// 		exp.DayUnit:         []byte("day"),
+		// 		exp.WeekUnit:        []byte("week"),
+		// 		exp.MonthUnit:       []byte("month"),
+		// 		exp.QuarterUnit:     []byte("quarter"),
+		// 		exp.YearUnit:        []byte("year"),
+		// }),
+		DateTruncLookup map[exp.DateTimeUnit][]byte
+		// A map used to look up the current date and time functions and their SQL equivalents
+		// (Default=map[exp.CurrentTimeType][]byte{
+		// 		exp.NowCurrentTimeType:       []byte("NOW()"),
+		// 		exp.TimestampCurrentTimeType: []byte("CURRENT_TIMESTAMP"),
+		// 		exp.DateCurrentTimeType:      []byte("CURRENT_DATE"),
+		// 		exp.TimeCurrentTimeType:      []byte("CURRENT_TIME"),
+		// }),
+		CurrentTimeLookup map[exp.CurrentTimeType][]byte
+		// The INTERVAL fragment used by the IntervalDateTimeStyle and DateAddDateTimeStyle
+		// (DEFAULT=[]byte("INTERVAL "))
+		IntervalFragment []byte
+		// The function used to extract a field from a date or time, the arguments depend on the DateTimeStyle (e.g.
+		// EXTRACT(YEAR FROM "ts"), DATEPART(year, "ts") or strftime('%Y', "ts")) (DEFAULT=[]byte("EXTRACT"))
+		ExtractFunction []byte
+		// The type the result of the ExtractFunction is cast to, when empty the result is not cast
+		// (e.g. CAST(strftime('%Y', `ts`) AS INTEGER)) (DEFAULT=nil)
+		ExtractCastType []byte
+		// The function used to truncate a date or time, the arguments depend on the DateTimeStyle (e.g.
+		// date_trunc('month', "ts"), DATE_FORMAT(`ts`, '%Y-%m-01 00:00:00'), DATETRUNC(month, "ts") or
+		// strftime('%Y-%m-01 00:00:00', `ts`)) (DEFAULT=[]byte("date_trunc"))
+		DateTruncFunction []byte
+		// The type the result of the DateTruncFunction is cast to, when empty the result is not cast
+		// (e.g. CAST(DATE_FORMAT(`ts`, '%Y-%m-01 00:00:00') AS DATETIME)) (DEFAULT=nil)
+		DateTruncCastType []byte
+		// The function used to add an interval to a date or time, the IntervalDateTimeStyle uses the + operator
+		// instead. With the DatePartDateTimeStyle and ModifierDateTimeStyle it is also used to subtract a negated
+		// interval. When empty date arithmetic is reported as unsupported (e.g. DATE_ADD, DATEADD or datetime)
+		// (DEFAULT=nil)
+		DateAddFunction []byte
+		// The function used to subtract an interval from a date or time with the DateAddDateTimeStyle, when empty
+		// date subtraction is reported as unsupported (e.g. DATE_SUB) (DEFAULT=nil)
+		DateSubFunction []byte
+		// The operator used to concatenate strings (e.g. ("first" || "last")), when empty the ConcatOp function from
+		// the StringFunctionLookup is used (e.g. CONCAT(`first`, `last`)) (DEFAULT=[]byte("||"))
+		ConcatOperator []byte
+		// A map used to look up the function names of StringOperations. Operations not in the map are reported as
+		// unsupported
+		// (Default=map[exp.StringOperation][]byte{
+		// 		exp.ConcatOp:    []byte("CONCAT"),
+		// 		exp.LowerOp:     []byte("LOWER"),
+		// 		exp.UpperOp:     []byte("UPPER"),
+		// 		exp.TrimOp:      []byte("TRIM"),
+		// 		exp.SubstringOp: []byte("SUBSTRING"),
+		// 		exp.LengthOp:    []byte("LENGTH"),
+		// 		exp.PositionOp:  []byte("POSITION"),
+		// 		exp.ReplaceOp:   []byte("REPLACE"),
+		// }),
+		StringFunctionLookup map[exp.StringOperation][]byte
+		// The function used to rank full-text search matches, the MatchAgainstTextSearchStyle uses the MATCH
+		// expression as the rank. When empty ranking is reported as unsupported (DEFAULT=[]byte("ts_rank"))
+		TextSearchRankFunction []byte
+		// The function used to convert a document to a tsvector with the TSVectorTextSearchStyle
+		// (DEFAULT=[]byte("to_tsvector"))
+		TSVectorFunction []byte
+		// The operator used to concatenate multiple tsvector documents with the TSVectorTextSearchStyle
+		// (DEFAULT=[]byte(" || "))
+		TSVectorConcatFragment []byte
+		// The operator used to match a tsvector against a query with the TSVectorTextSearchStyle
+		// (DEFAULT=[]byte(" @@ "))
+		TSMatchFragment []byte
+		// The MATCH fragment used by the MatchAgainstTextSearchStyle, when empty full-text search is reported as
+		// unsupported (DEFAULT=nil)
+		TextSearchMatchFragment []byte
+		// The AGAINST fragment used by the MatchAgainstTextSearchStyle, when empty full-text search is reported as
+		// unsupported (DEFAULT=nil)
+		TextSearchAgainstFragment []byte
+		// The fragment used to pass the configuration as a language with the ContainsTextSearchStyle, when empty
+		// configurations are reported as unsupported (DEFAULT=nil)
+		TextSearchLanguageFragment []byte
+		// A map used to look up JSONOperations rendered as infix operators (e.g. "data" @> '{"a":1}'). When a
+		// JSONExtractTextOp path has more than one element and UseJSONPathString is false all but the last element
+		// use the JSONExtractOp operator (e.g. "data"->'a'->>'b')
+		// (Default=map[exp.JSONOperation][]byte{
+		// 		exp.JSONExtractOp:     []byte("->"),
+		// 		exp.JSONExtractTextOp: []byte("->>"),
+		// 		exp.JSONContainsOp:    []byte("@>"),
+		// }),
+		JSONOperatorLookup map[exp.JSONOperation][]byte
+		// A map used to look up JSONOperations rendered as function calls, used when the operation is not in the
+		// JSONOperatorLookup (e.g. JSON_EXTRACT("data", '$.a')). Without UseJSONPathString each path element is
+		// passed as a separate argument, see JSONHasKeyIsNotNull for how a JSONHasKeyOp function is rendered.
+		// jsonb_exists is used instead of the ? operator which would be rewritten as a placeholder by drivers.
+		// Operations in neither map are reported as unsupported
+		// (Default=map[exp.JSONOperation][]byte{
+		// 		exp.JSONHasKeyOp:      []byte("jsonb_exists"),
+		// 		exp.JSONArrayLengthOp: []byte("jsonb_array_length"),
+		// }),
+		JSONFunctionLookup map[exp.JSONOperation][]byte
 		// A map used to look up RangeOperations and their SQL equivalents
 		// (Default=map[exp.RangeOperation][]byte{
 		// 		exp.BetweenOp:    []byte("BETWEEN"),
@@ -355,6 +656,39 @@ const (
 	WindowSQLFragment
 )
 
+const (
+	// Full-text search is not supported
+	NoTextSearchStyle TextSearchStyle = iota
+	// (to_tsvector(...) @@ websearch_to_tsquery(...))
+	TSVectorTextSearchStyle
+	// MATCH (...) AGAINST (... IN NATURAL LANGUAGE MODE)
+	MatchAgainstTextSearchStyle
+	// ("table" MATCH ...) against an FTS5 virtual table
+	FTS5TextSearchStyle
+	// CONTAINS(...) and FREETEXT(...)
+	ContainsTextSearchStyle
+)
+
+const (
+	// ("ts" + INTERVAL '7 days'), EXTRACT(YEAR FROM "ts") and date_trunc('month', "ts")
+	IntervalDateTimeStyle DateTimeStyle = iota
+	// DATE_ADD("ts", INTERVAL 7 DAY), EXTRACT(YEAR FROM "ts") and DATE_FORMAT("ts", ...)
+	DateAddDateTimeStyle
+	// DATEADD(day, 7, "ts"), DATEPART(year, "ts") and DATETRUNC(month, "ts")
+	DatePartDateTimeStyle
+	// datetime("ts", '+7 days') and strftime(..., "ts")
+	ModifierDateTimeStyle
+)
+
+const (
+	// POSITION('a' IN "name")
+	InPositionStyle PositionStyle = iota
+	// INSTR("name", 'a')
+	StringFirstPositionStyle
+	// CHARINDEX('a', "name")
+	SubstringFirstPositionStyle
+)
+
 // nolint:gocyclo // simple type to string conversion
 func (sf SQLFragmentType) String() string {
 	switch sf {
@@ -423,72 +757,100 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		SupportsDistinctOn:          true,
 		WrapCompoundsInParens:       true,
 		SupportsWindowFunction:      true,
+		SupportsAggregateFilter:     true,
+		SupportsAggregateOrderBy:    true,
+		SupportsWithinGroup:         true,
+		SupportsRowValueComparison:  true,
+		SupportsRowValueInList:      true,
+		UseJSONPathString:           false,
+		JSONHasKeyIsNotNull:         false,
+		SupportsArrays:              true,
+		BindSliceAsArray:            false,
+		SimplifyExpressions:         false,
+		TextSearchStyle:             TSVectorTextSearchStyle,
+		DateTimeStyle:               IntervalDateTimeStyle,
+		PositionStyle:               InPositionStyle,
+		PositionInFragment:          []byte(" IN "),
+		SubstringRequiresLength:     false,
+		QuoteCollations:             true,
+		CollateFragment:             []byte(" COLLATE "),
 		SupportsLateral:             true,
 
 		SupportsMultipleUpdateTables:         true,
 		UseFromClauseForMultipleUpdateTables: true,
 
-		UpdateClause:              []byte("UPDATE"),
-		InsertClause:              []byte("INSERT INTO"),
-		InsertIgnoreClause:        []byte("INSERT IGNORE INTO"),
-		SelectClause:              []byte("SELECT"),
-		DeleteClause:              []byte("DELETE"),
-		TruncateClause:            []byte("TRUNCATE"),
-		WithFragment:              []byte("WITH "),
-		RecursiveFragment:         []byte("RECURSIVE "),
-		CascadeFragment:           []byte(" CASCADE"),
-		RestrictFragment:          []byte(" RESTRICT"),
-		DefaultValuesFragment:     []byte(" DEFAULT VALUES"),
-		ValuesFragment:            []byte(" VALUES "),
-		IdentityFragment:          []byte(" IDENTITY"),
-		SetFragment:               []byte(" SET "),
-		DistinctFragment:          []byte("DISTINCT"),
-		ReturningFragment:         []byte(" RETURNING "),
-		FromFragment:              []byte(" FROM"),
-		UsingFragment:             []byte(" USING "),
-		OnFragment:                []byte(" ON "),
-		WhereFragment:             []byte(" WHERE "),
-		GroupByFragment:           []byte(" GROUP BY "),
-		HavingFragment:            []byte(" HAVING "),
-		WindowFragment:            []byte(" WINDOW "),
-		WindowPartitionByFragment: []byte("PARTITION BY "),
-		WindowOrderByFragment:     []byte("ORDER BY "),
-		WindowOverFragment:        []byte(" OVER "),
-		OrderByFragment:           []byte(" ORDER BY "),
-		FetchFragment:             []byte(" "),
-		LimitFragment:             []byte(" LIMIT "),
-		OffsetFragment:            []byte(" OFFSET "),
-		ForUpdateFragment:         []byte(" FOR UPDATE "),
-		ForNoKeyUpdateFragment:    []byte(" FOR NO KEY UPDATE "),
-		ForShareFragment:          []byte(" FOR SHARE "),
-		ForKeyShareFragment:       []byte(" FOR KEY SHARE "),
-		OfFragment:                []byte("OF "),
-		NowaitFragment:            []byte("NOWAIT"),
-		SkipLockedFragment:        []byte("SKIP LOCKED"),
-		LateralFragment:           []byte("LATERAL "),
-		AsFragment:                []byte(" AS "),
-		AscFragment:               []byte(" ASC"),
-		DescFragment:              []byte(" DESC"),
-		NullsFirstFragment:        []byte(" NULLS FIRST"),
-		NullsLastFragment:         []byte(" NULLS LAST"),
-		AndFragment:               []byte(" AND "),
-		OrFragment:                []byte(" OR "),
-		UnionFragment:             []byte(" UNION "),
-		UnionAllFragment:          []byte(" UNION ALL "),
-		IntersectFragment:         []byte(" INTERSECT "),
-		IntersectAllFragment:      []byte(" INTERSECT ALL "),
-		ConflictFragment:          []byte(" ON CONFLICT"),
-		ConflictDoUpdateFragment:  []byte(" DO UPDATE SET "),
-		ConflictDoNothingFragment: []byte(" DO NOTHING"),
-		CastFragment:              []byte("CAST"),
-		CaseFragment:              []byte("CASE "),
-		WhenFragment:              []byte(" WHEN "),
-		ThenFragment:              []byte(" THEN "),
-		ElseFragment:              []byte(" ELSE "),
-		EndFragment:               []byte(" END"),
-		Null:                      []byte("NULL"),
-		True:                      []byte("TRUE"),
-		False:                     []byte("FALSE"),
+		UpdateClause:               []byte("UPDATE"),
+		InsertClause:               []byte("INSERT INTO"),
+		InsertIgnoreClause:         []byte("INSERT IGNORE INTO"),
+		SelectClause:               []byte("SELECT"),
+		DeleteClause:               []byte("DELETE"),
+		TruncateClause:             []byte("TRUNCATE"),
+		WithFragment:               []byte("WITH "),
+		RecursiveFragment:          []byte("RECURSIVE "),
+		CascadeFragment:            []byte(" CASCADE"),
+		RestrictFragment:           []byte(" RESTRICT"),
+		DefaultValuesFragment:      []byte(" DEFAULT VALUES"),
+		ValuesFragment:             []byte(" VALUES "),
+		IdentityFragment:           []byte(" IDENTITY"),
+		SetFragment:                []byte(" SET "),
+		DistinctFragment:           []byte("DISTINCT"),
+		ReturningFragment:          []byte(" RETURNING "),
+		FromFragment:               []byte(" FROM"),
+		UsingFragment:              []byte(" USING "),
+		OnFragment:                 []byte(" ON "),
+		WhereFragment:              []byte(" WHERE "),
+		GroupByFragment:            []byte(" GROUP BY "),
+		HavingFragment:             []byte(" HAVING "),
+		WindowFragment:             []byte(" WINDOW "),
+		WindowPartitionByFragment:  []byte("PARTITION BY "),
+		WindowOrderByFragment:      []byte("ORDER BY "),
+		WindowOverFragment:         []byte(" OVER "),
+		WindowFrameBetweenFragment: []byte(" BETWEEN "),
+		WindowFrameAndFragment:     []byte(" AND "),
+		ExistsFragment:             []byte("EXISTS "),
+		NotExistsFragment:          []byte("NOT EXISTS "),
+		NotFragment:                []byte("NOT "),
+		ArrayFragment:              []byte("ARRAY["),
+		ArrayEndFragment:           []byte("]"),
+		AggregateFilterFragment:    []byte(" FILTER (WHERE "),
+		WithinGroupFragment:        []byte(" WITHIN GROUP (ORDER BY "),
+		OrderByFragment:            []byte(" ORDER BY "),
+		FetchFragment:              []byte(" "),
+		LimitFragment:              []byte(" LIMIT "),
+		OffsetFragment:             []byte(" OFFSET "),
+		ForUpdateFragment:          []byte(" FOR UPDATE "),
+		ForNoKeyUpdateFragment:     []byte(" FOR NO KEY UPDATE "),
+		ForShareFragment:           []byte(" FOR SHARE "),
+		ForKeyShareFragment:        []byte(" FOR KEY SHARE "),
+		OfFragment:                 []byte("OF "),
+		NowaitFragment:             []byte("NOWAIT"),
+		SkipLockedFragment:         []byte("SKIP LOCKED"),
+		LateralFragment:            []byte("LATERAL "),
+		AsFragment:                 []byte(" AS "),
+		AscFragment:                []byte(" ASC"),
+		DescFragment:               []byte(" DESC"),
+		NullsFirstFragment:         []byte(" NULLS FIRST"),
+		NullsLastFragment:          []byte(" NULLS LAST"),
+		AndFragment:                []byte(" AND "),
+		OrFragment:                 []byte(" OR "),
+		UnionFragment:              []byte(" UNION "),
+		UnionAllFragment:           []byte(" UNION ALL "),
+		IntersectFragment:          []byte(" INTERSECT "),
+		IntersectAllFragment:       []byte(" INTERSECT ALL "),
+		ExceptFragment:             []byte(" EXCEPT "),
+		ExceptAllFragment:          []byte(" EXCEPT ALL "),
+		ConflictFragment:           []byte(" ON CONFLICT"),
+		ConflictDoUpdateFragment:   []byte(" DO UPDATE SET "),
+		ConflictDoNothingFragment:  []byte(" DO NOTHING"),
+		CastFragment:               []byte("CAST"),
+		CaseFragment:               []byte("CASE "),
+		WhenFragment:               []byte(" WHEN "),
+		ThenFragment:               []byte(" THEN "),
+		ElseFragment:               []byte(" ELSE "),
+		EndFragment:                []byte(" END"),
+		Null:                       []byte("NULL"),
+		True:                       []byte("TRUE"),
+		False:                      []byte("FALSE"),
 
 		PlaceHolderFragment: []byte("?"),
 		QuoteRune:           '"',
@@ -503,24 +865,29 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		EmptyString:         "",
 
 		BooleanOperatorLookup: map[exp.BooleanOperation][]byte{
-			exp.EqOp:             []byte("="),
-			exp.NeqOp:            []byte("!="),
-			exp.GtOp:             []byte(">"),
-			exp.GteOp:            []byte(">="),
-			exp.LtOp:             []byte("<"),
-			exp.LteOp:            []byte("<="),
-			exp.InOp:             []byte("IN"),
-			exp.NotInOp:          []byte("NOT IN"),
-			exp.IsOp:             []byte("IS"),
-			exp.IsNotOp:          []byte("IS NOT"),
-			exp.LikeOp:           []byte("LIKE"),
-			exp.NotLikeOp:        []byte("NOT LIKE"),
-			exp.ILikeOp:          []byte("ILIKE"),
-			exp.NotILikeOp:       []byte("NOT ILIKE"),
-			exp.RegexpLikeOp:     []byte("~"),
-			exp.RegexpNotLikeOp:  []byte("!~"),
-			exp.RegexpILikeOp:    []byte("~*"),
-			exp.RegexpNotILikeOp: []byte("!~*"),
+			exp.EqOp:                []byte("="),
+			exp.NeqOp:               []byte("!="),
+			exp.GtOp:                []byte(">"),
+			exp.GteOp:               []byte(">="),
+			exp.LtOp:                []byte("<"),
+			exp.LteOp:               []byte("<="),
+			exp.InOp:                []byte("IN"),
+			exp.NotInOp:             []byte("NOT IN"),
+			exp.IsOp:                []byte("IS"),
+			exp.IsNotOp:             []byte("IS NOT"),
+			exp.LikeOp:              []byte("LIKE"),
+			exp.NotLikeOp:           []byte("NOT LIKE"),
+			exp.ILikeOp:             []byte("ILIKE"),
+			exp.NotILikeOp:          []byte("NOT ILIKE"),
+			exp.RegexpLikeOp:        []byte("~"),
+			exp.RegexpNotLikeOp:     []byte("!~"),
+			exp.RegexpILikeOp:       []byte("~*"),
+			exp.RegexpNotILikeOp:    []byte("!~*"),
+			exp.IsDistinctFromOp:    []byte("IS DISTINCT FROM"),
+			exp.IsNotDistinctFromOp: []byte("IS NOT DISTINCT FROM"),
+			exp.ArrayContainsOp:     []byte("@>"),
+			exp.ArrayContainedByOp:  []byte("<@"),
+			exp.ArrayOverlapsOp:     []byte("&&"),
 		},
 		BitwiseOperatorLookup: map[exp.BitwiseOperation][]byte{
 			exp.BitwiseInversionOp:  []byte("~"),
@@ -530,6 +897,116 @@ func DefaultDialectOptions() *SQLDialectOptions {
 			exp.BitwiseLeftShiftOp:  []byte("<<"),
 			exp.BitwiseRightShiftOp: []byte(">>"),
 		},
+		ArithmeticOperatorLookup: map[exp.ArithmeticOperation][]byte{
+			exp.AddOp: []byte("+"),
+			exp.SubOp: []byte("-"),
+			exp.MulOp: []byte("*"),
+			exp.DivOp: []byte("/"),
+			exp.ModOp: []byte("%"),
+			exp.NegOp: []byte("-"),
+		},
+		WindowFrameUnitLookup: map[exp.WindowFrameUnit][]byte{
+			exp.RowsFrameUnit:   []byte("ROWS"),
+			exp.RangeFrameUnit:  []byte("RANGE"),
+			exp.GroupsFrameUnit: []byte("GROUPS"),
+		},
+		WindowFrameBoundLookup: map[exp.WindowFrameBoundType][]byte{
+			exp.UnboundedPrecedingFrameBound: []byte("UNBOUNDED PRECEDING"),
+			exp.PrecedingFrameBound:          []byte(" PRECEDING"),
+			exp.CurrentRowFrameBound:         []byte("CURRENT ROW"),
+			exp.FollowingFrameBound:          []byte(" FOLLOWING"),
+			exp.UnboundedFollowingFrameBound: []byte("UNBOUNDED FOLLOWING"),
+		},
+		WindowFrameExclusionLookup: map[exp.WindowFrameExclusion][]byte{
+			exp.CurrentRowFrameExclusion: []byte(" EXCLUDE CURRENT ROW"),
+			exp.GroupFrameExclusion:      []byte(" EXCLUDE GROUP"),
+			exp.TiesFrameExclusion:       []byte(" EXCLUDE TIES"),
+			exp.NoOthersFrameExclusion:   []byte(" EXCLUDE NO OTHERS"),
+		},
+		QuantifierLookup: map[exp.QuantifierType][]byte{
+			exp.AnyQuantifierType: []byte("ANY"),
+			exp.AllQuantifierType: []byte("ALL"),
+		},
+		IntervalUnitLookup: map[exp.DateTimeUnit][]byte{
+			exp.MicrosecondUnit: []byte("microseconds"),
+			exp.MillisecondUnit: []byte("milliseconds"),
+			exp.SecondUnit:      []byte("seconds"),
+			exp.MinuteUnit:      []byte("minutes"),
+			exp.HourUnit:        []byte("hours"),
+			exp.DayUnit:         []byte("days"),
+			exp.WeekUnit:        []byte("weeks"),
+			exp.MonthUnit:       []byte("months"),
+			exp.YearUnit:        []byte("years"),
+		},
+		ExtractFieldLookup: map[exp.DateTimeUnit][]byte{
+			exp.MicrosecondUnit: []byte("MICROSECONDS"),
+			exp.MillisecondUnit: []byte("MILLISECONDS"),
+			exp.SecondUnit:      []byte("SECOND"),
+			exp.MinuteUnit:      []byte("MINUTE"),
+			exp.HourUnit:        []byte("HOUR"),
+			exp.DayUnit:         []byte("DAY"),
+			exp.WeekUnit:        []byte("WEEK"),
+			exp.MonthUnit:       []byte("MONTH"),
+			exp.QuarterUnit:     []byte("QUARTER"),
+			exp.YearUnit:        []byte("YEAR"),
+			exp.DayOfWeekUnit:   []byte("DOW"),
+			exp.DayOfYearUnit:   []byte("DOY"),
+			exp.EpochUnit:       []byte("EPOCH"),
+		},
+		DateTruncLookup: map[exp.DateTimeUnit][]byte{
+			exp.MicrosecondUnit: []byte("microseconds"),
+			exp.MillisecondUnit: []byte("milliseconds"),
+			exp.SecondUnit:      []byte("second"),
+			exp.MinuteUnit:      []byte("minute"),
+			exp.HourUnit:        []byte("hour"),
+			exp.DayUnit:         []byte("day"),
+			exp.WeekUnit:        []byte("week"),
+			exp.MonthUnit:       []byte("month"),
+			exp.QuarterUnit:     []byte("quarter"),
+			exp.YearUnit:        []byte("year"),
+		},
+		CurrentTimeLookup: map[exp.CurrentTimeType][]byte{
+			exp.NowCurrentTimeType:       []byte("NOW()"),
+			exp.TimestampCurrentTimeType: []byte("CURRENT_TIMESTAMP"),
+			exp.DateCurrentTimeType:      []byte("CURRENT_DATE"),
+			exp.TimeCurrentTimeType:      []byte("CURRENT_TIME"),
+		},
+		IntervalFragment:  []byte("INTERVAL "),
+		ExtractFunction:   []byte("EXTRACT"),
+		DateTruncFunction: []byte("date_trunc"),
+		ConcatOperator:    []byte("||"),
+		StringFunctionLookup: map[exp.StringOperation][]byte{
+			exp.ConcatOp:    []byte("CONCAT"),
+			exp.LowerOp:     []byte("LOWER"),
+			exp.UpperOp:     []byte("UPPER"),
+			exp.TrimOp:      []byte("TRIM"),
+			exp.SubstringOp: []byte("SUBSTRING"),
+			exp.LengthOp:    []byte("LENGTH"),
+			exp.PositionOp:  []byte("POSITION"),
+			exp.ReplaceOp:   []byte("REPLACE"),
+		},
+		TextSearchModeLookup: map[exp.TextSearchMode][]byte{
+			exp.NaturalLanguageTextSearchMode: []byte("websearch_to_tsquery"),
+			exp.BooleanTextSearchMode:         []byte("to_tsquery"),
+		},
+		TextSearchRankFunction: []byte("ts_rank"),
+		TSVectorFunction:       []byte("to_tsvector"),
+		TSVectorConcatFragment: []byte(" || "),
+		TSMatchFragment:        []byte(" @@ "),
+		JSONOperatorLookup: map[exp.JSONOperation][]byte{
+			exp.JSONExtractOp:     []byte("->"),
+			exp.JSONExtractTextOp: []byte("->>"),
+			exp.JSONContainsOp:    []byte("@>"),
+		},
+		JSONFunctionLookup: map[exp.JSONOperation][]byte{
+			exp.JSONHasKeyOp:      []byte("jsonb_exists"),
+			exp.JSONArrayLengthOp: []byte("jsonb_array_length"),
+		},
+		GroupingTypeLookup: map[exp.GroupingType][]byte{
+			exp.RollupGroupingType:       []byte("ROLLUP"),
+			exp.CubeGroupingType:         []byte("CUBE"),
+			exp.GroupingSetsGroupingType: []byte("GROUPING SETS"),
+		},
 		RangeOperatorLookup: map[exp.RangeOperation][]byte{
 			exp.BetweenOp:    []byte("BETWEEN"),
 			exp.NotBetweenOp: []byte("NOT BETWEEN"),
@@ -31,6 +31,9 @@ func NewUpdateSQLGenerator(dialect string, do *SQLDialectOptions) UpdateSQLGener
 }
 
 func (usg *updateSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.UpdateClauses) {
+	if usg.DialectOptions().SimplifyExpressions {
+		clauses = exp.RewriteUpdateClauses(clauses, Simplifier(usg.DialectOptions()))
+	}
 	if !clauses.HasTable() {
 		b.SetError(ErrNoSourceForUpdate)
 		return
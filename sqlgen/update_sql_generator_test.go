@@ -220,6 +220,21 @@ func (usgs *updateSQLGeneratorSuite) TestGenerate_withLimit() {
 	)
 }
 
+func (usgs *updateSQLGeneratorSuite) TestGenerate_withSimplifyExpressions() {
+	uc := exp.NewUpdateClauses().
+		SetTable(exp.NewIdentifierExpression("", "test", "")).
+		SetSetValues(exp.Record{"a": "b"}).
+		WhereAppend(exp.NewIdentifierExpression("", "", "a").NotIn([]string{}), exp.Ex{"b": "c"})
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SimplifyExpressions = true
+	usgs.assertCases(
+		sqlgen.NewUpdateSQLGenerator("test", opts),
+		updateTestCase{clause: uc, sql: `UPDATE "test" SET "a"='b' WHERE ("b" = 'c')`},
+		updateTestCase{clause: uc, sql: `UPDATE "test" SET "a"=? WHERE ("b" = ?)`, isPrepared: true, args: []interface{}{"b", "c"}},
+	)
+}
+
 func (usgs *updateSQLGeneratorSuite) TestGenerate_withCommonTables() {
 	tse := newTestAppendableExpression("select * from foo", emptyArgs, nil, nil)
 	uc := exp.NewUpdateClauses().
@@ -0,0 +1,209 @@
+package depiq
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/orn-id/depiq/exp"
+	"github.com/orn-id/depiq/internal/errors"
+	"github.com/orn-id/depiq/internal/util"
+	"github.com/orn-id/depiq/sqlgen"
+)
+
+type (
+	// Implemented by a struct to set the name of its table, see TableOf
+	TableNamer interface {
+		TableName() string
+	}
+
+	// The table of a struct created by TableOf. A TypedTable is an IdentifierExpression for the table so it can be used
+	// anywhere a table can (e.g. From, Join or Into).
+	TypedTable[M any] struct {
+		exp.IdentifierExpression
+		columnMap util.ColumnMap
+	}
+
+	// A typed column of a Table created by ColumnOf. The comparison methods only accept values of the type of the
+	// struct field so
+	//
+	//	users.Age.Eq("10")
+	//
+	// does not compile if Age is an int. A Column can be used anywhere an expression can (e.g. Select, Order or as the
+	// value of a comparison).
+	//
+	// The typed comparison methods shadow the methods of the embedded IdentifierExpression with different signatures,
+	// so a Column is NOT an exp.IdentifierExpression and can not be passed to an API that requires one. Use Ident to get
+	// the untyped identifier, e.g. to compare two columns in a join
+	//
+	//	depiq.On(orders.UserID.Ident().Eq(users.ID))
+	Column[V any] struct {
+		exp.IdentifierExpression
+	}
+)
+
+// Returns the Table of the struct M, the columns are found with the same rules used to scan rows into M (see the db
+// and depiq struct tags). The name of the table is returned by the TableName method if M implements TableNamer,
+// otherwise it is the lower case name of the type
+//
+//	type User struct {
+//		ID   int64  `db:"id"`
+//		Name string `db:"name"`
+//	}
+//
+//	users := depiq.TableOf[User]()
+//	name := depiq.ColumnOf(users, func(u *User) *string { return &u.Name })
+//	depiq.From(users).Where(name.Eq("Bob")) // SELECT * FROM "user" WHERE ("user"."name" = 'Bob')
+//
+// Use the depiqgen command to generate a struct with a typed Column for every field of M. TableOf panics if M is not a
+// struct.
+func TableOf[M any]() *TypedTable[M] {
+	var m M
+	cm, err := util.GetColumnMap(&m)
+	if err != nil {
+		panic(err.Error())
+	}
+	name := strings.ToLower(reflect.TypeOf(m).Name())
+	if tn, ok := interface{}(m).(TableNamer); ok {
+		name = tn.TableName()
+	} else if tn, ok := interface{}(&m).(TableNamer); ok {
+		name = tn.TableName()
+	}
+	return &TypedTable[M]{IdentifierExpression: T(name), columnMap: cm}
+}
+
+// Returns the Column of the struct field returned by field, the field is used to find the column so renaming a field
+// or changing its type breaks the build instead of the query
+//
+//	age := depiq.ColumnOf(users, func(u *User) *int { return &u.Age })
+//
+// The column is qualified with the name of the table, columns of nested structs (e.g. "address.street") use the name
+// of the nested struct as the table. ColumnOf panics if the field is not a column (e.g. it is tagged with db:"-").
+func ColumnOf[M, V any](t *TypedTable[M], field func(m *M) *V) Column[V] {
+	addr := func(m *M) uintptr { return reflect.ValueOf(field(m)).Pointer() }
+	col, err := t.column(addr, reflect.TypeOf((*V)(nil)).Elem())
+	if err != nil {
+		panic(err.Error())
+	}
+	return Column[V]{IdentifierExpression: col}
+}
+
+// Returns the names of the columns of the table, sorted by name
+func (t *TypedTable[M]) Columns() []string {
+	return t.columnMap.Cols()
+}
+
+// returns the column of the field of type vt at the address returned by addr
+func (t *TypedTable[M]) column(addr func(m *M) uintptr, vt reflect.Type) (exp.IdentifierExpression, error) {
+	m := new(M)
+	v := reflect.ValueOf(m).Elem()
+	// allocate the embedded and nested struct pointers so field can return the address of their fields
+	for _, cd := range t.columnMap {
+		initFieldPointers(v, cd.FieldIndex)
+	}
+	ptr := addr(m)
+	for _, cd := range t.columnMap {
+		f, ok := util.SafeGetFieldByIndex(v, cd.FieldIndex)
+		if ok && f.Type() == vt && f.Addr().Pointer() == ptr {
+			return t.columnIdentifier(cd.ColumnName), nil
+		}
+	}
+	return nil, errors.New("field of type %v is not a column of %T", vt, *m)
+}
+
+func (t *TypedTable[M]) columnIdentifier(col string) exp.IdentifierExpression {
+	if strings.Contains(col, ".") {
+		return exp.ParseIdentifier(col)
+	}
+	return t.Col(col)
+}
+
+// allocates the nil struct pointers on the path to the field, pointers to unexported embedded structs can not be set
+func initFieldPointers(v reflect.Value, fieldIndex []int) {
+	for _, i := range fieldIndex[:len(fieldIndex)-1] {
+		f := v.Field(i)
+		if f.Kind() == reflect.Ptr {
+			if f.IsNil() {
+				if !f.CanSet() {
+					return
+				}
+				f.Set(reflect.New(f.Type().Elem()))
+			}
+			f = f.Elem()
+		}
+		v = f
+	}
+}
+
+// Returns the untyped IdentifierExpression of the column, see Column
+func (c Column[V]) Ident() exp.IdentifierExpression {
+	return c.IdentifierExpression
+}
+
+func (c Column[V]) Expression() exp.Expression {
+	return c
+}
+
+func (c Column[V]) Clone() exp.Expression {
+	return Column[V]{IdentifierExpression: c.IdentifierExpression.Clone().(exp.IdentifierExpression)}
+}
+
+// Writes the SQL of the column, see sqlgen.SQLAppender
+func (c Column[V]) GenerateSQL(b sqlgen.SQLBuilder, esg sqlgen.ExpressionSQLGenerator) {
+	esg.Generate(b, c.IdentifierExpression)
+}
+
+// Creates a BooleanExpression for equality
+//
+//	users.Name.Eq("Bob") // "user"."name" = 'Bob'
+func (c Column[V]) Eq(val V) exp.BooleanExpression {
+	return c.IdentifierExpression.Eq(val)
+}
+
+// Creates a BooleanExpression for in equality
+func (c Column[V]) Neq(val V) exp.BooleanExpression {
+	return c.IdentifierExpression.Neq(val)
+}
+
+// Creates a BooleanExpression for greater than
+func (c Column[V]) Gt(val V) exp.BooleanExpression {
+	return c.IdentifierExpression.Gt(val)
+}
+
+// Creates a BooleanExpression for greater than or equal to
+func (c Column[V]) Gte(val V) exp.BooleanExpression {
+	return c.IdentifierExpression.Gte(val)
+}
+
+// Creates a BooleanExpression for less than
+func (c Column[V]) Lt(val V) exp.BooleanExpression {
+	return c.IdentifierExpression.Lt(val)
+}
+
+// Creates a BooleanExpression for less than or equal to
+func (c Column[V]) Lte(val V) exp.BooleanExpression {
+	return c.IdentifierExpression.Lte(val)
+}
+
+// Creates a BooleanExpression for an IN list
+//
+//	users.ID.In(1, 2, 3) // "user"."id" IN (1, 2, 3)
+func (c Column[V]) In(vals ...V) exp.BooleanExpression {
+	return c.IdentifierExpression.In(vals)
+}
+
+// Creates a BooleanExpression for a NOT IN list
+func (c Column[V]) NotIn(vals ...V) exp.BooleanExpression {
+	return c.IdentifierExpression.NotIn(vals)
+}
+
+// Creates a RangeExpression for a BETWEEN
+//
+//	users.Age.Between(18, 30) // "user"."age" BETWEEN 18 AND 30
+func (c Column[V]) Between(start, end V) exp.RangeExpression {
+	return c.IdentifierExpression.Between(exp.NewRangeVal(start, end))
+}
+
+// Creates a RangeExpression for a NOT BETWEEN
+func (c Column[V]) NotBetween(start, end V) exp.RangeExpression {
+	return c.IdentifierExpression.NotBetween(exp.NewRangeVal(start, end))
+}
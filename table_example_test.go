@@ -0,0 +1,45 @@
+package depiq_test
+
+import (
+	"fmt"
+
+	"github.com/orn-id/depiq"
+)
+
+type exampleUser struct {
+	ID   int64  `db:"id"`
+	Name string `db:"name"`
+	Age  int    `db:"age"`
+}
+
+func (exampleUser) TableName() string {
+	return "users"
+}
+
+func ExampleTableOf() {
+	users := depiq.TableOf[exampleUser]()
+	fmt.Println(users.GetTable(), users.Columns())
+
+	sql, _, _ := depiq.From(users).ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// users [age id name]
+	// SELECT * FROM "users"
+}
+
+func ExampleColumnOf() {
+	users := depiq.TableOf[exampleUser]()
+	name := depiq.ColumnOf(users, func(u *exampleUser) *string { return &u.Name })
+	age := depiq.ColumnOf(users, func(u *exampleUser) *int { return &u.Age })
+
+	// age.Gt("18") does not compile
+	sql, _, _ := depiq.From(users).
+		Select(name).
+		Where(age.Between(18, 30), name.In("Bob", "Sally")).
+		Order(age.Desc()).
+		ToSQL()
+	fmt.Println(sql)
+	// nolint:lll // sql statements are long
+	// Output:
+	// SELECT "users"."name" FROM "users" WHERE (("users"."age" BETWEEN 18 AND 30) AND ("users"."name" IN ('Bob', 'Sally'))) ORDER BY "users"."age" DESC
+}
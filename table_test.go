@@ -0,0 +1,113 @@
+package depiq_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/orn-id/depiq"
+	"github.com/orn-id/depiq/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	tableAddress struct {
+		Street string `db:"street"`
+	}
+	TableBase struct {
+		ID int64 `db:"id"`
+	}
+	tableUser struct {
+		*TableBase
+		Name     string         `db:"name"`
+		Email    sql.NullString `db:"email"`
+		Age      int            `db:"age"`
+		Address  tableAddress   `db:"address"`
+		Password string         `db:"-"`
+	}
+	tableOrder struct {
+		UserID int64 `db:"user_id"`
+	}
+
+	tableSuite struct {
+		suite.Suite
+	}
+)
+
+func (tableOrder) TableName() string {
+	return "orders"
+}
+
+func TestTableSuite(t *testing.T) {
+	suite.Run(t, new(tableSuite))
+}
+
+func (ts *tableSuite) assertSQL(ds *depiq.SelectDataset, expectedSQL string) {
+	sql, _, err := ds.ToSQL()
+	ts.Require().NoError(err)
+	ts.Equal(expectedSQL, sql)
+}
+
+func (ts *tableSuite) TestTableOf() {
+	users := depiq.TableOf[tableUser]()
+	ts.Equal("tableuser", users.GetTable())
+	ts.Equal([]string{"address.street", "age", "email", "id", "name"}, users.Columns())
+	ts.assertSQL(depiq.From(users), `SELECT * FROM "tableuser"`)
+
+	orders := depiq.TableOf[tableOrder]()
+	ts.Equal("orders", orders.GetTable())
+	ts.assertSQL(depiq.From(orders.As("o")), `SELECT * FROM "orders" AS "o"`)
+
+	ts.PanicsWithValue("depiq: cannot scan into this type: int", func() {
+		depiq.TableOf[int]()
+	})
+}
+
+func (ts *tableSuite) TestColumnOf() {
+	users := depiq.TableOf[tableUser]()
+	orders := depiq.TableOf[tableOrder]()
+	id := depiq.ColumnOf(users, func(u *tableUser) *int64 { return &u.ID })
+	name := depiq.ColumnOf(users, func(u *tableUser) *string { return &u.Name })
+	email := depiq.ColumnOf(users, func(u *tableUser) *sql.NullString { return &u.Email })
+	age := depiq.ColumnOf(users, func(u *tableUser) *int { return &u.Age })
+	street := depiq.ColumnOf(users, func(u *tableUser) *string { return &u.Address.Street })
+	userID := depiq.ColumnOf(orders, func(o *tableOrder) *int64 { return &o.UserID })
+
+	ts.assertSQL(
+		depiq.From(users).Select(id, name, street).Order(name.Asc()),
+		`SELECT "tableuser"."id", "tableuser"."name", "address"."street" FROM "tableuser" ORDER BY "tableuser"."name" ASC`,
+	)
+	ts.assertSQL(
+		depiq.From(users).Join(orders, depiq.On(userID.Ident().Eq(id))).Where(id.In(1, 2)),
+		`SELECT * FROM "tableuser" INNER JOIN "orders" ON ("orders"."user_id" = "tableuser"."id") `+
+			`WHERE ("tableuser"."id" IN (1, 2))`,
+	)
+	ts.assertSQL(
+		depiq.From(users).
+			LeftJoin(orders, depiq.On(id.Ident().Eq(userID), userID.Ident().IsNotNull())).
+			Order(userID.Ident().Desc().NullsLast(), age.Asc()),
+		`SELECT * FROM "tableuser" LEFT JOIN "orders" ON (("tableuser"."id" = "orders"."user_id") AND `+
+			`("orders"."user_id" IS NOT NULL)) ORDER BY "orders"."user_id" DESC NULLS LAST, "tableuser"."age" ASC`,
+	)
+	ts.assertSQL(
+		depiq.From(users).Where(
+			name.Eq("Bob"), name.Neq("Sally"), age.Gt(1), age.Gte(2), age.Lt(3), age.Lte(4),
+			email.Eq(sql.NullString{String: "a", Valid: true}), age.NotIn(5, 6),
+			age.Between(7, 8), age.NotBetween(9, 10), street.IsNull(),
+		),
+		`SELECT * FROM "tableuser" WHERE (("tableuser"."name" = 'Bob') AND ("tableuser"."name" != 'Sally') AND `+
+			`("tableuser"."age" > 1) AND ("tableuser"."age" >= 2) AND ("tableuser"."age" < 3) AND `+
+			`("tableuser"."age" <= 4) AND ("tableuser"."email" = 'a') AND ("tableuser"."age" NOT IN (5, 6)) AND `+
+			`("tableuser"."age" BETWEEN 7 AND 8) AND ("tableuser"."age" NOT BETWEEN 9 AND 10) AND `+
+			`("address"."street" IS NULL))`,
+	)
+
+	ts.Equal(name, name.Expression())
+	ts.Equal(name, name.Clone())
+	var _ exp.Expression = name
+	var ident exp.IdentifierExpression = name.Ident()
+	ts.Equal(name.IdentifierExpression, ident)
+
+	ts.PanicsWithValue("depiq: field of type string is not a column of depiq_test.tableUser", func() {
+		depiq.ColumnOf(users, func(u *tableUser) *string { return &u.Password })
+	})
+}
@@ -73,6 +73,11 @@ func (td *TruncateDataset) GetClauses() exp.TruncateClauses {
 	return td.clauses
 }
 
+// Returns a copy of the dataset using the clauses. Used by exp.Rewrite to rebuild a rewritten dataset.
+func (td *TruncateDataset) WithClauses(clauses exp.TruncateClauses) exp.Expression {
+	return td.copy(clauses)
+}
+
 // used interally to copy the dataset
 func (td *TruncateDataset) copy(clauses exp.TruncateClauses) *TruncateDataset {
 	return &TruncateDataset{
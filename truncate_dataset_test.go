@@ -77,6 +77,16 @@ func (tds *truncateDatasetSuite) TestGetClauses() {
 	tds.Equal(ce, ds.GetClauses())
 }
 
+func (tds *truncateDatasetSuite) TestWithClauses() {
+	ds := depiq.Truncate("test").WithDialect("mock")
+	ce := exp.NewTruncateClauses().SetTable(exp.NewColumnListExpression(depiq.I("other")))
+	wds, ok := ds.WithClauses(ce).(*depiq.TruncateDataset)
+	tds.True(ok)
+	tds.Equal(ce, wds.GetClauses())
+	tds.Equal(ds.Dialect(), wds.Dialect())
+	tds.NotEqual(ce, ds.GetClauses())
+}
+
 func (tds *truncateDatasetSuite) TestTable() {
 	bd := depiq.Truncate("test")
 	tds.assertCases(
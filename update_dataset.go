@@ -11,6 +11,7 @@ type UpdateDataset struct {
 	dialect      SQLDialect
 	clauses      exp.UpdateClauses
 	isPrepared   prepared
+	simplify     bool
 	queryFactory exec.QueryFactory
 	err          error
 }
@@ -43,6 +44,18 @@ func (ud *UpdateDataset) IsPrepared() bool {
 	return ud.isPrepared.Bool()
 }
 
+// Set to true to simplify the boolean expressions of the dataset before the SQL is generated, e.g. empty expression
+// lists are removed and x IN () is folded into FALSE. See sqlgen.Simplifier
+func (ud *UpdateDataset) Simplify(simplify bool) *UpdateDataset {
+	ret := ud.copy(ud.clauses)
+	ret.simplify = simplify
+	return ret
+}
+
+func (ud *UpdateDataset) IsSimplified() bool {
+	return ud.simplify
+}
+
 // Sets the adapter used to serialize values and create the SQL statement
 func (ud *UpdateDataset) WithDialect(dl string) *UpdateDataset {
 	ds := ud.copy(ud.GetClauses())
@@ -76,12 +89,18 @@ func (ud *UpdateDataset) GetClauses() exp.UpdateClauses {
 	return ud.clauses
 }
 
+// Returns a copy of the dataset using the clauses. Used by exp.Rewrite to rebuild a rewritten dataset.
+func (ud *UpdateDataset) WithClauses(clauses exp.UpdateClauses) exp.Expression {
+	return ud.copy(clauses)
+}
+
 // used internally to copy the dataset
 func (ud *UpdateDataset) copy(clauses exp.UpdateClauses) *UpdateDataset {
 	return &UpdateDataset{
 		dialect:      ud.dialect,
 		clauses:      clauses,
 		isPrepared:   ud.isPrepared,
+		simplify:     ud.simplify,
 		queryFactory: ud.queryFactory,
 		err:          ud.err,
 	}
@@ -240,6 +259,14 @@ func (ud *UpdateDataset) updateSQLBuilder() sb.SQLBuilder {
 	if ud.err != nil {
 		return buf.SetError(ud.err)
 	}
-	ud.dialect.ToUpdateSQL(buf, ud.clauses)
+	ud.dialect.ToUpdateSQL(buf, ud.sqlClauses())
 	return buf
 }
+
+// returns the clauses used to generate the SQL, simplified if Simplify(true) was used
+func (ud *UpdateDataset) sqlClauses() exp.UpdateClauses {
+	if fn := datasetSimplifier(ud.dialect, ud.simplify); fn != nil {
+		return exp.RewriteUpdateClauses(ud.clauses, fn)
+	}
+	return ud.clauses
+}
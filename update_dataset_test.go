@@ -78,12 +78,38 @@ func (uds *updateDatasetSuite) TestPrepared() {
 	uds.True(ds.IsPrepared())
 }
 
+func (uds *updateDatasetSuite) TestSimplify() {
+	ds := depiq.Update("test").Set(depiq.Record{"a": 1}).Where(depiq.C("b").NotIn([]int{}), depiq.Ex{"c": 1})
+	simplifiedDs := ds.Simplify(true)
+	uds.True(simplifiedDs.IsSimplified())
+	uds.False(ds.IsSimplified())
+	uds.True(simplifiedDs.Where(depiq.Ex{"b": 1}).IsSimplified())
+
+	sql, _, err := ds.ToSQL()
+	uds.NoError(err)
+	uds.Equal(`UPDATE "test" SET "a"=1 WHERE (("b" NOT IN ()) AND ("c" = 1))`, sql)
+
+	sql, _, err = simplifiedDs.ToSQL()
+	uds.NoError(err)
+	uds.Equal(`UPDATE "test" SET "a"=1 WHERE ("c" = 1)`, sql)
+}
+
 func (uds *updateDatasetSuite) TestGetClauses() {
 	ds := depiq.Update("test")
 	ce := exp.NewUpdateClauses().SetTable(depiq.I("test"))
 	uds.Equal(ce, ds.GetClauses())
 }
 
+func (uds *updateDatasetSuite) TestWithClauses() {
+	ds := depiq.Update("test").WithDialect("mock")
+	ce := exp.NewUpdateClauses().SetTable(depiq.I("other"))
+	wds, ok := ds.WithClauses(ce).(*depiq.UpdateDataset)
+	uds.True(ok)
+	uds.Equal(ce, wds.GetClauses())
+	uds.Equal(ds.Dialect(), wds.Dialect())
+	uds.NotEqual(ce, ds.GetClauses())
+}
+
 func (uds *updateDatasetSuite) TestWith() {
 	from := depiq.Update("cte")
 	bd := depiq.Update("items")